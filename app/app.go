@@ -90,6 +90,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/modules/core/keeper"
+	misestmclient "github.com/mises-id/mises-tm/x/misestm/client"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	_ "github.com/tendermint/tm-db/metadb"
 
@@ -113,6 +114,9 @@ import (
 	"github.com/mises-id/mises-tm/x/misestm"
 	misestmkeeper "github.com/mises-id/mises-tm/x/misestm/keeper"
 	misestmtypes "github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/mises-id/mises-tm/x/nfttransfer"
+	nfttransferkeeper "github.com/mises-id/mises-tm/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/mises-id/mises-tm/x/nfttransfer/types"
 )
 
 const Name = "misestm"
@@ -129,6 +133,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+		misestmclient.AppVerificationProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -166,6 +171,7 @@ var (
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 		misestm.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -179,6 +185,8 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		//gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		nft.ModuleName: nil,
+		// mints and burns the denoms issued by apps
+		misestmtypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -206,6 +214,7 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -235,10 +244,12 @@ type App struct {
 	//GravityKeeper gravitykeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper capabilitykeeper.ScopedKeeper
 
-	NFTKeeper nftkeeper.Keeper
+	NFTKeeper         nftkeeper.Keeper
+	NFTTransferKeeper nfttransferkeeper.Keeper
 
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
@@ -279,7 +290,7 @@ func New(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		authzkeeper.StoreKey, //gravitytypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
-		misestmtypes.StoreKey, feegrant.StoreKey, nftkeeper.StoreKey,
+		misestmtypes.StoreKey, feegrant.StoreKey, nftkeeper.StoreKey, nfttransfertypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -289,6 +300,7 @@ func New(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -306,6 +318,7 @@ func New(
 	// grant capabilities for the ibc and ibc-transfer modules
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/scopedKeeper
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
@@ -415,12 +428,24 @@ func New(
 		mongoCodec,
 		keys[misestmtypes.StoreKey],
 		keys[misestmtypes.MemStoreKey],
+		app.GetSubspace(misestmtypes.ModuleName),
 		app.AccountKeeper,
-		app.FeeGrantKeeper,
+		app.BankKeeper,
+		misesFeeGrantKeeper{app.FeeGrantKeeper},
 		app.NFTKeeper,
+		keys[nftkeeper.StoreKey],
 		rawdb,
 	)
 	misestmModule := misestm.NewAppModule(appCodec, app.MisestmKeeper)
+	govRouter.AddRoute(misestmtypes.RouterKey, misestm.NewProposalHandler(app.MisestmKeeper))
+
+	// nfts of the classes minted by misestm move to other chains through ICS-721
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, scopedNFTTransferKeeper,
+		app.NFTKeeper, app.MisestmKeeper,
+	)
+	nftTransferModule := nfttransfer.NewAppModule(appCodec, app.NFTTransferKeeper)
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -430,6 +455,7 @@ func New(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nftTransferModule)
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -472,6 +498,7 @@ func New(
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		// this line is used by starport scaffolding # stargate/app/appModule
 		misestmModule,
+		nftTransferModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -493,6 +520,7 @@ func New(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		misestmtypes.ModuleName,
 		//gravitytypes.ModuleName,
 	)
 
@@ -520,12 +548,14 @@ func New(
 		nft.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 		misestmtypes.ModuleName,
+		nfttransfertypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.setupUpgradeHandlers()
 
 	// initialize stores
 
@@ -565,9 +595,14 @@ func New(
 	if err != nil {
 		panic(err)
 	}
-	app.SetAnteHandler(anteHandler)
+	soulboundDecorator := misestm.NewSoulboundDecorator(app.MisestmKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return soulboundDecorator.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setupUpgradeStoreLoaders()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -576,6 +611,7 @@ func New(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
 	// this line is used by starport scaffolding # stargate/app/beforeInitReturn
 
 	return app
@@ -600,6 +636,7 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -639,6 +676,41 @@ func (app *App) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// GetBaseApp returns the app's BaseApp.
+//
+// NOTE: This is solely to be used for testing purposes, it makes the app an ibc-go TestingApp.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the app's staking keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the app's IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the app's scoped IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the app's TxConfig.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
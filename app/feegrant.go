@@ -0,0 +1,21 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+// misesFeeGrantKeeper exposes allowance revocation of the feegrant keeper to misestm,
+// the feegrant keeper only revokes through its msg server
+type misesFeeGrantKeeper struct {
+	feegrantkeeper.Keeper
+}
+
+func (k misesFeeGrantKeeper) RevokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	_, err := feegrantkeeper.NewMsgServerImpl(k.Keeper).RevokeAllowance(
+		sdk.WrapSDKContext(ctx),
+		&feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: grantee.String()},
+	)
+	return err
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	misestmtypes "github.com/mises-id/mises-tm/x/misestm/types"
+	nfttransfertypes "github.com/mises-id/mises-tm/x/nfttransfer/types"
+)
+
+// UpgradeV2 is the name of the software upgrade migrating misestm to consensus
+// version 2 and adding the nft transfer module
+const UpgradeV2 = "v2"
+
+// setupUpgradeHandlers registers the handlers run by the upgrade module when a
+// software upgrade proposal passes
+func (app *App) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeV2, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if len(fromVM) == 0 {
+			// chains started before the version map was stored run every module
+			// at its current version, but misestm and the new nft transfer module
+			fromVM = app.mm.GetVersionMap()
+			fromVM[misestmtypes.ModuleName] = 1
+			delete(fromVM, nfttransfertypes.ModuleName)
+		}
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+}
+
+// setupUpgradeStoreLoaders mounts the stores added by an upgrade when the node
+// restarts at the upgrade height
+func (app *App) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	switch upgradeInfo.Name {
+	case UpgradeV2:
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{nfttransfertypes.StoreKey},
+		}))
+	}
+}
@@ -0,0 +1,49 @@
+package misestm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/mises-id/mises-tm/x/misestm/keeper"
+)
+
+// SoulboundDecorator rejects the nft module transfers of soulbound NFTs and of NFTs
+// whose sales pay a royalty, including the ones executed through authz
+type SoulboundDecorator struct {
+	k keeper.Keeper
+}
+
+func NewSoulboundDecorator(k keeper.Keeper) SoulboundDecorator {
+	return SoulboundDecorator{k: k}
+}
+
+func (d SoulboundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d SoulboundDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *nft.MsgSend:
+			if d.k.IsSoulboundNFTClass(ctx, msg.ClassId) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s can not be transferred", msg.ClassId)
+			}
+			if d.k.HasNFTClassRoyalty(ctx, msg.ClassId) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s pay a royalty, transfer them with TransferNFT", msg.ClassId)
+			}
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
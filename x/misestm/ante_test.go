@@ -0,0 +1,59 @@
+package misestm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mises-id/mises-tm/testutil/simapp"
+	"github.com/mises-id/mises-tm/x/misestm"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+type anteTx []sdk.Msg
+
+func (tx anteTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx anteTx) ValidateBasic() error { return nil }
+
+func TestSoulboundDecorator(t *testing.T) {
+	a := simapp.New(t.TempDir())
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{})
+	artist := sdk.AccAddress([]byte("artist"))
+	holder := sdk.AccAddress([]byte("holder")).String()
+	a.MisestmKeeper.SetNFTClassInfo(ctx, types.NFTClassInfo{ClassId: "badge", Soulbound: true})
+	a.MisestmKeeper.SetNFTClassInfo(ctx, types.NFTClassInfo{ClassId: "art", RoyaltyRecipient: artist.String(), RoyaltyBasisPoints: 250})
+	a.MisestmKeeper.SetNFTClassInfo(ctx, types.NFTClassInfo{ClassId: "free"})
+
+	decorator := misestm.NewSoulboundDecorator(a.MisestmKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	send := func(classID string) *nft.MsgSend {
+		return &nft.MsgSend{ClassId: classID, Id: "nft1", Sender: holder, Receiver: artist.String()}
+	}
+	for _, tc := range []struct {
+		desc string
+		msg  sdk.Msg
+		err  bool
+	}{
+		{desc: "Soulbound", msg: send("badge"), err: true},
+		// the royalty is only paid by TransferNFT and TransferDidNFT sales
+		{desc: "Royalty", msg: send("art"), err: true},
+		{desc: "Free", msg: send("free")},
+		{desc: "Unknown", msg: send("ibc/class")},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			exec := authz.NewMsgExec(artist, []sdk.Msg{tc.msg})
+			for _, tx := range []anteTx{{tc.msg}, {&exec}} {
+				_, err := decorator.AnteHandle(ctx, tx, false, next)
+				if tc.err {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+		})
+	}
+}
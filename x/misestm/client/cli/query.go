@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	// this line is used by starport scaffolding # 1
 
+	cmd.AddCommand(CmdQueryParams())
+
 	cmd.AddCommand(CmdListUserInfo())
 	cmd.AddCommand(CmdShowUserInfo())
 
@@ -34,9 +36,31 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListAppInfo())
 	cmd.AddCommand(CmdShowAppInfo())
+	cmd.AddCommand(CmdShowAppByDomain())
 
 	cmd.AddCommand(CmdListDidRegistry())
 	cmd.AddCommand(CmdShowDidRegistry())
 
+	cmd.AddCommand(CmdListUserList())
+	cmd.AddCommand(CmdShowUserList())
+	cmd.AddCommand(CmdUserListsByOwner())
+	cmd.AddCommand(CmdUserListsByMember())
+
+	cmd.AddCommand(CmdListCommunity())
+	cmd.AddCommand(CmdShowCommunity())
+	cmd.AddCommand(CmdCommunityMembers())
+	cmd.AddCommand(CmdCommunitiesByMember())
+
+	cmd.AddCommand(CmdAppFeeGrantTemplates())
+	cmd.AddCommand(CmdAppFeeGrantees())
+
+	cmd.AddCommand(CmdAppAuthorizationsByUser())
+	cmd.AddCommand(CmdAppAuthorizationsByApp())
+	cmd.AddCommand(CmdShowAppSponsorStat())
+	cmd.AddCommand(CmdAppSponsoredAccounts())
+	cmd.AddCommand(CmdAppDenoms())
+	cmd.AddCommand(CmdShowNFTClassRoyalty())
+	cmd.AddCommand(CmdListDidNFT())
+
 	return cmd
 }
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAppAuthorizationsByUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppAuthorization-by-user [uid]",
+		Short: "list the apps a user authorized",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppAuthorizationsByUserRequest{
+				Uid:        args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppAuthorizationsByUser(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAppAuthorizationsByApp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppAuthorization-by-app [appid]",
+		Short: "list the users who authorized an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppAuthorizationsByAppRequest{
+				Appid:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppAuthorizationsByApp(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
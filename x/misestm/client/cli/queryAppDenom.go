@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAppDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppDenom [appid]",
+		Short: "list the denoms issued by an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppDenomsRequest{
+				Appid:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppDenoms(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAppFeeGrantTemplates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppFeeGrantTemplate [appid]",
+		Short: "list the fee grant templates of an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppFeeGrantTemplatesRequest{
+				Appid:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppFeeGrantTemplates(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAppFeeGrantees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppFeeGrantee [appid]",
+		Short: "list the users granted a fee allowance by an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppFeeGranteesRequest{
+				Appid:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppFeeGrantees(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
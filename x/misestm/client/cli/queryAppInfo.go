@@ -75,3 +75,31 @@ func CmdShowAppInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowAppByDomain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-app-by-domain [domain]",
+		Short: "shows the app claiming a domain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewRestQueryClient(clientCtx)
+
+			params := &types.RestQueryAppByDomainRequest{
+				Domain: args[0],
+			}
+
+			res, err := queryClient.QueryAppByDomain(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
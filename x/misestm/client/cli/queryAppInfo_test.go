@@ -24,8 +24,9 @@ func networkWithAppInfoObjects(t *testing.T, n int) (*network.Network, []*types.
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
 	for i := 0; i < n; i++ {
-		state.AppInfoList = append(state.AppInfoList, &types.AppInfo{Creator: "ANY", Id: uint64(i)})
+		state.AppInfoList = append(state.AppInfoList, &types.AppInfo{Creator: "ANY", Id: uint64(i), Admins: []string{}})
 	}
+	state.AppInfoCount = uint64(n)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowAppSponsorStat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-AppSponsorStat [appid]",
+		Short: "shows the did sponsoring statistics of an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppSponsorStatRequest{
+				Appid: args[0],
+			}
+
+			res, err := queryClient.AppSponsorStat(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAppSponsoredAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-AppSponsoredAccounts [appid]",
+		Short: "list the dids sponsored by an app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAppSponsoredAccountsRequest{
+				Appid:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AppSponsoredAccounts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
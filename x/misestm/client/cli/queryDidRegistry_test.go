@@ -26,6 +26,7 @@ func networkWithDidRegistryObjects(t *testing.T, n int) (*network.Network, []*ty
 	for i := 0; i < n; i++ {
 		state.DidRegistryList = append(state.DidRegistryList, &types.DidRegistry{Creator: "ANY", Id: uint64(i)})
 	}
+	state.DidRegistryCount = uint64(n)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowNFTClassRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-nft-class-royalty [classid]",
+		Short: "shows the royalty of a NFT class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			price, err := cmd.Flags().GetString(flagNFTPrice)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNFTClassRoyaltyRequest{
+				ClassId: args[0],
+				Price:   price,
+			}
+
+			res, err := queryClient.NFTClassRoyalty(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagNFTPrice, "", "sale price to compute the royalty for")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListDidNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-did-nft [mises-id]",
+		Short: "list the NFTs owned by a mises id, grouped by class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			classID, err := cmd.Flags().GetString(flagNFTClassID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewRestQueryClient(clientCtx)
+
+			params := &types.RestQueryNFTRequest{
+				MisesId:    args[0],
+				ClassId:    classID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QueryNFT(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagNFTClassID, "", "only list the NFTs of this class")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,7 @@ func networkWithUserInfoObjects(t *testing.T, n int) (*network.Network, []*types
 	for i := 0; i < n; i++ {
 		state.UserInfoList = append(state.UserInfoList, &types.UserInfo{Creator: "ANY", Id: uint64(i)})
 	}
+	state.UserInfoCount = uint64(n)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
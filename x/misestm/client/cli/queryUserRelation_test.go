@@ -24,8 +24,9 @@ func networkWithUserRelationObjects(t *testing.T, n int) (*network.Network, []*t
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
 	for i := 0; i < n; i++ {
-		state.UserRelationList = append(state.UserRelationList, &types.UserRelation{Creator: "ANY", Id: uint64(i)})
+		state.UserRelationList = append(state.UserRelationList, &types.UserRelation{Creator: "ANY", Id: uint64(i), Flags: []types.RelationFlag{}})
 	}
+	state.UserRelationCount = uint64(n)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -29,8 +29,17 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdNewDenom())
+	cmd.AddCommand(CmdMintAppDenom())
+	cmd.AddCommand(CmdBurnAppDenom())
 	cmd.AddCommand(CmdCreateNFTClass())
+	cmd.AddCommand(CmdUpdateNFTClass())
 	cmd.AddCommand(CmdMintNFT())
+	cmd.AddCommand(CmdUpdateNFT())
+	cmd.AddCommand(CmdBurnNFT())
+	cmd.AddCommand(CmdTransferNFT())
+	cmd.AddCommand(CmdRevokeNFT())
+	cmd.AddCommand(CmdTransferDidNFT())
 
 	// this line is used by starport scaffolding # 1
 
@@ -39,8 +48,31 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateUserRelation())
 
 	cmd.AddCommand(CmdUpdateAppInfo())
+	cmd.AddCommand(CmdAddAppAdmin())
+	cmd.AddCommand(CmdRemoveAppAdmin())
+	cmd.AddCommand(CmdTransferAppOwnership())
+	cmd.AddCommand(CmdAcceptAppOwnership())
 
 	cmd.AddCommand(CmdCreateDidRegistry())
+	cmd.AddCommand(CmdSignSponsoredDid())
+	cmd.AddCommand(CmdCreateUserList())
+	cmd.AddCommand(CmdRenameUserList())
+	cmd.AddCommand(CmdAddUserListMembers())
+	cmd.AddCommand(CmdRemoveUserListMembers())
+	cmd.AddCommand(CmdDeleteUserList())
+	cmd.AddCommand(CmdCreateCommunity())
+	cmd.AddCommand(CmdJoinCommunity())
+	cmd.AddCommand(CmdApproveCommunityMember())
+	cmd.AddCommand(CmdKickCommunityMember())
+	cmd.AddCommand(CmdChangeCommunityRole())
+	cmd.AddCommand(CmdSetAppFeeGrantTemplate())
+	cmd.AddCommand(CmdDeleteAppFeeGrantTemplate())
+	cmd.AddCommand(CmdGrantAppFeeAllowances())
+	cmd.AddCommand(CmdRenewAppFeeAllowances())
+	cmd.AddCommand(CmdRevokeAppFeeAllowances())
+	cmd.AddCommand(CmdAuthorizeApp())
+	cmd.AddCommand(CmdRevokeAppAuthorization())
+	cmd.AddCommand(CmdExecAppAuthorized())
 
 	return cmd
 }
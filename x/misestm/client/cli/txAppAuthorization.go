@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+const (
+	flagAppAuthorizationExpiration = "expiration"
+)
+
+func CmdAuthorizeApp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize-app [uid] [appid] [scopes]",
+		Short: "Authorize an app to act for a user, scopes are comma separated",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			expirationStr, err := cmd.Flags().GetString(flagAppAuthorizationExpiration)
+			if err != nil {
+				return err
+			}
+			var argsExpiration *time.Time
+			if expirationStr != "" {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				argsExpiration = &expiration
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthorizeApp(clientCtx.GetFromAddress().String(), args[0], args[1], parseUserListMembers(args[2]), argsExpiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAppAuthorizationExpiration, "", "expiration of the authorization in RFC3339 format, empty means never")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeAppAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-app-authorization [uid] [appid]",
+		Short: "Revoke the authorization of an app",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAppAuthorization(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdExecAppAuthorized() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-app-authorized [appid] [msg_tx_json_file]",
+		Short: "Execute the messages of a generated tx for the users who authorized the app",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecAppAuthorized(clientCtx.GetFromAddress().String(), args[0], theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
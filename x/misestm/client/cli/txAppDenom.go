@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+const flagAppDenomMetadata = "metadata"
+
+func CmdNewDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new-denom [appid] [id] [amount] [recipient]",
+		Short: "Issue a new denom of an app and mint its initial supply",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var argsMetadata *banktypes.Metadata
+			metadataFile, err := cmd.Flags().GetString(flagAppDenomMetadata)
+			if err != nil {
+				return err
+			}
+			if metadataFile != "" {
+				bz, err := os.ReadFile(metadataFile)
+				if err != nil {
+					return err
+				}
+				argsMetadata = &banktypes.Metadata{}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, argsMetadata); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgNewDenom(args[1], argsAmount, argsMetadata, clientCtx.GetFromAddress().String(), args[3], args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAppDenomMetadata, "", "JSON file of the bank metadata, unit denoms are relative to the app namespace")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMintAppDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-AppDenom [appid] [id] [amount] [recipient]",
+		Short: "Mint a denom issued by an app",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintAppDenom(clientCtx.GetFromAddress().String(), args[0], args[1], argsAmount, args[3])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBurnAppDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-AppDenom [appid] [id] [amount]",
+		Short: "Burn a denom issued by an app from the balance of the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnAppDenom(clientCtx.GetFromAddress().String(), args[0], args[1], argsAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+const (
+	flagAppFeeGrantSpendLimit       = "spend-limit"
+	flagAppFeeGrantPeriodSpendLimit = "period-spend-limit"
+	flagAppFeeGrantExpiry           = "expiry"
+	flagAppFeeGrantTemplate         = "template"
+)
+
+func CmdSetAppFeeGrantTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-AppFeeGrantTemplate [appid] [name] [period]",
+		Short: "Create or replace a fee grant template of an app",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsPeriod, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			spendLimitStr, err := cmd.Flags().GetString(flagAppFeeGrantSpendLimit)
+			if err != nil {
+				return err
+			}
+			argsSpendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+			if err != nil {
+				return err
+			}
+
+			periodSpendLimitStr, err := cmd.Flags().GetString(flagAppFeeGrantPeriodSpendLimit)
+			if err != nil {
+				return err
+			}
+			argsPeriodSpendLimit, err := sdk.ParseCoinsNormalized(periodSpendLimitStr)
+			if err != nil {
+				return err
+			}
+
+			argsExpiry, err := cmd.Flags().GetDuration(flagAppFeeGrantExpiry)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAppFeeGrantTemplate(clientCtx.GetFromAddress().String(), args[0], args[1], argsPeriod, argsSpendLimit, argsPeriodSpendLimit, argsExpiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAppFeeGrantSpendLimit, "", "total spend limit of a grant, empty means unlimited")
+	cmd.Flags().String(flagAppFeeGrantPeriodSpendLimit, "", "spend limit of each period, defaults to the total spend limit")
+	cmd.Flags().Duration(flagAppFeeGrantExpiry, 0, "lifetime of a grant, zero means no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteAppFeeGrantTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-AppFeeGrantTemplate [appid] [name]",
+		Short: "Delete a fee grant template of an app",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteAppFeeGrantTemplate(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGrantAppFeeAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-AppFeeAllowances [appid] [template] [grantees]",
+		Short: "Grant fee allowances of an app to users, grantees are comma separated mises ids",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantAppFeeAllowances(clientCtx.GetFromAddress().String(), args[0], args[1], parseUserListMembers(args[2]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRenewAppFeeAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-AppFeeAllowances [appid] [grantees]",
+		Short: "Renew fee allowances of an app, grantees are comma separated mises ids",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsTemplate, err := cmd.Flags().GetString(flagAppFeeGrantTemplate)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewAppFeeAllowances(clientCtx.GetFromAddress().String(), args[0], argsTemplate, parseUserListMembers(args[1]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAppFeeGrantTemplate, "", "template to renew with, defaults to the template of each grant")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeAppFeeAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-AppFeeAllowances [appid] [grantees]",
+		Short: "Revoke fee allowances of an app, grantees are comma separated mises ids",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAppFeeAllowances(clientCtx.GetFromAddress().String(), args[0], parseUserListMembers(args[1]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+const (
+	flagCommunityDescription = "description"
+	flagCommunityJoinPolicy  = "join-policy"
+)
+
+func CmdCreateCommunity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-Community [owner] [name]",
+		Short: "Create a Community owned by a user or app mises id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsDescription, err := cmd.Flags().GetString(flagCommunityDescription)
+			if err != nil {
+				return err
+			}
+
+			argsJoinPolicy, err := cmd.Flags().GetString(flagCommunityJoinPolicy)
+			if err != nil {
+				return err
+			}
+			joinPolicy, err := parseCommunityJoinPolicy(argsJoinPolicy)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCommunity(clientCtx.GetFromAddress().String(), args[0], args[1], argsDescription, joinPolicy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagCommunityDescription, "", "description of the community")
+	cmd.Flags().String(flagCommunityJoinPolicy, "open", "join policy: open, approval or invite-only")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdJoinCommunity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-Community [community-id] [member]",
+		Short: "Join a Community or accept an invitation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsCommunityId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgJoinCommunity(clientCtx.GetFromAddress().String(), argsCommunityId, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdApproveCommunityMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-Community-member [community-id] [operator] [member]",
+		Short: "Approve a join request or invite a mises id",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsCommunityId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveCommunityMember(clientCtx.GetFromAddress().String(), argsCommunityId, args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdKickCommunityMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kick-Community-member [community-id] [operator] [member]",
+		Short: "Remove a member, join request or invitation from a Community",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsCommunityId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgKickCommunityMember(clientCtx.GetFromAddress().String(), argsCommunityId, args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdChangeCommunityRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-Community-role [community-id] [operator] [member] [role]",
+		Short: "Change the role of a Community member to member, moderator or owner",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsCommunityId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			role, err := parseCommunityRole(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeCommunityRole(clientCtx.GetFromAddress().String(), argsCommunityId, args[1], args[2], role)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseCommunityJoinPolicy(arg string) (types.CommunityJoinPolicy, error) {
+	switch strings.ToLower(arg) {
+	case "open":
+		return types.CommunityJoinPolicyOpen, nil
+	case "approval":
+		return types.CommunityJoinPolicyApproval, nil
+	case "invite-only":
+		return types.CommunityJoinPolicyInviteOnly, nil
+	}
+	return 0, fmt.Errorf("unknown join policy %s", arg)
+}
+
+func parseCommunityRole(arg string) (types.CommunityRole, error) {
+	switch strings.ToLower(arg) {
+	case "member":
+		return types.CommunityRoleMember, nil
+	case "moderator":
+		return types.CommunityRoleModerator, nil
+	case "owner":
+		return types.CommunityRoleOwner, nil
+	}
+	return 0, fmt.Errorf("unknown role %s", arg)
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/base64"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/spf13/cast"
@@ -11,6 +14,11 @@ import (
 	"github.com/mises-id/mises-tm/x/misestm/types"
 )
 
+const (
+	FlagSponsor   = "sponsor"
+	FlagSignature = "signature"
+)
+
 func CmdCreateDidRegistry() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-DidRegistry [did] [pkeyDid] [pkeyType] [pkeyMultibase] [version]",
@@ -44,6 +52,18 @@ func CmdCreateDidRegistry() *cobra.Command {
 			}
 
 			msg := types.NewMsgCreateDidRegistry(clientCtx.GetFromAddress().String(), argsDid, argsPkeyDid, argsPkeyType, argsPkeyMultibase, argsVersion)
+			msg.Sponsor, err = cmd.Flags().GetString(FlagSponsor)
+			if err != nil {
+				return err
+			}
+			signature, err := cmd.Flags().GetString(FlagSignature)
+			if err != nil {
+				return err
+			}
+			msg.Signature, err = base64.StdEncoding.DecodeString(signature)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -51,6 +71,35 @@ func CmdCreateDidRegistry() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagSponsor, "", "app did paying for the creation of the user did")
+	cmd.Flags().String(FlagSignature, "", "base64 signature of the user over the sponsored did, see sign-sponsored-did")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSignSponsoredDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-sponsored-did [did] [pkeyMultibase] [sponsor]",
+		Short: "Sign with the user key the creation of its did sponsored by an app",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes := types.SponsoredDidSignBytes(clientCtx.ChainID, args[0], args[1], args[2])
+			signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), base64.StdEncoding.EncodeToString(signature))
+			return err
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -8,9 +8,30 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/mises-id/mises-tm/x/misestm/types"
 )
 
+const (
+	flagNFTRoyaltyRecipient   = "royalty-recipient"
+	flagNFTRoyaltyBasisPoints = "royalty-bps"
+	flagNFTPrice              = "price"
+	flagNFTSoulbound          = "soulbound"
+	flagNFTData               = "data"
+	flagNFTSenderDid          = "sender-did"
+	flagNFTClassID            = "class-id"
+)
+
+// nftDataFromFlags wraps the JSON data of a NFT in a NFTMetadata, nil when no data is given
+func nftDataFromFlags(cmd *cobra.Command) (*codectypes.Any, error) {
+	data, err := cmd.Flags().GetString(flagNFTData)
+	if err != nil || data == "" {
+		return nil, err
+	}
+	return codectypes.NewAnyWithValue(&types.NFTMetadata{Json: data})
+}
+
 func CmdCreateNFTClass() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-nft-class [id] [name] [uri] [schema] [symbol]",
@@ -47,7 +68,21 @@ func CmdCreateNFTClass() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgNewNFTClass(argsId, argsName, argsUri, argsSchema, argsSymbol, nil, clientCtx.GetFromAddress().String())
+			argsRoyaltyRecipient, err := cmd.Flags().GetString(flagNFTRoyaltyRecipient)
+			if err != nil {
+				return err
+			}
+			argsRoyaltyBasisPoints, err := cmd.Flags().GetUint32(flagNFTRoyaltyBasisPoints)
+			if err != nil {
+				return err
+			}
+
+			argsSoulbound, err := cmd.Flags().GetBool(flagNFTSoulbound)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgNewNFTClass(argsId, argsName, argsUri, argsSchema, argsSymbol, nil, clientCtx.GetFromAddress().String(), argsRoyaltyRecipient, argsRoyaltyBasisPoints, argsSoulbound)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -55,6 +90,9 @@ func CmdCreateNFTClass() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagNFTRoyaltyRecipient, "", "address receiving the royalty of the sales")
+	cmd.Flags().Uint32(flagNFTRoyaltyBasisPoints, 0, "royalty in basis points of the sale price")
+	cmd.Flags().Bool(flagNFTSoulbound, false, "nfts of the class can't be transferred, only burned or revoked")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -90,12 +128,275 @@ func CmdMintNFT() *cobra.Command {
 				return err
 			}
 
+			argsData, err := nftDataFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintNFT(argsId, argsClassId, argsName, argsUri, argsData, clientCtx.GetFromAddress().String(), argsRecipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagNFTData, "", "JSON data of the nft, checked against the class schema")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateNFTClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft-class [classid] [name] [uri]",
+		Short: "Update a NFT Class",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsClassId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsName, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
+			argsUri, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateNFTClass(argsClassId, argsClassId, argsName, argsUri, nil, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [id] [classid] [uri]",
+		Short: "update a NFT",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsClassId, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
+			argsUri, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+
+			argsData, err := nftDataFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateNFT(argsId, argsClassId, argsUri, argsData, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagNFTData, "", "new JSON data of the nft, checked against the class schema, empty keeps the data")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-nft [id] [classid]",
+		Short: "burn a NFT",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsClassId, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgMintNFT(argsId, argsClassId, argsName, argsUri, nil, clientCtx.GetFromAddress().String(), argsRecipient)
+			msg := types.NewMsgBurnNFT(argsId, argsClassId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-nft [id] [classid]",
+		Short: "revoke a NFT of a soulbound class",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsClassId, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeNFT(argsId, argsClassId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-nft [id] [classid] [recipient]",
+		Short: "transfer a NFT, a sale with a price is signed by the recipient too",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsClassId, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
+			argsRecipient, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+
+			priceStr, err := cmd.Flags().GetString(flagNFTPrice)
+			if err != nil {
+				return err
+			}
+			argsPrice, err := sdk.ParseCoinsNormalized(priceStr)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNFT(argsId, argsClassId, clientCtx.GetFromAddress().String(), argsRecipient, argsPrice)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagNFTPrice, "", "sale price paid by the recipient, the class royalty is taken from it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferDidNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-did-nft [id] [classid] [recipient-did]",
+		Short: "transfer a NFT to a mises id, a sale with a price is signed by the recipient too",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsId, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsClassId, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+
+			argsRecipient, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+
+			argsSender, err := cmd.Flags().GetString(flagNFTSenderDid)
+			if err != nil {
+				return err
+			}
+
+			priceStr, err := cmd.Flags().GetString(flagNFTPrice)
+			if err != nil {
+				return err
+			}
+			argsPrice, err := sdk.ParseCoinsNormalized(priceStr)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if argsSender == "" {
+				argsSender = types.DIDPrefixForUser + clientCtx.GetFromAddress().String()
+			}
+
+			msg := types.NewMsgTransferDidNFT(argsId, argsClassId, argsSender, argsRecipient, argsPrice)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -103,6 +404,8 @@ func CmdMintNFT() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagNFTSenderDid, "", "mises id of the owner, defaults to the user mises id of the signer")
+	cmd.Flags().String(flagNFTPrice, "", "sale price paid by the recipient, the class royalty is taken from it")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+const (
+	flagUserListDescription = "description"
+	flagUserListPrivate     = "private"
+)
+
+func CmdCreateUserList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-UserList [owner] [name] [members]",
+		Short: "Create a UserList, members are comma separated mises ids",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsDescription, err := cmd.Flags().GetString(flagUserListDescription)
+			if err != nil {
+				return err
+			}
+
+			argsPrivate, err := cmd.Flags().GetBool(flagUserListPrivate)
+			if err != nil {
+				return err
+			}
+			visibility := types.UserListVisibilityPublic
+			if argsPrivate {
+				visibility = types.UserListVisibilityPrivate
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateUserList(clientCtx.GetFromAddress().String(), args[0], args[1], argsDescription, visibility, parseUserListMembers(args[2]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagUserListDescription, "", "description of the list")
+	cmd.Flags().Bool(flagUserListPrivate, false, "hide the list from member queries")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRenameUserList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename-UserList [id] [name] [version]",
+		Short: "Rename a UserList",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsVersion, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenameUserList(clientCtx.GetFromAddress().String(), argsId, args[1], argsVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAddUserListMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-UserList-members [id] [members] [version]",
+		Short: "Add members to a UserList, members are comma separated mises ids",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsVersion, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddUserListMembers(clientCtx.GetFromAddress().String(), argsId, parseUserListMembers(args[1]), argsVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveUserListMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-UserList-members [id] [members] [version]",
+		Short: "Remove members from a UserList, members are comma separated mises ids",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsVersion, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveUserListMembers(clientCtx.GetFromAddress().String(), argsId, parseUserListMembers(args[1]), argsVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteUserList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-UserList [id] [version]",
+		Short: "Delete a UserList",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argsVersion, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteUserList(clientCtx.GetFromAddress().String(), argsId, argsVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseUserListMembers(arg string) []string {
+	members := []string{}
+	for _, member := range strings.Split(arg, ",") {
+		member = strings.TrimSpace(member)
+		if member != "" {
+			members = append(members, member)
+		}
+	}
+	return members
+}
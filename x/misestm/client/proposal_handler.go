@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/mises-id/mises-tm/x/misestm/client/cli"
+	"github.com/mises-id/mises-tm/x/misestm/client/rest"
+)
+
+// AppVerificationProposalHandler is the app verification proposal handler.
+var AppVerificationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitAppVerificationProposal, rest.AppVerificationProposalRESTHandler)
@@ -105,6 +105,11 @@ func HandleQueryUserRelationRequest(clientCtx client.Context) http.HandlerFunc {
 		}
 		misesIDStr := r.Form.Get("mises_id")
 		filterStr := r.Form.Get("filter")
+		sortStr := r.Form.Get("sort")
+		followedSince, err := strconv.ParseInt(r.Form.Get("followed_since"), 10, 64)
+		if err != nil {
+			followedSince = 0
+		}
 		keyStr := r.Form.Get("pagination.key")
 		offsetStr := r.Form.Get("pagination.offset")
 		offset, err := strconv.Atoi(offsetStr)
@@ -121,8 +126,10 @@ func HandleQueryUserRelationRequest(clientCtx client.Context) http.HandlerFunc {
 		queryClient := types.NewRestQueryClient(clientCtx)
 
 		params := &types.RestQueryUserRelationRequest{
-			MisesUid: misesIDStr,
-			Filter:   filterStr,
+			MisesUid:      misesIDStr,
+			Filter:        filterStr,
+			Sort:          sortStr,
+			FollowedSince: followedSince,
 			Pagination: &query.PageRequest{
 				Key:        []byte(keyStr),
 				Offset:     uint64(offset),
@@ -193,3 +200,67 @@ func HandleQueryAppFeeGrantRequest(clientCtx client.Context) http.HandlerFunc {
 		PostProcessResponseBare(w, clientCtx, resp)
 	}
 }
+
+// HandleQueryAppByDomainRequest the QueryAppByDomainRequest http handler
+func HandleQueryAppByDomainRequest(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		domainStr := r.Form.Get("domain")
+
+		queryClient := types.NewRestQueryClient(clientCtx)
+
+		params := &types.RestQueryAppByDomainRequest{
+			Domain: domainStr,
+		}
+
+		resp, err := queryClient.QueryAppByDomain(context.Background(), params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		PostProcessResponseBare(w, clientCtx, resp)
+	}
+}
+
+// HandleQueryNFTRequest the QueryNFTRequest http handler
+func HandleQueryNFTRequest(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		misesIDStr := r.Form.Get("mises_id")
+		classIDStr := r.Form.Get("class_id")
+		keyStr := r.Form.Get("pagination.key")
+		limitStr := r.Form.Get("pagination.limit")
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			limit = 10
+		}
+
+		queryClient := types.NewRestQueryClient(clientCtx)
+
+		params := &types.RestQueryNFTRequest{
+			MisesId: misesIDStr,
+			ClassId: classIDStr,
+			Pagination: &query.PageRequest{
+				Key:   []byte(keyStr),
+				Limit: uint64(limit),
+			},
+		}
+
+		resp, err := queryClient.QueryNFT(context.Background(), params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		PostProcessResponseBare(w, clientCtx, resp)
+	}
+}
@@ -108,6 +108,8 @@ func RegisterRoutes(clientCtx client.Context, rtr *mux.Router) {
 	r.HandleFunc("/mises/user/relation", HandleQueryUserRelationRequest(clientCtx)).Methods(MethodGet)
 	r.HandleFunc("/mises/app", HandleQueryAppRequest(clientCtx)).Methods(MethodGet)
 	r.HandleFunc("/mises/app/feegrant", HandleQueryAppFeeGrantRequest(clientCtx)).Methods(MethodGet)
+	r.HandleFunc("/mises/app/by-domain", HandleQueryAppByDomainRequest(clientCtx)).Methods(MethodGet)
+	r.HandleFunc("/mises/nft", HandleQueryNFTRequest(clientCtx)).Methods(MethodGet)
 
 	r.HandleFunc("/mises/tx", HandleQueryTxRequest(clientCtx)).Methods(MethodGet)
 
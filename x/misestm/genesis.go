@@ -9,8 +9,13 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	for _, elem := range genState.MisesAccountList {
 		k.SetMisesAccount(ctx, *elem)
+		if elem.Sponsor != "" {
+			k.SetAppSponsoredAccount(ctx, elem.Sponsor, elem.MisesID)
+		}
 	}
 
 	// this line is used by starport scaffolding # genesis/module/init
@@ -22,9 +27,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set UserInfo count
 	k.SetUserInfoCount(ctx, genState.UserInfoCount)
 
-	// Set all the UserRelation
+	// Set all the UserRelation and rebuild their indexes
 	for _, elem := range genState.UserRelationList {
 		k.SetUserRelation(ctx, *elem)
+		k.SetUserRelationExist(ctx, elem.UidFrom, elem.UidTo, elem.Id)
+		k.SetUserRelationExpiryQueue(ctx, *elem)
 	}
 
 	// Set UserRelation count
@@ -33,6 +40,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the AppInfo
 	for _, elem := range genState.AppInfoList {
 		k.SetAppInfo(ctx, *elem)
+		if elem.PubInfo != nil {
+			for _, domain := range elem.PubInfo.Domains {
+				k.SetAppDomain(ctx, domain, elem.Appid)
+			}
+		}
 	}
 
 	// Set AppInfo count
@@ -46,12 +58,64 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set DidRegistry count
 	k.SetDidRegistryCount(ctx, genState.DidRegistryCount)
 
+	// Set all the UserList
+	for _, elem := range genState.UserListList {
+		k.SetUserList(ctx, *elem)
+	}
+
+	// Set UserList count
+	k.SetUserListCount(ctx, genState.UserListCount)
+
+	// Set all the Community
+	for _, elem := range genState.CommunityList {
+		k.SetCommunity(ctx, *elem)
+	}
+
+	// Set Community count
+	k.SetCommunityCount(ctx, genState.CommunityCount)
+
+	// Set all the CommunityMember
+	for _, elem := range genState.CommunityMemberList {
+		k.SetCommunityMember(ctx, *elem)
+	}
+
+	// Set all the AppFeeGrantTemplate
+	for _, elem := range genState.AppFeeGrantTemplateList {
+		k.SetAppFeeGrantTemplate(ctx, *elem)
+	}
+
+	// Set all the AppFeeGrantee
+	for _, elem := range genState.AppFeeGranteeList {
+		k.SetAppFeeGrantee(ctx, *elem)
+	}
+
+	// Set all the AppAuthorization
+	for _, elem := range genState.AppAuthorizationList {
+		k.SetAppAuthorization(ctx, *elem)
+	}
+
+	// Set all the AppDenom
+	for _, elem := range genState.AppDenomList {
+		k.SetAppDenom(ctx, *elem)
+	}
+
+	// Set all the NFTClassInfo
+	for _, elem := range genState.NFTClassInfoList {
+		k.SetNFTClassInfo(ctx, *elem)
+	}
+
+	// Set all the AppSponsorStat, overriding the statistics counted from the sponsored accounts
+	for _, elem := range genState.AppSponsorStatList {
+		k.SetAppSponsorStat(ctx, *elem)
+	}
+
 	// this line is used by starport scaffolding # ibc/genesis/init
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
 
 	MisesAccountList := k.GetAllMisesAccount(ctx)
 	for _, elem := range MisesAccountList {
@@ -100,6 +164,75 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Set the current count
 	genesis.DidRegistryCount = k.GetDidRegistryCount(ctx)
 
+	// Get all UserList
+	UserListList := k.GetAllUserList(ctx)
+	for _, elem := range UserListList {
+		elem := elem
+		genesis.UserListList = append(genesis.UserListList, &elem)
+	}
+
+	// Set the current count
+	genesis.UserListCount = k.GetUserListCount(ctx)
+
+	// Get all Community
+	CommunityList := k.GetAllCommunity(ctx)
+	for _, elem := range CommunityList {
+		elem := elem
+		genesis.CommunityList = append(genesis.CommunityList, &elem)
+	}
+
+	// Set the current count
+	genesis.CommunityCount = k.GetCommunityCount(ctx)
+
+	// Get all CommunityMember
+	CommunityMemberList := k.GetAllCommunityMember(ctx)
+	for _, elem := range CommunityMemberList {
+		elem := elem
+		genesis.CommunityMemberList = append(genesis.CommunityMemberList, &elem)
+	}
+
+	// Get all AppFeeGrantTemplate
+	AppFeeGrantTemplateList := k.GetAllAppFeeGrantTemplate(ctx)
+	for _, elem := range AppFeeGrantTemplateList {
+		elem := elem
+		genesis.AppFeeGrantTemplateList = append(genesis.AppFeeGrantTemplateList, &elem)
+	}
+
+	// Get all AppFeeGrantee
+	AppFeeGranteeList := k.GetAllAppFeeGrantee(ctx)
+	for _, elem := range AppFeeGranteeList {
+		elem := elem
+		genesis.AppFeeGranteeList = append(genesis.AppFeeGranteeList, &elem)
+	}
+
+	// Get all AppAuthorization
+	AppAuthorizationList := k.GetAllAppAuthorization(ctx)
+	for _, elem := range AppAuthorizationList {
+		elem := elem
+		genesis.AppAuthorizationList = append(genesis.AppAuthorizationList, &elem)
+	}
+
+	// Get all AppDenom
+	AppDenomList := k.GetAllAppDenom(ctx)
+	for _, elem := range AppDenomList {
+		elem := elem
+		genesis.AppDenomList = append(genesis.AppDenomList, &elem)
+	}
+
+	// Get all NFTClassInfo
+	NFTClassInfoList := k.GetAllNFTClassInfo(ctx)
+	for _, elem := range NFTClassInfoList {
+		elem := elem
+		genesis.NFTClassInfoList = append(genesis.NFTClassInfoList, &elem)
+	}
+
+	// Get all AppSponsorStat
+	AppSponsorStatList := k.GetAllAppSponsorStat(ctx)
+	for _, elem := range AppSponsorStatList {
+		elem := elem
+		genesis.AppSponsorStatList = append(genesis.AppSponsorStatList, &elem)
+	}
+
 	// this line is used by starport scaffolding # ibc/genesis/export
 
 	return genesis
@@ -0,0 +1,105 @@
+package misestm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mises-id/mises-tm/testutil/simapp"
+	"github.com/mises-id/mises-tm/x/misestm"
+	"github.com/mises-id/mises-tm/x/misestm/keeper"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func genesisWithUsers(names ...string) *types.GenesisState {
+	gs := types.DefaultGenesis()
+	for i, name := range names {
+		uid := types.DIDPrefixForUser + sdk.AccAddress([]byte(name)).String()
+		gs.DidRegistryList = append(gs.DidRegistryList, &types.DidRegistry{Id: uint64(i), Did: uid})
+		gs.UserInfoList = append(gs.UserInfoList, &types.UserInfo{Id: uint64(i), Uid: uid})
+		gs.MisesAccountList = append(gs.MisesAccountList, &types.MisesAccount{
+			MisesID: uid, DidRegistryID: uint64(i), InfoID: uint64(i), DidType: types.DIDTypeUser,
+		})
+	}
+	gs.DidRegistryCount = uint64(len(names))
+	gs.UserInfoCount = uint64(len(names))
+	return gs
+}
+
+func TestInitGenesisRebuildsIndexes(t *testing.T) {
+	gs := genesisWithUsers("genesis user 1", "genesis user 2")
+	from, to := gs.UserInfoList[0].Uid, gs.UserInfoList[1].Uid
+	gs.UserRelationList = []*types.UserRelation{{Id: 0, UidFrom: from, UidTo: to, IsFollowing: true}}
+	gs.UserRelationCount = 1
+	require.NoError(t, gs.Validate())
+
+	a := simapp.New(t.TempDir())
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{})
+	misestm.InitGenesis(ctx, a.MisestmKeeper, *gs)
+
+	require.True(t, a.MisestmKeeper.HasUserRelationByMisesID(ctx, from, to))
+	require.Equal(t, uint64(0), a.MisestmKeeper.GetUserRelationByMisesID(ctx, from, to).Id)
+
+	_, broken := keeper.AllInvariants(a.MisestmKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestGenesisAppSponsorStat(t *testing.T) {
+	gs := genesisWithUsers("genesis user 1", "genesis user 2")
+	sponsor := types.DIDPrefixForApp + sdk.AccAddress([]byte("genesis app")).String()
+	gs.MisesAccountList[0].Sponsor = sponsor
+	gs.AppSponsorStatList = []*types.AppSponsorStat{{Appid: sponsor, SponsoredCount: 1, LastSponsoredHeight: 7}}
+	require.NoError(t, gs.Validate())
+
+	a := simapp.New(t.TempDir())
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{Height: 50})
+	misestm.InitGenesis(ctx, a.MisestmKeeper, *gs)
+
+	// the imported stat is kept rather than recounted at the import height
+	require.Equal(t, *gs.AppSponsorStatList[0], a.MisestmKeeper.GetAppSponsorStat(ctx, sponsor))
+	require.Equal(t, gs.AppSponsorStatList, misestm.ExportGenesis(ctx, a.MisestmKeeper).AppSponsorStatList)
+
+	gs.AppSponsorStatList[0].SponsoredCount = 2
+	require.Error(t, gs.Validate())
+}
+
+func TestGenesisValidateReferences(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(gs *types.GenesisState)
+	}{
+		{
+			desc:   "id not lower than count",
+			modify: func(gs *types.GenesisState) { gs.UserInfoCount = 1 },
+		},
+		{
+			desc:   "missing did registry",
+			modify: func(gs *types.GenesisState) { gs.MisesAccountList[0].DidRegistryID = 5 },
+		},
+		{
+			desc:   "did registry of another account",
+			modify: func(gs *types.GenesisState) { gs.MisesAccountList[0].DidRegistryID = 1 },
+		},
+		{
+			desc:   "user info of another account",
+			modify: func(gs *types.GenesisState) { gs.MisesAccountList[0].InfoID = 1 },
+		},
+		{
+			desc:   "did prefix not matching did type",
+			modify: func(gs *types.GenesisState) { gs.MisesAccountList[0].DidType = types.DIDTypeApp },
+		},
+		{
+			desc:   "duplicated account",
+			modify: func(gs *types.GenesisState) { gs.MisesAccountList[1] = gs.MisesAccountList[0] },
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			gs := genesisWithUsers("genesis user 1", "genesis user 2")
+			require.NoError(t, gs.Validate())
+			tc.modify(gs)
+			require.Error(t, gs.Validate())
+		})
+	}
+}
@@ -54,6 +54,46 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CreateDidRegistry(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateUserList:
+			res, err := msgServer.CreateUserList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRenameUserList:
+			res, err := msgServer.RenameUserList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddUserListMembers:
+			res, err := msgServer.AddUserListMembers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRemoveUserListMembers:
+			res, err := msgServer.RemoveUserListMembers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeleteUserList:
+			res, err := msgServer.DeleteUserList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateCommunity:
+			res, err := msgServer.CreateCommunity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgJoinCommunity:
+			res, err := msgServer.JoinCommunity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgApproveCommunityMember:
+			res, err := msgServer.ApproveCommunityMember(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgKickCommunityMember:
+			res, err := msgServer.KickCommunityMember(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgChangeCommunityRole:
+			res, err := msgServer.ChangeCommunityRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
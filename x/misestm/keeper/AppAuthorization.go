@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetAppAuthorization set a specific AppAuthorization in the store
+func (k Keeper) SetAppAuthorization(ctx sdk.Context, AppAuthorization types.AppAuthorization) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationKey))
+	b := k.cdc.MustMarshal(&AppAuthorization)
+	store.Set(GetAppAuthorizationKeyBytes(AppAuthorization.Uid, AppAuthorization.Appid), b)
+
+	appStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationByAppKey))
+	appStore.Set(GetAppAuthorizationKeyBytes(AppAuthorization.Appid, AppAuthorization.Uid), []byte{1})
+}
+
+// GetAppAuthorization returns the authorization of an app by a user
+func (k Keeper) GetAppAuthorization(ctx sdk.Context, uid string, appid string) (types.AppAuthorization, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationKey))
+	var AppAuthorization types.AppAuthorization
+	bz := store.Get(GetAppAuthorizationKeyBytes(uid, appid))
+	if bz == nil {
+		return AppAuthorization, false
+	}
+	k.cdc.MustUnmarshal(bz, &AppAuthorization)
+	return AppAuthorization, true
+}
+
+// RemoveAppAuthorization removes a AppAuthorization from the store
+func (k Keeper) RemoveAppAuthorization(ctx sdk.Context, uid string, appid string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationKey))
+	store.Delete(GetAppAuthorizationKeyBytes(uid, appid))
+
+	appStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationByAppKey))
+	appStore.Delete(GetAppAuthorizationKeyBytes(appid, uid))
+}
+
+// GetAllAppAuthorization returns all AppAuthorization
+func (k Keeper) GetAllAppAuthorization(ctx sdk.Context) (list []types.AppAuthorization) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppAuthorizationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AppAuthorization
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsAppAuthorized checks a user authorized an app for the scope and the authorization did not expire
+func (k Keeper) IsAppAuthorized(ctx sdk.Context, uid string, appid string, scope string) bool {
+	AppAuthorization, found := k.GetAppAuthorization(ctx, uid, appid)
+	if !found || AppAuthorization.IsExpired(ctx.BlockTime()) {
+		return false
+	}
+	return AppAuthorization.HasScope(scope)
+}
+
+// GetAppAuthorizationPrefix returns the prefix of the authorizations of a user, or of the users of an app in the app index
+func GetAppAuthorizationPrefix(did string) []byte {
+	return append([]byte(did), '/')
+}
+
+// GetAppAuthorizationKeyBytes returns the key of a AppAuthorization, or of its app index entry with the DIDs swapped
+func GetAppAuthorizationKeyBytes(did string, other string) []byte {
+	return append(GetAppAuthorizationPrefix(did), []byte(other)...)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppAuthorization(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	now := time.Unix(10000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+	expiration := now.Add(time.Hour)
+
+	items := []types.AppAuthorization{
+		{Uid: "did:mises:user1", Appid: "did:misesapp:app1", Scopes: []string{types.AppAuthScopeUpdateRelations}},
+		{Uid: "did:mises:user1", Appid: "did:misesapp:app2", Scopes: []string{types.AppAuthScopeUpdateUserInfo}, Expiration: &expiration},
+		{Uid: "did:mises:user2", Appid: "did:misesapp:app1", Scopes: []string{types.AppAuthScopeReadPrivateInfo}},
+	}
+	for _, item := range items {
+		keeper.SetAppAuthorization(ctx, item)
+	}
+
+	assert.True(t, keeper.IsAppAuthorized(ctx, "did:mises:user1", "did:misesapp:app1", types.AppAuthScopeUpdateRelations))
+	assert.False(t, keeper.IsAppAuthorized(ctx, "did:mises:user1", "did:misesapp:app1", types.AppAuthScopeUpdateUserInfo))
+	assert.True(t, keeper.IsAppAuthorized(ctx, "did:mises:user1", "did:misesapp:app2", types.AppAuthScopeUpdateUserInfo))
+	assert.False(t, keeper.IsAppAuthorized(ctx.WithBlockTime(expiration), "did:mises:user1", "did:misesapp:app2", types.AppAuthScopeUpdateUserInfo))
+
+	byUser, err := keeper.AppAuthorizationsByUser(wctx, &types.QueryAppAuthorizationsByUserRequest{Uid: "did:mises:user1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AppAuthorization{&items[0], &items[1]}, byUser.Authorizations)
+
+	byApp, err := keeper.AppAuthorizationsByApp(wctx, &types.QueryAppAuthorizationsByAppRequest{Appid: "did:misesapp:app1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AppAuthorization{&items[0], &items[2]}, byApp.Authorizations)
+
+	keeper.RemoveAppAuthorization(ctx, "did:mises:user1", "did:misesapp:app1")
+	assert.False(t, keeper.IsAppAuthorized(ctx, "did:mises:user1", "did:misesapp:app1", types.AppAuthScopeUpdateRelations))
+	byApp, err = keeper.AppAuthorizationsByApp(wctx, &types.QueryAppAuthorizationsByAppRequest{Appid: "did:misesapp:app1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AppAuthorization{&items[2]}, byApp.Authorizations)
+	assert.Len(t, keeper.GetAllAppAuthorization(ctx), 2)
+}
+
+func TestAppAuthorizedMsgScopes(t *testing.T) {
+	scope, ok := types.AppAuthScopeOfMsg(&types.MsgUpdateUserRelation{})
+	assert.True(t, ok)
+	assert.Equal(t, types.AppAuthScopeUpdateRelations, scope)
+
+	// an app can't use its authorizations to authorize other apps
+	_, ok = types.AppAuthScopeOfMsg(&types.MsgAuthorizeApp{})
+	assert.False(t, ok)
+	_, ok = types.AppAuthScopeOfMsg(&types.MsgExecAppAuthorized{})
+	assert.False(t, ok)
+}
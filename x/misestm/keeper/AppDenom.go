@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetAppDenom set a specific AppDenom in the store
+func (k Keeper) SetAppDenom(ctx sdk.Context, AppDenom types.AppDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDenomKey))
+	b := k.cdc.MustMarshal(&AppDenom)
+	store.Set([]byte(AppDenom.Denom), b)
+
+	appStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDenomByAppKey))
+	appStore.Set(GetAppDenomByAppKeyBytes(AppDenom.Appid, AppDenom.Denom), []byte{1})
+}
+
+// GetAppDenom returns a AppDenom from its bank denom
+func (k Keeper) GetAppDenom(ctx sdk.Context, denom string) (types.AppDenom, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDenomKey))
+	var AppDenom types.AppDenom
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return AppDenom, false
+	}
+	k.cdc.MustUnmarshal(bz, &AppDenom)
+	return AppDenom, true
+}
+
+// GetAllAppDenom returns all AppDenom
+func (k Keeper) GetAllAppDenom(ctx sdk.Context) (list []types.AppDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDenomKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AppDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAppDenomCount returns the number of denoms issued by an app
+func (k Keeper) GetAppDenomCount(ctx sdk.Context, appid string) uint64 {
+	appStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDenomByAppKey))
+	iterator := sdk.KVStorePrefixIterator(appStore, GetAppDenomByAppPrefix(appid))
+
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetAppDenomByAppPrefix returns the prefix of the denoms of an app in the app index
+func GetAppDenomByAppPrefix(appid string) []byte {
+	return append([]byte(appid), '/')
+}
+
+// GetAppDenomByAppKeyBytes returns the key of a denom in the app index
+func GetAppDenomByAppKeyBytes(appid string, denom string) []byte {
+	return append(GetAppDenomByAppPrefix(appid), []byte(denom)...)
+}
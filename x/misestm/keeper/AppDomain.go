@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetAppDomain indexes a domain claimed by an app
+func (k Keeper) SetAppDomain(ctx sdk.Context, domain string, appid string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDomainKey))
+	store.Set([]byte(types.NormalizeAppDomain(domain)), []byte(appid))
+}
+
+// GetAppDomain returns the app claiming a domain
+func (k Keeper) GetAppDomain(ctx sdk.Context, domain string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDomainKey))
+	bz := store.Get([]byte(types.NormalizeAppDomain(domain)))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// RemoveAppDomain removes a domain from the index
+func (k Keeper) RemoveAppDomain(ctx sdk.Context, domain string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppDomainKey))
+	store.Delete([]byte(types.NormalizeAppDomain(domain)))
+}
+
+// UpdateAppDomains moves the domain claims of an app from the old to the new domains,
+// nothing changes when another app already claims one of the new domains
+func (k Keeper) UpdateAppDomains(ctx sdk.Context, appid string, oldDomains []string, newDomains []string) error {
+	for _, domain := range newDomains {
+		if types.NormalizeAppDomain(domain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty domain")
+		}
+		if owner, found := k.GetAppDomain(ctx, domain); found && owner != appid {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "domain %s is claimed by %s", domain, owner)
+		}
+	}
+
+	for _, domain := range oldDomains {
+		if owner, found := k.GetAppDomain(ctx, domain); found && owner == appid {
+			k.RemoveAppDomain(ctx, domain)
+		}
+	}
+	for _, domain := range newDomains {
+		k.SetAppDomain(ctx, domain, appid)
+	}
+	return nil
+}
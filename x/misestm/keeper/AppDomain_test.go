@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDomains(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	require.NoError(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app1", nil, []string{"Mises.site", "app.mises.site."}))
+	appid, found := keeper.GetAppDomain(ctx, "mises.site")
+	assert.True(t, found)
+	assert.Equal(t, "did:misesapp:app1", appid)
+	appid, found = keeper.GetAppDomain(ctx, "APP.mises.site")
+	assert.True(t, found)
+	assert.Equal(t, "did:misesapp:app1", appid)
+
+	// another app can't claim the domain, and its claims are left untouched
+	require.NoError(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app2", nil, []string{"other.site"}))
+	require.Error(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app2", []string{"other.site"}, []string{"new.site", "mises.site"}))
+	_, found = keeper.GetAppDomain(ctx, "new.site")
+	assert.False(t, found)
+	appid, _ = keeper.GetAppDomain(ctx, "other.site")
+	assert.Equal(t, "did:misesapp:app2", appid)
+	require.Error(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app2", []string{"other.site"}, []string{" "}))
+
+	// dropped domains become free
+	require.NoError(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app1", []string{"Mises.site", "app.mises.site."}, []string{"mises.site"}))
+	_, found = keeper.GetAppDomain(ctx, "app.mises.site")
+	assert.False(t, found)
+	require.NoError(t, keeper.UpdateAppDomains(ctx, "did:misesapp:app2", []string{"other.site"}, []string{"app.mises.site"}))
+	appid, _ = keeper.GetAppDomain(ctx, "app.mises.site")
+	assert.Equal(t, "did:misesapp:app2", appid)
+	_, found = keeper.GetAppDomain(ctx, "other.site")
+	assert.False(t, found)
+}
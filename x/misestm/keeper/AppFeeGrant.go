@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetAppFeeGrantTemplate set a specific AppFeeGrantTemplate in the store
+func (k Keeper) SetAppFeeGrantTemplate(ctx sdk.Context, AppFeeGrantTemplate types.AppFeeGrantTemplate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGrantTemplateKey))
+	b := k.cdc.MustMarshal(&AppFeeGrantTemplate)
+	store.Set(GetAppFeeGrantKeyBytes(AppFeeGrantTemplate.Appid, AppFeeGrantTemplate.Name), b)
+}
+
+// GetAppFeeGrantTemplate returns a named AppFeeGrantTemplate of an app
+func (k Keeper) GetAppFeeGrantTemplate(ctx sdk.Context, appid string, name string) (types.AppFeeGrantTemplate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGrantTemplateKey))
+	var AppFeeGrantTemplate types.AppFeeGrantTemplate
+	bz := store.Get(GetAppFeeGrantKeyBytes(appid, name))
+	if bz == nil {
+		return AppFeeGrantTemplate, false
+	}
+	k.cdc.MustUnmarshal(bz, &AppFeeGrantTemplate)
+	return AppFeeGrantTemplate, true
+}
+
+// RemoveAppFeeGrantTemplate removes a AppFeeGrantTemplate from the store
+func (k Keeper) RemoveAppFeeGrantTemplate(ctx sdk.Context, appid string, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGrantTemplateKey))
+	store.Delete(GetAppFeeGrantKeyBytes(appid, name))
+}
+
+// GetAllAppFeeGrantTemplate returns all AppFeeGrantTemplate
+func (k Keeper) GetAllAppFeeGrantTemplate(ctx sdk.Context) (list []types.AppFeeGrantTemplate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGrantTemplateKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AppFeeGrantTemplate
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAppFeeGrantee set a specific AppFeeGrantee in the store
+func (k Keeper) SetAppFeeGrantee(ctx sdk.Context, AppFeeGrantee types.AppFeeGrantee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGranteeKey))
+	b := k.cdc.MustMarshal(&AppFeeGrantee)
+	store.Set(GetAppFeeGrantKeyBytes(AppFeeGrantee.Appid, AppFeeGrantee.Grantee), b)
+}
+
+// GetAppFeeGrantee returns the grant record of a user DID by an app
+func (k Keeper) GetAppFeeGrantee(ctx sdk.Context, appid string, grantee string) (types.AppFeeGrantee, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGranteeKey))
+	var AppFeeGrantee types.AppFeeGrantee
+	bz := store.Get(GetAppFeeGrantKeyBytes(appid, grantee))
+	if bz == nil {
+		return AppFeeGrantee, false
+	}
+	k.cdc.MustUnmarshal(bz, &AppFeeGrantee)
+	return AppFeeGrantee, true
+}
+
+// RemoveAppFeeGrantee removes a AppFeeGrantee from the store
+func (k Keeper) RemoveAppFeeGrantee(ctx sdk.Context, appid string, grantee string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGranteeKey))
+	store.Delete(GetAppFeeGrantKeyBytes(appid, grantee))
+}
+
+// GetAllAppFeeGrantee returns all AppFeeGrantee
+func (k Keeper) GetAllAppFeeGrantee(ctx sdk.Context) (list []types.AppFeeGrantee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppFeeGranteeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AppFeeGrantee
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAppFeeGrantPrefix returns the prefix of the templates or grantees of an app
+func GetAppFeeGrantPrefix(appid string) []byte {
+	return append([]byte(appid), '/')
+}
+
+// GetAppFeeGrantKeyBytes returns the key of a template or grantee, grouped by app
+func GetAppFeeGrantKeyBytes(appid string, name string) []byte {
+	return append(GetAppFeeGrantPrefix(appid), []byte(name)...)
+}
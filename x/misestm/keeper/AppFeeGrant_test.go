@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppFeeGrantTemplate(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	template := types.AppFeeGrantTemplate{
+		Appid:      "did:misesapp:app1",
+		Name:       "default",
+		Period:     time.Hour,
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("umis", 1000)),
+		Expiry:     24 * time.Hour,
+	}
+	keeper.SetAppFeeGrantTemplate(ctx, template)
+	keeper.SetAppFeeGrantTemplate(ctx, types.AppFeeGrantTemplate{Appid: "did:misesapp:app2", Name: "default"})
+
+	got, found := keeper.GetAppFeeGrantTemplate(ctx, template.Appid, template.Name)
+	assert.True(t, found)
+	assert.Equal(t, template, got)
+
+	resp, err := keeper.AppFeeGrantTemplates(wctx, &types.QueryAppFeeGrantTemplatesRequest{Appid: template.Appid})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AppFeeGrantTemplate{&template}, resp.Templates)
+
+	blockTime := time.Unix(1000, 0).UTC()
+	allowance := template.Allowance(blockTime)
+	require.NoError(t, allowance.ValidateBasic())
+	assert.Equal(t, template.SpendLimit, allowance.PeriodSpendLimit)
+	assert.Equal(t, blockTime.Add(template.Period), allowance.PeriodReset)
+	assert.Equal(t, blockTime.Add(template.Expiry), *allowance.Basic.Expiration)
+
+	keeper.RemoveAppFeeGrantTemplate(ctx, template.Appid, template.Name)
+	_, found = keeper.GetAppFeeGrantTemplate(ctx, template.Appid, template.Name)
+	assert.False(t, found)
+	assert.Len(t, keeper.GetAllAppFeeGrantTemplate(ctx), 1)
+}
+
+func TestAppFeeGrantees(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	grantees := []types.AppFeeGrantee{
+		{Appid: "did:misesapp:app1", Grantee: "did:mises:user1", Template: "default"},
+		{Appid: "did:misesapp:app1", Grantee: "did:mises:user2", Template: "default"},
+	}
+	for _, grantee := range grantees {
+		keeper.SetAppFeeGrantee(ctx, grantee)
+	}
+	keeper.SetAppFeeGrantee(ctx, types.AppFeeGrantee{Appid: "did:misesapp:app2", Grantee: "did:mises:user1"})
+
+	resp, err := keeper.AppFeeGrantees(wctx, &types.QueryAppFeeGranteesRequest{Appid: "did:misesapp:app1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AppFeeGrantee{&grantees[0], &grantees[1]}, resp.Grantees)
+
+	keeper.RemoveAppFeeGrantee(ctx, grantees[0].Appid, grantees[0].Grantee)
+	_, found := keeper.GetAppFeeGrantee(ctx, grantees[0].Appid, grantees[0].Grantee)
+	assert.False(t, found)
+	_, found = keeper.GetAppFeeGrantee(ctx, "did:misesapp:app2", "did:mises:user1")
+	assert.True(t, found)
+}
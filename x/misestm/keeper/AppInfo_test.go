@@ -54,3 +54,21 @@ func TestAppInfoCount(t *testing.T) {
 	count := uint64(len(items))
 	assert.Equal(t, count, keeper.GetAppInfoCount(ctx))
 }
+
+func TestAppInfoAdmins(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNAppInfo(keeper, ctx, 1)
+	AppInfo := keeper.GetAppInfo(ctx, items[0].Id)
+	assert.Equal(t, AppInfo.Creator, AppInfo.CurrentOwner())
+	assert.True(t, AppInfo.IsAdmin(AppInfo.Creator))
+	assert.False(t, AppInfo.IsAdmin("admin"))
+
+	AppInfo.Admins = []string{"admin"}
+	AppInfo.Owner = "owner"
+	keeper.SetAppInfo(ctx, AppInfo)
+	AppInfo = keeper.GetAppInfo(ctx, items[0].Id)
+	assert.Equal(t, "owner", AppInfo.CurrentOwner())
+	assert.True(t, AppInfo.IsAdmin("owner"))
+	assert.True(t, AppInfo.IsAdmin("admin"))
+	assert.False(t, AppInfo.IsAdmin(AppInfo.Creator))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// GetAppSponsorStat returns the DID sponsoring statistics of an app
+func (k Keeper) GetAppSponsorStat(ctx sdk.Context, appid string) types.AppSponsorStat {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppSponsorStatKey))
+	stat := types.AppSponsorStat{Appid: appid}
+	bz := store.Get([]byte(appid))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stat)
+	}
+	return stat
+}
+
+// SetAppSponsorStat set the DID sponsoring statistics of an app
+func (k Keeper) SetAppSponsorStat(ctx sdk.Context, stat types.AppSponsorStat) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppSponsorStatKey))
+	b := k.cdc.MustMarshal(&stat)
+	store.Set([]byte(stat.Appid), b)
+}
+
+// GetAllAppSponsorStat returns the DID sponsoring statistics of all apps
+func (k Keeper) GetAllAppSponsorStat(ctx sdk.Context) (list []types.AppSponsorStat) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppSponsorStatKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AppSponsorStat
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAppSponsoredAccount records a DID sponsored by an app and counts it in the app statistics
+func (k Keeper) SetAppSponsoredAccount(ctx sdk.Context, appid string, misesID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AppSponsoredAccountKey))
+	key := GetAppSponsoredAccountKeyBytes(appid, misesID)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte{1})
+
+	stat := k.GetAppSponsorStat(ctx, appid)
+	stat.SponsoredCount++
+	stat.LastSponsoredHeight = ctx.BlockHeight()
+	k.SetAppSponsorStat(ctx, stat)
+}
+
+// GetAppSponsoredAccountPrefix returns the prefix of the DIDs sponsored by an app
+func GetAppSponsoredAccountPrefix(appid string) []byte {
+	return append([]byte(appid), '/')
+}
+
+// GetAppSponsoredAccountKeyBytes returns the key of a DID sponsored by an app
+func GetAppSponsoredAccountKeyBytes(appid string, misesID string) []byte {
+	return append(GetAppSponsoredAccountPrefix(appid), []byte(misesID)...)
+}
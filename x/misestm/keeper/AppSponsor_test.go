@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppSponsoredAccounts(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for _, did := range []string{"did:mises:user1", "did:mises:user2"} {
+		keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: did, DidType: types.DIDTypeUser, Sponsor: "did:misesapp:app1"})
+		keeper.SetAppSponsoredAccount(ctx, "did:misesapp:app1", did)
+	}
+	// counted once
+	keeper.SetAppSponsoredAccount(ctx, "did:misesapp:app1", "did:mises:user1")
+
+	stat, err := keeper.AppSponsorStat(wctx, &types.QueryAppSponsorStatRequest{Appid: "did:misesapp:app1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), stat.Stat.SponsoredCount)
+	assert.Equal(t, int64(10), stat.Stat.LastSponsoredHeight)
+
+	stat, err = keeper.AppSponsorStat(wctx, &types.QueryAppSponsorStatRequest{Appid: "did:misesapp:app2"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), stat.Stat.SponsoredCount)
+
+	accounts, err := keeper.AppSponsoredAccounts(wctx, &types.QueryAppSponsoredAccountsRequest{Appid: "did:misesapp:app1"})
+	require.NoError(t, err)
+	require.Len(t, accounts.Accounts, 2)
+	assert.Equal(t, "did:misesapp:app1", accounts.Accounts[0].Sponsor)
+}
+
+func TestSponsoredDidSignBytes(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signBytes := types.SponsoredDidSignBytes("mises", "did:mises:user1", "zkey", "did:misesapp:app1")
+	signature, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+	assert.True(t, priv.PubKey().VerifySignature(signBytes, signature))
+
+	// the signature is bound to the sponsor and the chain
+	assert.False(t, priv.PubKey().VerifySignature(types.SponsoredDidSignBytes("mises", "did:mises:user1", "zkey", "did:misesapp:app2"), signature))
+	assert.False(t, priv.PubKey().VerifySignature(types.SponsoredDidSignBytes("other", "did:mises:user1", "zkey", "did:misesapp:app1"), signature))
+}
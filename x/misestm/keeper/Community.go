@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// GetCommunityCount get the total number of Community
+func (k Keeper) GetCommunityCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityCountKey))
+	byteKey := types.KeyPrefix(types.CommunityCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to iint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetCommunityCount set the total number of Community
+func (k Keeper) SetCommunityCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityCountKey))
+	byteKey := types.KeyPrefix(types.CommunityCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendCommunity appends a Community in the store with a new id and update the count
+func (k Keeper) AppendCommunity(
+	ctx sdk.Context,
+	Community types.Community,
+) uint64 {
+	// Create the Community
+	count := k.GetCommunityCount(ctx)
+
+	// Set the ID of the appended value
+	Community.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	appendedValue := k.cdc.MustMarshal(&Community)
+	store.Set(GetCommunityIDBytes(Community.Id), appendedValue)
+
+	// Update Community count
+	k.SetCommunityCount(ctx, count+1)
+
+	return count
+}
+
+// SetCommunity set a specific Community in the store
+func (k Keeper) SetCommunity(ctx sdk.Context, Community types.Community) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	b := k.cdc.MustMarshal(&Community)
+	store.Set(GetCommunityIDBytes(Community.Id), b)
+}
+
+// GetCommunity returns a Community from its id
+func (k Keeper) GetCommunity(ctx sdk.Context, id uint64) types.Community {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	var Community types.Community
+	k.cdc.MustUnmarshal(store.Get(GetCommunityIDBytes(id)), &Community)
+	return Community
+}
+
+// HasCommunity checks if the Community exists in the store
+func (k Keeper) HasCommunity(ctx sdk.Context, id uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	return store.Has(GetCommunityIDBytes(id))
+}
+
+// GetCommunityOwner returns the creator of the Community
+func (k Keeper) GetCommunityOwner(ctx sdk.Context, id uint64) string {
+	return k.GetCommunity(ctx, id).Creator
+}
+
+// RemoveCommunity removes a Community from the store
+func (k Keeper) RemoveCommunity(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	store.Delete(GetCommunityIDBytes(id))
+}
+
+// GetAllCommunity returns all Community
+func (k Keeper) GetAllCommunity(ctx sdk.Context) (list []types.Community) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Community
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCommunityIDBytes returns the byte representation of the ID
+func GetCommunityIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetCommunityIDFromBytes returns ID in uint64 format from a byte array
+func GetCommunityIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCommunityMember set a specific CommunityMember in the store
+func (k Keeper) SetCommunityMember(ctx sdk.Context, CommunityMember types.CommunityMember) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberKey))
+	b := k.cdc.MustMarshal(&CommunityMember)
+	store.Set(GetCommunityMemberKeyBytes(CommunityMember.CommunityId, CommunityMember.Member), b)
+
+	didStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberByDidKey))
+	didStore.Set(GetCommunityMemberByDidKeyBytes(CommunityMember.Member, CommunityMember.CommunityId), []byte{1})
+}
+
+// GetCommunityMember returns the membership of a DID in a Community
+func (k Keeper) GetCommunityMember(ctx sdk.Context, communityID uint64, member string) (types.CommunityMember, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberKey))
+	var CommunityMember types.CommunityMember
+	bz := store.Get(GetCommunityMemberKeyBytes(communityID, member))
+	if bz == nil {
+		return CommunityMember, false
+	}
+	k.cdc.MustUnmarshal(bz, &CommunityMember)
+	return CommunityMember, true
+}
+
+// RemoveCommunityMember removes the membership of a DID from the store
+func (k Keeper) RemoveCommunityMember(ctx sdk.Context, communityID uint64, member string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberKey))
+	store.Delete(GetCommunityMemberKeyBytes(communityID, member))
+
+	didStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberByDidKey))
+	didStore.Delete(GetCommunityMemberByDidKeyBytes(member, communityID))
+}
+
+// GetAllCommunityMember returns all CommunityMember
+func (k Keeper) GetAllCommunityMember(ctx sdk.Context) (list []types.CommunityMember) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityMemberKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommunityMember
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCommunityMemberKeyBytes returns the key of a CommunityMember, members are grouped by community
+func GetCommunityMemberKeyBytes(communityID uint64, member string) []byte {
+	return append(GetCommunityIDBytes(communityID), []byte(member)...)
+}
+
+// GetCommunityMemberByDidPrefix returns the prefix of the communities of a DID
+func GetCommunityMemberByDidPrefix(member string) []byte {
+	return append([]byte(member), '/')
+}
+
+// GetCommunityMemberByDidKeyBytes returns the DID index key of a CommunityMember
+func GetCommunityMemberByDidKeyBytes(member string, communityID uint64) []byte {
+	return append(GetCommunityMemberByDidPrefix(member), GetCommunityIDBytes(communityID)...)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNCommunity(keeper *Keeper, ctx sdk.Context, n int) []types.Community {
+	items := make([]types.Community, n)
+	for i := range items {
+		items[i].Creator = "any"
+		items[i].Id = keeper.AppendCommunity(ctx, items[i])
+	}
+	return items
+}
+
+func TestCommunityGet(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 10)
+	for _, item := range items {
+		assert.Equal(t, item, keeper.GetCommunity(ctx, item.Id))
+	}
+}
+
+func TestCommunityExist(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 10)
+	for _, item := range items {
+		assert.True(t, keeper.HasCommunity(ctx, item.Id))
+	}
+}
+
+func TestCommunityRemove(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveCommunity(ctx, item.Id)
+		assert.False(t, keeper.HasCommunity(ctx, item.Id))
+	}
+}
+
+func TestCommunityGetAll(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 10)
+	assert.Equal(t, items, keeper.GetAllCommunity(ctx))
+}
+
+func TestCommunityCount(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 10)
+	count := uint64(len(items))
+	assert.Equal(t, count, keeper.GetCommunityCount(ctx))
+}
+
+func TestCommunityMember(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNCommunity(keeper, ctx, 2)
+	member := types.CommunityMember{CommunityId: items[1].Id, Member: "did:mises:member", Role: types.CommunityRoleModerator}
+	keeper.SetCommunityMember(ctx, member)
+
+	got, found := keeper.GetCommunityMember(ctx, items[1].Id, member.Member)
+	assert.True(t, found)
+	assert.Equal(t, member, got)
+	assert.True(t, got.CanModerate())
+	_, found = keeper.GetCommunityMember(ctx, items[0].Id, member.Member)
+	assert.False(t, found)
+	assert.Equal(t, []types.CommunityMember{member}, keeper.GetAllCommunityMember(ctx))
+
+	keeper.RemoveCommunityMember(ctx, items[1].Id, member.Member)
+	_, found = keeper.GetCommunityMember(ctx, items[1].Id, member.Member)
+	assert.False(t, found)
+}
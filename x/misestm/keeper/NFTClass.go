@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetNFTClassInfo set a specific NFTClassInfo in the store
+func (k Keeper) SetNFTClassInfo(ctx sdk.Context, NFTClassInfo types.NFTClassInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NFTClassInfoKey))
+	b := k.cdc.MustMarshal(&NFTClassInfo)
+	store.Set([]byte(NFTClassInfo.ClassId), b)
+}
+
+// GetNFTClassInfo returns the NFTClassInfo of a class
+func (k Keeper) GetNFTClassInfo(ctx sdk.Context, classID string) (types.NFTClassInfo, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NFTClassInfoKey))
+	NFTClassInfo := types.NFTClassInfo{ClassId: classID}
+	bz := store.Get([]byte(classID))
+	if bz == nil {
+		return NFTClassInfo, false
+	}
+	k.cdc.MustUnmarshal(bz, &NFTClassInfo)
+	return NFTClassInfo, true
+}
+
+// GetAllNFTClassInfo returns all NFTClassInfo
+func (k Keeper) GetAllNFTClassInfo(ctx sdk.Context) (list []types.NFTClassInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NFTClassInfoKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.NFTClassInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsSoulboundNFTClass checks the NFTs of a class can't be transferred
+func (k Keeper) IsSoulboundNFTClass(ctx sdk.Context, classID string) bool {
+	NFTClassInfo, _ := k.GetNFTClassInfo(ctx, classID)
+	return NFTClassInfo.Soulbound
+}
+
+// HasNFTClassRoyalty checks the sales of the NFTs of a class pay a royalty
+func (k Keeper) HasNFTClassRoyalty(ctx sdk.Context, classID string) bool {
+	NFTClassInfo, _ := k.GetNFTClassInfo(ctx, classID)
+	return NFTClassInfo.RoyaltyBasisPoints > 0
+}
+
+// validateNFTData checks the data of a NFT conforms to the schema of its class
+func (k Keeper) validateNFTData(ctx sdk.Context, classID string, data *codectypes.Any) error {
+	NFTClassInfo, _ := k.GetNFTClassInfo(ctx, classID)
+	return NFTClassInfo.ValidateData(data, k.GetParams(ctx).NFTSchemaLimits(), ctx.GasMeter())
+}
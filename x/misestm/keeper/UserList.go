@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// GetUserListCount get the total number of UserList
+func (k Keeper) GetUserListCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListCountKey))
+	byteKey := types.KeyPrefix(types.UserListCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to iint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetUserListCount set the total number of UserList
+func (k Keeper) SetUserListCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListCountKey))
+	byteKey := types.KeyPrefix(types.UserListCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendUserList appends a UserList in the store with a new id and update the count
+func (k Keeper) AppendUserList(
+	ctx sdk.Context,
+	UserList types.UserList,
+) uint64 {
+	// Create the UserList
+	count := k.GetUserListCount(ctx)
+
+	// Set the ID of the appended value
+	UserList.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	appendedValue := k.cdc.MustMarshal(&UserList)
+	store.Set(GetUserListIDBytes(UserList.Id), appendedValue)
+	k.setUserListIndexes(ctx, UserList)
+
+	// Update UserList count
+	k.SetUserListCount(ctx, count+1)
+
+	return count
+}
+
+// SetUserList set a specific UserList in the store
+func (k Keeper) SetUserList(ctx sdk.Context, UserList types.UserList) {
+	if k.HasUserList(ctx, UserList.Id) {
+		k.removeUserListIndexes(ctx, k.GetUserList(ctx, UserList.Id))
+	}
+	k.setUserListIndexes(ctx, UserList)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	b := k.cdc.MustMarshal(&UserList)
+	store.Set(GetUserListIDBytes(UserList.Id), b)
+}
+
+// GetUserList returns a UserList from its id
+func (k Keeper) GetUserList(ctx sdk.Context, id uint64) types.UserList {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	var UserList types.UserList
+	k.cdc.MustUnmarshal(store.Get(GetUserListIDBytes(id)), &UserList)
+	return UserList
+}
+
+// HasUserList checks if the UserList exists in the store
+func (k Keeper) HasUserList(ctx sdk.Context, id uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	return store.Has(GetUserListIDBytes(id))
+}
+
+// GetUserListOwner returns the creator of the UserList
+func (k Keeper) GetUserListOwner(ctx sdk.Context, id uint64) string {
+	return k.GetUserList(ctx, id).Creator
+}
+
+// RemoveUserList removes a UserList from the store
+func (k Keeper) RemoveUserList(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	k.removeUserListIndexes(ctx, k.GetUserList(ctx, id))
+	store.Delete(GetUserListIDBytes(id))
+}
+
+// setUserListIndexes indexes a UserList by owner and members
+func (k Keeper) setUserListIndexes(ctx sdk.Context, UserList types.UserList) {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListOwnerKey))
+	ownerStore.Set(GetUserListIndexKeyBytes(UserList.Owner, UserList.Id), []byte{1})
+
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListMemberKey))
+	for _, member := range UserList.Members {
+		memberStore.Set(GetUserListIndexKeyBytes(member, UserList.Id), []byte{1})
+	}
+}
+
+// removeUserListIndexes removes the owner and member indexes of a UserList
+func (k Keeper) removeUserListIndexes(ctx sdk.Context, UserList types.UserList) {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListOwnerKey))
+	ownerStore.Delete(GetUserListIndexKeyBytes(UserList.Owner, UserList.Id))
+
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListMemberKey))
+	for _, member := range UserList.Members {
+		memberStore.Delete(GetUserListIndexKeyBytes(member, UserList.Id))
+	}
+}
+
+// GetAllUserList returns all UserList
+func (k Keeper) GetAllUserList(ctx sdk.Context) (list []types.UserList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UserList
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetUserListIDBytes returns the byte representation of the ID
+func GetUserListIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetUserListIDFromBytes returns ID in uint64 format from a byte array
+func GetUserListIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetUserListIndexPrefix returns the owner or member index prefix of a mises id
+func GetUserListIndexPrefix(misesID string) []byte {
+	return append([]byte(misesID), '/')
+}
+
+// GetUserListIndexKeyBytes returns the owner or member index key of a UserList
+func GetUserListIndexKeyBytes(misesID string, id uint64) []byte {
+	return append(GetUserListIndexPrefix(misesID), GetUserListIDBytes(id)...)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNUserList(keeper *Keeper, ctx sdk.Context, n int) []types.UserList {
+	items := make([]types.UserList, n)
+	for i := range items {
+		items[i].Creator = "any"
+		items[i].Id = keeper.AppendUserList(ctx, items[i])
+	}
+	return items
+}
+
+func TestUserListGet(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserList(keeper, ctx, 10)
+	for _, item := range items {
+		assert.Equal(t, item, keeper.GetUserList(ctx, item.Id))
+	}
+}
+
+func TestUserListExist(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserList(keeper, ctx, 10)
+	for _, item := range items {
+		assert.True(t, keeper.HasUserList(ctx, item.Id))
+	}
+}
+
+func TestUserListRemove(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserList(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveUserList(ctx, item.Id)
+		assert.False(t, keeper.HasUserList(ctx, item.Id))
+	}
+}
+
+func TestUserListGetAll(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserList(keeper, ctx, 10)
+	assert.Equal(t, items, keeper.GetAllUserList(ctx))
+}
+
+func TestUserListCount(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserList(keeper, ctx, 10)
+	count := uint64(len(items))
+	assert.Equal(t, count, keeper.GetUserListCount(ctx))
+}
@@ -123,6 +123,7 @@ func (k Keeper) RemoveUserRelation(ctx sdk.Context, id uint64) {
 	store.Delete(GetUserRelationIDBytes(id))
 
 	k.RemoveUserRelationExist(ctx, rel.UidFrom, rel.UidTo)
+	k.RemoveUserRelationExpiryQueue(ctx, rel)
 }
 
 // GetAllUserRelation returns all UserRelation
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// SetUserRelationExpiryQueue adds the expiring flags of a UserRelation to the expiry queues
+func (k Keeper) SetUserRelationExpiryQueue(ctx sdk.Context, UserRelation types.UserRelation) {
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryHeightKey))
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryTimeKey))
+	for _, flag := range UserRelation.Flags {
+		if flag.ExpireHeight != 0 {
+			heightStore.Set(GetUserRelationExpiryHeightKeyBytes(flag.ExpireHeight, UserRelation.Id, flag.RelType), []byte{1})
+		}
+		if flag.ExpireTime != nil {
+			timeStore.Set(GetUserRelationExpiryTimeKeyBytes(*flag.ExpireTime, UserRelation.Id, flag.RelType), []byte{1})
+		}
+	}
+}
+
+// RemoveUserRelationExpiryQueue removes the expiring flags of a UserRelation from the expiry queues
+func (k Keeper) RemoveUserRelationExpiryQueue(ctx sdk.Context, UserRelation types.UserRelation) {
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryHeightKey))
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryTimeKey))
+	for _, flag := range UserRelation.Flags {
+		if flag.ExpireHeight != 0 {
+			heightStore.Delete(GetUserRelationExpiryHeightKeyBytes(flag.ExpireHeight, UserRelation.Id, flag.RelType))
+		}
+		if flag.ExpireTime != nil {
+			timeStore.Delete(GetUserRelationExpiryTimeKeyBytes(*flag.ExpireTime, UserRelation.Id, flag.RelType))
+		}
+	}
+}
+
+// ExpireUserRelationFlags clears the relation flags expired at the current block
+func (k Keeper) ExpireUserRelationFlags(ctx sdk.Context) {
+	type expiry struct {
+		id      uint64
+		relType uint64
+		store   prefix.Store
+		key     []byte
+	}
+	expired := []expiry{}
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryHeightKey))
+	heightEnd := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) + 1)
+	heightIterator := heightStore.Iterator(nil, heightEnd)
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		key := heightIterator.Key()
+		expired = append(expired, expiry{
+			id:      sdk.BigEndianToUint64(key[8:16]),
+			relType: sdk.BigEndianToUint64(key[16:24]),
+			store:   heightStore,
+			key:     key,
+		})
+	}
+	heightIterator.Close()
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExpiryTimeKey))
+	timeEnd := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	timeIterator := timeStore.Iterator(nil, timeEnd)
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		key := timeIterator.Key()
+		timeLen := len(key) - 16
+		expired = append(expired, expiry{
+			id:      sdk.BigEndianToUint64(key[timeLen : timeLen+8]),
+			relType: sdk.BigEndianToUint64(key[timeLen+8:]),
+			store:   timeStore,
+			key:     key,
+		})
+	}
+	timeIterator.Close()
+
+	for _, e := range expired {
+		// the due key is dropped even when the relation or flag is already gone
+		e.store.Delete(e.key)
+		if !k.HasUserRelation(ctx, e.id) {
+			continue
+		}
+		UserRelation := k.GetUserRelation(ctx, e.id)
+		relType := UserRelation.GetRelTypeMask()
+		if relType&e.relType == 0 {
+			continue
+		}
+		k.RemoveUserRelationExpiryQueue(ctx, UserRelation)
+		UserRelation.UpdateRelType(relType&^e.relType, ctx.BlockHeight(), ctx.BlockTime())
+		UserRelation.Version++
+		k.SetUserRelation(ctx, UserRelation)
+		k.SetUserRelationExpiryQueue(ctx, UserRelation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRelationFlagExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyUidFrom, UserRelation.UidFrom),
+				sdk.NewAttribute(types.AttributeKeyUidTo, UserRelation.UidTo),
+				sdk.NewAttribute(types.AttributeKeyRelType, strconv.FormatUint(e.relType, 10)),
+				sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(UserRelation.Version, 10)),
+			),
+		)
+	}
+}
+
+// GetUserRelationExpiryHeightKeyBytes returns the height expiry queue key of a relation flag
+func GetUserRelationExpiryHeightKeyBytes(height int64, id uint64, relType uint64) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(height))
+	key = append(key, GetUserRelationIDBytes(id)...)
+	return append(key, sdk.Uint64ToBigEndian(relType)...)
+}
+
+// GetUserRelationExpiryTimeKeyBytes returns the time expiry queue key of a relation flag
+func GetUserRelationExpiryTimeKeyBytes(expireTime time.Time, id uint64, relType uint64) []byte {
+	key := sdk.FormatTimeBytes(expireTime)
+	key = append(key, GetUserRelationIDBytes(id)...)
+	return append(key, sdk.Uint64ToBigEndian(relType)...)
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpireUserRelationFlags(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserRelation(keeper, ctx, 2)
+	blockTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	muteUntil := blockTime.Add(time.Hour)
+	items[0].UpdateRelType(types.RelTypeBitFollow|types.RelTypeBitMute, 10, blockTime)
+	items[0].SetFlagExpiry(types.RelTypeBitMute, 0, &muteUntil)
+	items[1].UpdateRelType(types.RelTypeBitBlock, 10, blockTime)
+	items[1].SetFlagExpiry(types.RelTypeBitBlock, 20, nil)
+	for _, item := range items {
+		keeper.SetUserRelation(ctx, item)
+		keeper.SetUserRelationExpiryQueue(ctx, item)
+	}
+
+	keeper.ExpireUserRelationFlags(ctx.WithBlockHeight(19))
+	rel := keeper.GetUserRelation(ctx, items[0].Id)
+	assert.Equal(t, types.RelTypeBitFollow|types.RelTypeBitMute, rel.GetRelTypeMask())
+	rel = keeper.GetUserRelation(ctx, items[1].Id)
+	assert.Equal(t, types.RelTypeBitBlock, rel.GetRelTypeMask())
+
+	ctx = ctx.WithBlockHeight(20)
+	keeper.ExpireUserRelationFlags(ctx)
+	rel = keeper.GetUserRelation(ctx, items[1].Id)
+	assert.Equal(t, uint64(0), rel.GetRelTypeMask())
+	assert.Equal(t, uint64(1), rel.Version)
+	assert.Len(t, ctx.EventManager().Events(), 1)
+
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(muteUntil)
+	keeper.ExpireUserRelationFlags(ctx)
+	rel = keeper.GetUserRelation(ctx, items[0].Id)
+	assert.Equal(t, types.RelTypeBitFollow, rel.GetRelTypeMask())
+	assert.Nil(t, rel.GetFlag(types.RelTypeBitMute))
+	assert.Equal(t, int64(10), rel.GetFlag(types.RelTypeBitFollow).SinceHeight)
+
+	// the queues are drained
+	keeper.ExpireUserRelationFlags(ctx.WithBlockHeight(100).WithBlockTime(muteUntil.Add(time.Hour)))
+	assert.Equal(t, uint64(1), keeper.GetUserRelation(ctx, items[0].Id).Version)
+}
+
+func TestExpireUserRelationFlagsDropsStaleKeys(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	items := createNUserRelation(keeper, ctx, 3)
+	ctx = ctx.WithBlockHeight(10)
+	heightStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.KeyPrefix(types.UserRelationExpiryHeightKey))
+
+	for _, item := range items {
+		item.UpdateRelType(types.RelTypeBitBlock, 10, ctx.BlockTime())
+		item.SetFlagExpiry(types.RelTypeBitBlock, 20, nil)
+		keeper.SetUserRelation(ctx, item)
+		keeper.SetUserRelationExpiryQueue(ctx, item)
+	}
+	// removing a relation removes its queued flags
+	keeper.RemoveUserRelation(ctx, items[0].Id)
+	assert.False(t, heightStore.Has(GetUserRelationExpiryHeightKeyBytes(20, items[0].Id, types.RelTypeBitBlock)))
+
+	// a queued flag already cleared and a queued flag of a missing relation
+	items[1].UpdateRelType(0, 10, ctx.BlockTime())
+	keeper.SetUserRelation(ctx, items[1])
+	heightStore.Set(GetUserRelationExpiryHeightKeyBytes(20, items[1].Id, types.RelTypeBitBlock), []byte{1})
+	heightStore.Set(GetUserRelationExpiryHeightKeyBytes(20, 100, types.RelTypeBitBlock), []byte{1})
+
+	keeper.ExpireUserRelationFlags(ctx.WithBlockHeight(20))
+	iterator := heightStore.Iterator(nil, nil)
+	defer iterator.Close()
+	assert.False(t, iterator.Valid())
+	rel := keeper.GetUserRelation(ctx, items[2].Id)
+	assert.Equal(t, uint64(0), rel.GetRelTypeMask())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/mises-id/mises-tm/x/misestm/types"
@@ -54,3 +55,28 @@ func TestUserRelationCount(t *testing.T) {
 	count := uint64(len(items))
 	assert.Equal(t, count, keeper.GetUserRelationCount(ctx))
 }
+
+func TestUserRelationSince(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	item := createNUserRelation(keeper, ctx, 1)[0]
+
+	followTime := time.Unix(1000, 0).UTC()
+	item.UpdateRelType(types.RelTypeBitFollow, 10, followTime)
+	keeper.SetUserRelation(ctx, item)
+	item = keeper.GetUserRelation(ctx, item.Id)
+	assert.Equal(t, &types.RelationFlag{RelType: types.RelTypeBitFollow, SinceHeight: 10, SinceTime: followTime}, item.GetFlag(types.RelTypeBitFollow))
+
+	item.UpdateRelType(types.RelTypeBitFollow|types.RelTypeBitMute, 20, followTime.Add(time.Hour))
+	assert.Equal(t, int64(10), item.GetFlag(types.RelTypeBitFollow).SinceHeight)
+	assert.Equal(t, int64(20), item.GetFlag(types.RelTypeBitMute).SinceHeight)
+	assert.True(t, item.IsFollowing)
+
+	item.UpdateRelType(types.RelTypeBitMute, 30, followTime.Add(2*time.Hour))
+	assert.Nil(t, item.GetFlag(types.RelTypeBitFollow))
+	assert.False(t, item.IsFollowing)
+
+	height, uidTo, err := types.ParseFollowedSinceKey(types.FollowedSinceKey(&types.UserRelation{UidTo: "did:mises:a"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), height)
+	assert.Equal(t, "did:mises:a", uidTo)
+}
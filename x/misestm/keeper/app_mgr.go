@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
@@ -44,6 +46,9 @@ func (k *appMgr) GetAppAccount(ctx sdk.Context, did string) (*types.MisesAccount
 	if err != nil {
 		return nil, err
 	}
+	if misesAcc == nil {
+		return nil, nil
+	}
 	if misesAcc.DidType != types.DIDTypeApp {
 		return nil, sdkerrors.ErrLogic
 	}
@@ -68,18 +73,79 @@ func (k *appMgr) GetAppFeeGrant(ctx sdk.Context, appDID string, userDID string)
 	if err != nil {
 		return nil, err
 	}
-	pa, ok := feeAllowance.(*feegrant.PeriodicAllowance)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType
+	return appFeeGrantFromAllowance(feeAllowance, ctx.BlockTime())
+}
+
+// intersectMsgTypes returns the message types of allowed also in filter, in the order of allowed
+func intersectMsgTypes(allowed []string, filter []string) []string {
+	inFilter := make(map[string]bool, len(filter))
+	for _, msgType := range filter {
+		inFilter[msgType] = true
+	}
+	ret := []string{}
+	for _, msgType := range allowed {
+		if inFilter[msgType] {
+			ret = append(ret, msgType)
+		}
 	}
-	if len(pa.Basic.SpendLimit) != 1 {
-		return nil, sdkerrors.ErrInvalidType
+	return ret
+}
+
+// appFeeGrantFromAllowance describes a fee allowance as seen at the given block time
+func appFeeGrantFromAllowance(feeAllowance feegrant.FeeAllowanceI, blockTime time.Time) (*types.AppFeeGrant, error) {
+	ret := &types.AppFeeGrant{}
+
+	// unwrap the message filters, a message must pass every nested filter
+	for filters := 0; ; filters++ {
+		filtered, ok := feeAllowance.(*feegrant.AllowedMsgAllowance)
+		if !ok {
+			break
+		}
+		inner, err := filtered.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		if filters == 0 {
+			ret.AllowedMessages = append([]string{}, filtered.AllowedMessages...)
+		} else {
+			ret.AllowedMessages = intersectMsgTypes(ret.AllowedMessages, filtered.AllowedMessages)
+		}
+		feeAllowance = inner
+	}
+
+	var basic feegrant.BasicAllowance
+	switch a := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *a
+		ret.AllowanceType = types.AppFeeGrantTypeBasic
+	case *feegrant.PeriodicAllowance:
+		basic = a.Basic
+		ret.AllowanceType = types.AppFeeGrantTypePeriodic
+		ret.Period = a.Period
+		ret.PeriodSpendLimit = a.PeriodSpendLimit
+		ret.PeriodCanSpend = a.PeriodCanSpend
+		periodReset := a.PeriodReset
+		// the stored values are only brought up to date by the next spend, report what it would see
+		if !blockTime.Before(periodReset) {
+			if _, isNeg := basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !basic.SpendLimit.Empty() {
+				ret.PeriodCanSpend = basic.SpendLimit
+			} else {
+				ret.PeriodCanSpend = a.PeriodSpendLimit
+			}
+			periodReset = periodReset.Add(a.Period)
+			if blockTime.After(periodReset) {
+				periodReset = blockTime.Add(a.Period)
+			}
+		}
+		ret.PeriodReset = &periodReset
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unsupported fee allowance %T", feeAllowance)
 	}
 
-	ret = &types.AppFeeGrant{
-		Period:     pa.Period,
-		SpendLimit: &pa.Basic.SpendLimit[0],
-		Expiration: pa.Basic.Expiration,
+	ret.SpendLimits = basic.SpendLimit
+	if len(basic.SpendLimit) == 1 {
+		ret.SpendLimit = &basic.SpendLimit[0]
 	}
+	ret.Expiration = basic.Expiration
 	return ret, nil
 }
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppFeeGrantFromAllowance(t *testing.T) {
+	now := time.Unix(10000, 0).UTC()
+	expiration := now.Add(24 * time.Hour)
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("umis", 1000), sdk.NewInt64Coin("uusd", 10))
+
+	grant, err := appFeeGrantFromAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: &expiration}, now)
+	require.NoError(t, err)
+	assert.Equal(t, types.AppFeeGrantTypeBasic, grant.AllowanceType)
+	assert.Equal(t, spendLimit, grant.SpendLimits)
+	assert.Nil(t, grant.SpendLimit)
+	assert.Equal(t, &expiration, grant.Expiration)
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("umis", 50))},
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("umis", 100)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("umis", 20)),
+		PeriodReset:      now.Add(time.Minute),
+	}
+	grant, err = appFeeGrantFromAllowance(periodic, now)
+	require.NoError(t, err)
+	assert.Equal(t, types.AppFeeGrantTypePeriodic, grant.AllowanceType)
+	assert.Equal(t, sdk.NewInt64Coin("umis", 50), *grant.SpendLimit)
+	assert.Equal(t, periodic.PeriodCanSpend, grant.PeriodCanSpend)
+	assert.Equal(t, now.Add(time.Minute), *grant.PeriodReset)
+
+	// the period is over, the next spend resets it
+	grant, err = appFeeGrantFromAllowance(periodic, now.Add(3*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, periodic.Basic.SpendLimit, grant.PeriodCanSpend)
+	assert.Equal(t, now.Add(4*time.Hour), *grant.PeriodReset)
+
+	filtered, err := feegrant.NewAllowedMsgAllowance(periodic, []string{"/misesid.misestm.v1beta1.MsgUpdateUserInfo"})
+	require.NoError(t, err)
+	grant, err = appFeeGrantFromAllowance(filtered, now)
+	require.NoError(t, err)
+	assert.Equal(t, types.AppFeeGrantTypePeriodic, grant.AllowanceType)
+	assert.Equal(t, []string{"/misesid.misestm.v1beta1.MsgUpdateUserInfo"}, grant.AllowedMessages)
+
+	// nested filters only allow the messages passing all of them
+	inner, err := feegrant.NewAllowedMsgAllowance(periodic, []string{
+		"/misesid.misestm.v1beta1.MsgUpdateUserInfo",
+		"/misesid.misestm.v1beta1.MsgUpdateUserRelation",
+	})
+	require.NoError(t, err)
+	nested, err := feegrant.NewAllowedMsgAllowance(inner, []string{
+		"/misesid.misestm.v1beta1.MsgUpdateUserRelation",
+		"/misesid.misestm.v1beta1.MsgCreateDidRegistry",
+	})
+	require.NoError(t, err)
+	grant, err = appFeeGrantFromAllowance(nested, now)
+	require.NoError(t, err)
+	assert.Equal(t, types.AppFeeGrantTypePeriodic, grant.AllowanceType)
+	assert.Equal(t, []string{"/misesid.misestm.v1beta1.MsgUpdateUserRelation"}, grant.AllowedMessages)
+}
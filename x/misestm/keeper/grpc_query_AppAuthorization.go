@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AppAuthorizationsByUser(c context.Context, req *types.QueryAppAuthorizationsByUserRequest) (*types.QueryAppAuthorizationsByUserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var authorizations []*types.AppAuthorization
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	userStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppAuthorizationKey), GetAppAuthorizationPrefix(req.Uid)...))
+
+	pageRes, err := query.Paginate(userStore, req.Pagination, func(key []byte, value []byte) error {
+		var authorization types.AppAuthorization
+		if err := k.cdc.Unmarshal(value, &authorization); err != nil {
+			return err
+		}
+
+		authorizations = append(authorizations, &authorization)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppAuthorizationsByUserResponse{Authorizations: authorizations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AppAuthorizationsByApp(c context.Context, req *types.QueryAppAuthorizationsByAppRequest) (*types.QueryAppAuthorizationsByAppResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var authorizations []*types.AppAuthorization
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	appStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppAuthorizationByAppKey), GetAppAuthorizationPrefix(req.Appid)...))
+
+	pageRes, err := query.Paginate(appStore, req.Pagination, func(key []byte, value []byte) error {
+		authorization, found := k.GetAppAuthorization(ctx, string(key), req.Appid)
+		if !found {
+			return fmt.Errorf("authorization of %s by %s not found", req.Appid, string(key))
+		}
+
+		authorizations = append(authorizations, &authorization)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppAuthorizationsByAppResponse{Authorizations: authorizations, Pagination: pageRes}, nil
+}
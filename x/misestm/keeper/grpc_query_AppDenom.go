@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AppDenoms(c context.Context, req *types.QueryAppDenomsRequest) (*types.QueryAppDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var denoms []*types.AppDenom
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	appStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppDenomByAppKey), GetAppDenomByAppPrefix(req.Appid)...))
+
+	pageRes, err := query.Paginate(appStore, req.Pagination, func(key []byte, value []byte) error {
+		denom, found := k.GetAppDenom(ctx, string(key))
+		if !found {
+			return fmt.Errorf("denom %s of %s not found", string(key), req.Appid)
+		}
+
+		denoms = append(denoms, &denom)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AppFeeGrantTemplates(c context.Context, req *types.QueryAppFeeGrantTemplatesRequest) (*types.QueryAppFeeGrantTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var templates []*types.AppFeeGrantTemplate
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	templateStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppFeeGrantTemplateKey), GetAppFeeGrantPrefix(req.Appid)...))
+
+	pageRes, err := query.Paginate(templateStore, req.Pagination, func(key []byte, value []byte) error {
+		var template types.AppFeeGrantTemplate
+		if err := k.cdc.Unmarshal(value, &template); err != nil {
+			return err
+		}
+
+		templates = append(templates, &template)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppFeeGrantTemplatesResponse{Templates: templates, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AppFeeGrantees(c context.Context, req *types.QueryAppFeeGranteesRequest) (*types.QueryAppFeeGranteesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var grantees []*types.AppFeeGrantee
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	granteeStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppFeeGranteeKey), GetAppFeeGrantPrefix(req.Appid)...))
+
+	pageRes, err := query.Paginate(granteeStore, req.Pagination, func(key []byte, value []byte) error {
+		var grantee types.AppFeeGrantee
+		if err := k.cdc.Unmarshal(value, &grantee); err != nil {
+			return err
+		}
+
+		grantees = append(grantees, &grantee)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppFeeGranteesResponse{Grantees: grantees, Pagination: pageRes}, nil
+}
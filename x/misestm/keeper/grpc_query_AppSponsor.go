@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AppSponsorStat(c context.Context, req *types.QueryAppSponsorStatRequest) (*types.QueryAppSponsorStatResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stat := k.GetAppSponsorStat(ctx, req.Appid)
+
+	return &types.QueryAppSponsorStatResponse{Stat: &stat}, nil
+}
+
+func (k Keeper) AppSponsoredAccounts(c context.Context, req *types.QueryAppSponsoredAccountsRequest) (*types.QueryAppSponsoredAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var accounts []*types.MisesAccount
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	appStore := prefix.NewStore(store, append(types.KeyPrefix(types.AppSponsoredAccountKey), GetAppSponsoredAccountPrefix(req.Appid)...))
+
+	pageRes, err := query.Paginate(appStore, req.Pagination, func(key []byte, value []byte) error {
+		if !k.HasMisesAccount(ctx, string(key)) {
+			return fmt.Errorf("account %s sponsored by %s not found", string(key), req.Appid)
+		}
+		account := k.GetMisesAccount(ctx, string(key))
+
+		accounts = append(accounts, &account)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAppSponsoredAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
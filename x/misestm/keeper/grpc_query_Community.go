@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CommunityAll(c context.Context, req *types.QueryAllCommunityRequest) (*types.QueryAllCommunityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var Communitys []*types.Community
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	CommunityStore := prefix.NewStore(store, types.KeyPrefix(types.CommunityKey))
+
+	pageRes, err := query.Paginate(CommunityStore, req.Pagination, func(key []byte, value []byte) error {
+		var Community types.Community
+		if err := k.cdc.Unmarshal(value, &Community); err != nil {
+			return err
+		}
+
+		Communitys = append(Communitys, &Community)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCommunityResponse{Community: Communitys, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Community(c context.Context, req *types.QueryGetCommunityRequest) (*types.QueryGetCommunityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var Community types.Community
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, req.Id) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommunityKey))
+
+	if err := k.cdc.Unmarshal(store.Get(GetCommunityIDBytes(req.Id)), &Community); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetCommunityResponse{Community: &Community}, nil
+}
+
+func (k Keeper) CommunityMembers(c context.Context, req *types.QueryCommunityMembersRequest) (*types.QueryCommunityMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var members []*types.CommunityMember
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasCommunity(ctx, req.CommunityId) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	memberStore := prefix.NewStore(store, append(types.KeyPrefix(types.CommunityMemberKey), GetCommunityIDBytes(req.CommunityId)...))
+
+	pageRes, err := query.Paginate(memberStore, req.Pagination, func(key []byte, value []byte) error {
+		var member types.CommunityMember
+		if err := k.cdc.Unmarshal(value, &member); err != nil {
+			return err
+		}
+
+		members = append(members, &member)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommunityMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CommunitiesByMember(c context.Context, req *types.QueryCommunitiesByMemberRequest) (*types.QueryCommunitiesByMemberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var members []*types.CommunityMember
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	didStore := prefix.NewStore(store, append(types.KeyPrefix(types.CommunityMemberByDidKey), GetCommunityMemberByDidPrefix(req.Member)...))
+
+	pageRes, err := query.Paginate(didStore, req.Pagination, func(key []byte, value []byte) error {
+		member, found := k.GetCommunityMember(ctx, GetCommunityIDFromBytes(key), req.Member)
+		if !found {
+			return fmt.Errorf("community member %s of %d not found", req.Member, GetCommunityIDFromBytes(key))
+		}
+
+		members = append(members, &member)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommunitiesByMemberResponse{Members: members, Pagination: pageRes}, nil
+}
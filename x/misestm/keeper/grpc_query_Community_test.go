@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func TestCommunityQuerySingle(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCommunity(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetCommunityRequest
+		response *types.QueryGetCommunityResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetCommunityRequest{Id: msgs[0].Id},
+			response: &types.QueryGetCommunityResponse{Community: &msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetCommunityRequest{Id: msgs[1].Id},
+			response: &types.QueryGetCommunityResponse{Community: &msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetCommunityRequest{Id: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Community(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestCommunityQueryPaginated(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCommunity(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllCommunityRequest {
+		return &types.QueryAllCommunityRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CommunityAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			for j := i; j < len(msgs) && j < i+step; j++ {
+				assert.Equal(t, &msgs[j], resp.Community[j-i])
+			}
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CommunityAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			for j := i; j < len(msgs) && j < i+step; j++ {
+				assert.Equal(t, &msgs[j], resp.Community[j-i])
+			}
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.CommunityAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.CommunityAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestCommunityMembersQuery(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	items := createNCommunity(keeper, ctx, 2)
+	member := "did:mises:member"
+	members := []types.CommunityMember{
+		{CommunityId: items[0].Id, Member: "did:mises:owner", Role: types.CommunityRoleOwner},
+		{CommunityId: items[0].Id, Member: member, Status: types.CommunityMemberStatusPending},
+		{CommunityId: items[1].Id, Member: member},
+	}
+	for _, m := range members {
+		keeper.SetCommunityMember(ctx, m)
+	}
+
+	resp, err := keeper.CommunityMembers(wctx, &types.QueryCommunityMembersRequest{CommunityId: items[0].Id})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*types.CommunityMember{&members[0], &members[1]}, resp.Members)
+
+	_, err = keeper.CommunityMembers(wctx, &types.QueryCommunityMembersRequest{CommunityId: uint64(len(items))})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	byMember, err := keeper.CommunitiesByMember(wctx, &types.QueryCommunitiesByMemberRequest{Member: member})
+	require.NoError(t, err)
+	require.Equal(t, []*types.CommunityMember{&members[1], &members[2]}, byMember.Members)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NFTClassRoyalty(c context.Context, req *types.QueryNFTClassRoyaltyRequest) (*types.QueryNFTClassRoyaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	price, err := sdk.ParseCoinsNormalized(req.Price)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	info, found := k.GetNFTClassInfo(ctx, req.ClassId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryNFTClassRoyaltyResponse{
+		RoyaltyRecipient:   info.RoyaltyRecipient,
+		RoyaltyBasisPoints: info.RoyaltyBasisPoints,
+		Royalty:            info.Royalty(price),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UserListAll(c context.Context, req *types.QueryAllUserListRequest) (*types.QueryAllUserListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var UserLists []*types.UserList
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	UserListStore := prefix.NewStore(store, types.KeyPrefix(types.UserListKey))
+
+	pageRes, err := query.Paginate(UserListStore, req.Pagination, func(key []byte, value []byte) error {
+		var UserList types.UserList
+		if err := k.cdc.Unmarshal(value, &UserList); err != nil {
+			return err
+		}
+
+		UserLists = append(UserLists, &UserList)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserListResponse{UserList: UserLists, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UserList(c context.Context, req *types.QueryGetUserListRequest) (*types.QueryGetUserListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var UserList types.UserList
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasUserList(ctx, req.Id) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserListKey))
+
+	if err := k.cdc.Unmarshal(store.Get(GetUserListIDBytes(req.Id)), &UserList); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetUserListResponse{UserList: &UserList}, nil
+}
+
+func (k Keeper) UserListsByOwner(c context.Context, req *types.QueryUserListsByOwnerRequest) (*types.QueryUserListsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var UserLists []*types.UserList
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, append(types.KeyPrefix(types.UserListOwnerKey), GetUserListIndexPrefix(req.Owner)...))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		UserList := k.GetUserList(ctx, GetUserListIDFromBytes(key))
+		UserLists = append(UserLists, &UserList)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserListsByOwnerResponse{UserList: UserLists, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UserListsByMember(c context.Context, req *types.QueryUserListsByMemberRequest) (*types.QueryUserListsByMemberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var UserLists []*types.UserList
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	memberStore := prefix.NewStore(store, append(types.KeyPrefix(types.UserListMemberKey), GetUserListIndexPrefix(req.Member)...))
+
+	// chain state is public, lists are returned whatever their visibility
+	pageRes, err := query.Paginate(memberStore, req.Pagination, func(key []byte, value []byte) error {
+		UserList := k.GetUserList(ctx, GetUserListIDFromBytes(key))
+		UserLists = append(UserLists, &UserList)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserListsByMemberResponse{UserList: UserLists, Pagination: pageRes}, nil
+}
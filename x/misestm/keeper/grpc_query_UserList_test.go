@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func TestUserListQuerySingle(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNUserList(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetUserListRequest
+		response *types.QueryGetUserListResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetUserListRequest{Id: msgs[0].Id},
+			response: &types.QueryGetUserListResponse{UserList: &msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetUserListRequest{Id: msgs[1].Id},
+			response: &types.QueryGetUserListResponse{UserList: &msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetUserListRequest{Id: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.UserList(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestUserListQueryPaginated(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNUserList(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllUserListRequest {
+		return &types.QueryAllUserListRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.UserListAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			for j := i; j < len(msgs) && j < i+step; j++ {
+				assert.Equal(t, &msgs[j], resp.UserList[j-i])
+			}
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.UserListAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			for j := i; j < len(msgs) && j < i+step; j++ {
+				assert.Equal(t, &msgs[j], resp.UserList[j-i])
+			}
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.UserListAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.UserListAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestUserListQueryByOwnerAndMember(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	owner := "did:mises:owner"
+	member := "did:mises:member"
+	items := []types.UserList{
+		{Creator: "any", Owner: owner, Members: []string{member}},
+		{Creator: "any", Owner: owner, Members: []string{member}, Visibility: types.UserListVisibilityPrivate},
+		{Creator: "any", Owner: "did:mises:other"},
+	}
+	for i := range items {
+		items[i].Id = keeper.AppendUserList(ctx, items[i])
+	}
+
+	byOwner, err := keeper.UserListsByOwner(wctx, &types.QueryUserListsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Equal(t, []*types.UserList{&items[0], &items[1]}, byOwner.UserList)
+
+	byMember, err := keeper.UserListsByMember(wctx, &types.QueryUserListsByMemberRequest{Member: member})
+	require.NoError(t, err)
+	require.Equal(t, []*types.UserList{&items[0], &items[1]}, byMember.UserList)
+
+	// the indexes follow member updates
+	items[0].Members = nil
+	keeper.SetUserList(ctx, items[0])
+	byMember, err = keeper.UserListsByMember(wctx, &types.QueryUserListsByMemberRequest{Member: member})
+	require.NoError(t, err)
+	require.Equal(t, []*types.UserList{&items[1]}, byMember.UserList)
+
+	keeper.RemoveUserList(ctx, items[1].Id)
+	byOwner, err = keeper.UserListsByOwner(wctx, &types.QueryUserListsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Equal(t, []*types.UserList{&items[0]}, byOwner.UserList)
+}
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 	"github.com/mises-id/mises-tm/x/misestm/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -98,14 +102,18 @@ func (k Keeper) QueryUserRelation(c context.Context, req *types.RestQueryUserRel
 	if misesAcc == nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mises id %s not exists", req.MisesUid)
 	}
+	params := k.GetParams(ctx)
 	pagination := req.Pagination
 	if pagination == nil {
 		pagination = &query.PageRequest{
 			Key:   []byte(""),
-			Limit: 100,
+			Limit: params.MaxRelationBatch,
 		}
 	}
 	_, uidOk := types.CheckDid(req.Filter, types.DIDTypeUser)
+	if uidOk && (req.Sort != "" || req.FollowedSince != 0) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sort and followed since not supported with mises id filter")
+	}
 	var UserRelations []*types.UserRelation
 	if uidOk {
 		//filter by to uid
@@ -118,14 +126,11 @@ func (k Keeper) QueryUserRelation(c context.Context, req *types.RestQueryUserRel
 		}
 
 	} else {
-		relType := uint64(0)
-		if req.Filter == "following" {
-			relType = types.RelTypeBitFollow
-		}
-		if req.Filter == "blocking" {
-			relType = types.RelTypeBitBlock
+		relType, err := params.RelTypeFromNames(req.Filter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
-		UserRelations, err = userMgr.GetUserRelations(ctx, relType, req.MisesUid, string(pagination.Key), int(pagination.Limit))
+		UserRelations, err = userMgr.GetUserRelations(ctx, relType, req.MisesUid, string(pagination.Key), int(pagination.Limit), req.Sort, req.FollowedSince)
 		if err != nil {
 			return nil, err
 		}
@@ -134,20 +139,20 @@ func (k Keeper) QueryUserRelation(c context.Context, req *types.RestQueryUserRel
 	misesList := []*types.MisesID{}
 	for _, r := range UserRelations {
 		var relType string
-		if r.IsFollowing {
-			relType = "following,"
+		relTypeBits := r.GetRelTypeMask()
+		for _, name := range params.RelTypeNames(relTypeBits) {
+			relType += name + ","
 		}
-		if r.IsBlocking {
-			relType += "blocking,"
-		}
-		if r.IsReferredBy {
-			relType += "refer_by,"
-		}
-		misesList = append(misesList, &types.MisesID{MisesId: r.UidTo, RelType: relType})
+		misesList = append(misesList, &types.MisesID{MisesId: r.UidTo, RelType: relType, RelTypeBits: relTypeBits, Flags: r.Flags})
 	}
 	nextKey := ""
-	if len(misesList) > 0 {
-		nextKey = misesList[len(misesList)-1].MisesId
+	if len(UserRelations) > 0 {
+		last := UserRelations[len(UserRelations)-1]
+		if req.Sort == types.UserRelationSortFollowedSince {
+			nextKey = types.FollowedSinceKey(last)
+		} else {
+			nextKey = last.UidTo
+		}
 	}
 	pageRes := &query.PageResponse{NextKey: []byte(nextKey)}
 
@@ -180,12 +185,41 @@ func (k Keeper) QueryApp(c context.Context, req *types.RestQueryAppRequest) (*ty
 		return nil, err
 	}
 
-	return &types.RestQueryAppResponse{
-		PubInfo: AppInfo.PubInfo,
-		Version: AppInfo.Version,
+	return restQueryAppResponse(AppInfo), nil
+}
+
+func (k Keeper) QueryAppByDomain(c context.Context, req *types.RestQueryAppByDomainRequest) (*types.RestQueryAppByDomainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	appid, found := k.GetAppDomain(ctx, req.Domain)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "domain %s not claimed", req.Domain)
+	}
+	app, err := k.QueryApp(c, &types.RestQueryAppRequest{MisesAppid: appid})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RestQueryAppByDomainResponse{
+		MisesAppid: appid,
+		App:        app,
 	}, nil
 }
 
+func restQueryAppResponse(AppInfo types.AppInfo) *types.RestQueryAppResponse {
+	return &types.RestQueryAppResponse{
+		PubInfo:            AppInfo.PubInfo,
+		Version:            AppInfo.Version,
+		Verified:           AppInfo.Verified,
+		VerificationReason: AppInfo.VerificationReason,
+		Owner:              AppInfo.CurrentOwner(),
+		Admins:             AppInfo.Admins,
+	}
+}
+
 func (k Keeper) QueryAppFeeGrant(c context.Context, req *types.RestQueryAppFeeGrantRequest) (*types.RestQueryAppFeeGrantResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -203,6 +237,58 @@ func (k Keeper) QueryAppFeeGrant(c context.Context, req *types.RestQueryAppFeeGr
 
 }
 
+func (k Keeper) QueryNFT(c context.Context, req *types.RestQueryNFTRequest) (*types.RestQueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, _, err := types.AddrFromDid(req.MisesId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !k.HasMisesAccount(ctx, req.MisesId) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mises id %s not exists", req.MisesId)
+	}
+
+	// the nft module indexes the nfts by owner then class, paging through the index covers
+	// every class, including the ones not created by misestm
+	prefixKey := append(append([]byte{}, nftkeeper.NFTOfClassByOwnerKey...), address.MustLengthPrefix(owner)...)
+	if req.ClassId != "" {
+		prefixKey = append(append(prefixKey, req.ClassId...), nftkeeper.Delimiter...)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.nftKey), prefixKey)
+	var nftsOfClasses []types.NFTsOfClass
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		classID, nftID := req.ClassId, string(key)
+		if classID == "" {
+			parts := bytes.SplitN(key, nftkeeper.Delimiter, 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid nft owner key %X", key)
+			}
+			classID, nftID = string(parts[0]), string(parts[1])
+		}
+		token, found := k.nk.GetNFT(ctx, classID, nftID)
+		if !found {
+			return nil
+		}
+		// the keys of a class are contiguous
+		if n := len(nftsOfClasses); n == 0 || nftsOfClasses[n-1].ClassId != classID {
+			nftsOfClasses = append(nftsOfClasses, types.NFTsOfClass{ClassId: classID})
+		}
+		last := &nftsOfClasses[len(nftsOfClasses)-1]
+		last.Nfts = append(last.Nfts, token)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.RestQueryNFTResponse{
+		Classes:    nftsOfClasses,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) QueryTx(c context.Context, req *types.RestQueryTxRequest) (*types.RestTxResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
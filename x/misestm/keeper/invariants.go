@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// RegisterInvariants registers all misestm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "mises-accounts", MisesAccountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "counts", CountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "user-relation-index", UserRelationIndexInvariant(k))
+}
+
+// AllInvariants runs all invariants of the misestm module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			MisesAccountsInvariant(k),
+			CountsInvariant(k),
+			UserRelationIndexInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// MisesAccountsInvariant checks that every MisesAccount points to its DidRegistry
+// and to the UserInfo or AppInfo matching its did type
+func MisesAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, acc := range k.GetAllMisesAccount(ctx) {
+			if broken := k.checkMisesAccount(ctx, acc); broken != "" {
+				count++
+				msg += fmt.Sprintf("\t%s %s\n", acc.MisesID, broken)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "mises-accounts",
+			fmt.Sprintf("%d mises accounts with missing or mismatched records\n%s", count, msg)), count != 0
+	}
+}
+
+func (k Keeper) checkMisesAccount(ctx sdk.Context, acc types.MisesAccount) string {
+	if !k.HasDidRegistry(ctx, acc.DidRegistryID) {
+		return fmt.Sprintf("points to missing did registry %d", acc.DidRegistryID)
+	}
+	if reg := k.GetDidRegistry(ctx, acc.DidRegistryID); reg.Did != acc.MisesID {
+		return fmt.Sprintf("points to did registry %d of %s", acc.DidRegistryID, reg.Did)
+	}
+	switch acc.DidType {
+	case types.DIDTypeUser:
+		if !k.HasUserInfo(ctx, acc.InfoID) {
+			return fmt.Sprintf("points to missing user info %d", acc.InfoID)
+		}
+		if info := k.GetUserInfo(ctx, acc.InfoID); info.Uid != acc.MisesID {
+			return fmt.Sprintf("points to user info %d of %s", acc.InfoID, info.Uid)
+		}
+	case types.DIDTypeApp:
+		if !k.HasAppInfo(ctx, acc.InfoID) {
+			return fmt.Sprintf("points to missing app info %d", acc.InfoID)
+		}
+		if info := k.GetAppInfo(ctx, acc.InfoID); info.Appid != acc.MisesID {
+			return fmt.Sprintf("points to app info %d of %s", acc.InfoID, info.Appid)
+		}
+	default:
+		return fmt.Sprintf("has unknown did type %d", acc.DidType)
+	}
+	return ""
+}
+
+// CountsInvariant checks that each count is greater than the largest stored id
+func CountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, c := range []struct {
+			name     string
+			valueKey string
+			count    uint64
+		}{
+			{"did registry", types.DidRegistryKey, k.GetDidRegistryCount(ctx)},
+			{"user info", types.UserInfoKey, k.GetUserInfoCount(ctx)},
+			{"app info", types.AppInfoKey, k.GetAppInfoCount(ctx)},
+			{"user relation", types.UserRelationKey, k.GetUserRelationCount(ctx)},
+			{"user list", types.UserListKey, k.GetUserListCount(ctx)},
+			{"community", types.CommunityKey, k.GetCommunityCount(ctx)},
+		} {
+			if maxID, found := k.maxStoredID(ctx, c.valueKey); found && maxID >= c.count {
+				count++
+				msg += fmt.Sprintf("\t%s count %d, max id %d\n", c.name, c.count, maxID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "counts",
+			fmt.Sprintf("%d counts not greater than the max stored id\n%s", count, msg)), count != 0
+	}
+}
+
+// maxStoredID returns the largest id stored under a value key, ids are big endian
+// so the last key holds it
+func (k Keeper) maxStoredID(ctx sdk.Context, valueKey string) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(valueKey))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(iterator.Key()), true
+}
+
+// UserRelationIndexInvariant checks that every UserRelationExist index entry
+// resolves to a relation between the same users
+func UserRelationIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationExistKey))
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			id := GetUserRelationIDFromBytes(iterator.Value())
+			if !k.HasUserRelation(ctx, id) {
+				count++
+				msg += fmt.Sprintf("\tindex %X points to missing relation %d\n", iterator.Key(), id)
+				continue
+			}
+			rel := k.GetUserRelation(ctx, id)
+			if !bytes.Equal(iterator.Key(), GetUserRelationExistKeyBytes(rel.UidFrom, rel.UidTo)) {
+				count++
+				msg += fmt.Sprintf("\tindex %X points to relation %d from %s to %s\n", iterator.Key(), id, rel.UidFrom, rel.UidTo)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "user-relation-index",
+			fmt.Sprintf("%d user relation index entries not matching their relation\n%s", count, msg)), count != 0
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func TestInvariants(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	uids := []string{}
+	for _, name := range []string{"invariant user 1", "invariant user 2"} {
+		uid := types.DIDPrefixForUser + sdk.AccAddress([]byte(name)).String()
+		regID := keeper.AppendDidRegistry(ctx, types.DidRegistry{Did: uid})
+		infoID := keeper.AppendUserInfo(ctx, types.UserInfo{Uid: uid})
+		keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: uid, DidRegistryID: regID, InfoID: infoID, DidType: types.DIDTypeUser})
+		uids = append(uids, uid)
+	}
+	relID := keeper.AppendUserRelation(ctx, types.UserRelation{UidFrom: uids[0], UidTo: uids[1]})
+
+	_, broken := AllInvariants(*keeper)(ctx)
+	require.False(t, broken)
+
+	// a count not greater than the max id
+	keeper.SetUserInfoCount(ctx, 1)
+	_, broken = CountsInvariant(*keeper)(ctx)
+	require.True(t, broken)
+	keeper.SetUserInfoCount(ctx, 2)
+
+	// an account pointing to the info of another user
+	acc := keeper.GetMisesAccount(ctx, uids[0])
+	acc.InfoID = 1
+	keeper.SetMisesAccount(ctx, acc)
+	_, broken = MisesAccountsInvariant(*keeper)(ctx)
+	require.True(t, broken)
+	acc.InfoID = 0
+	acc.DidType = types.DIDTypeApp
+	keeper.SetMisesAccount(ctx, acc)
+	_, broken = MisesAccountsInvariant(*keeper)(ctx)
+	require.True(t, broken)
+	acc.DidType = types.DIDTypeUser
+	keeper.SetMisesAccount(ctx, acc)
+
+	// an index entry of the reverse relation
+	keeper.SetUserRelationExist(ctx, uids[1], uids[0], relID)
+	_, broken = UserRelationIndexInvariant(*keeper)(ctx)
+	require.True(t, broken)
+	keeper.RemoveUserRelationExist(ctx, uids[1], uids[0])
+
+	_, broken = AllInvariants(*keeper)(ctx)
+	require.False(t, broken)
+}
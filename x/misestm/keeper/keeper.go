@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/mises-id/mises-tm/x/misestm/types"
 	dbm "github.com/tendermint/tm-db"
 	// this line is used by starport scaffolding # ibc/keeper/import
@@ -14,13 +15,16 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.Codec
-		storeKey sdk.StoreKey
-		memKey   sdk.StoreKey
-		ak       types.AccountKeeper
-		fk       types.FeeGrantKeeper
-		nk       types.NFTKeeper
-		db       dbm.RawDB
+		cdc        codec.Codec
+		storeKey   sdk.StoreKey
+		memKey     sdk.StoreKey
+		paramstore paramtypes.Subspace
+		ak         types.AccountKeeper
+		bk         types.BankKeeper
+		fk         types.FeeGrantKeeper
+		nk         types.NFTKeeper
+		nftKey     sdk.StoreKey
+		db         dbm.RawDB
 		// this line is used by starport scaffolding # ibc/keeper/attribute
 	}
 )
@@ -29,21 +33,32 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey,
 	memKey sdk.StoreKey,
+	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
+	bk types.BankKeeper,
 	fk types.FeeGrantKeeper,
 	nk types.NFTKeeper,
+	// store of the nft module, QueryNFT pages through its owner index
+	nftKey sdk.StoreKey,
 	db dbm.RawDB,
 	// this line is used by starport scaffolding # ibc/keeper/parameter
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
 
 	k := &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		memKey:   memKey,
-		ak:       ak,
-		fk:       fk,
-		nk:       nk,
-		db:       db,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		memKey:     memKey,
+		paramstore: ps,
+		ak:         ak,
+		bk:         bk,
+		fk:         fk,
+		nk:         nk,
+		nftKey:     nftKey,
+		db:         db,
 		// this line is used by starport scaffolding # ibc/keeper/return
 	}
 	if db != nil {
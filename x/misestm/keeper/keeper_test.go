@@ -8,6 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/mises-id/mises-tm/x/misestm/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -16,19 +20,83 @@ import (
 	_ "github.com/tendermint/tm-db/metadb"
 )
 
-func setupKeeper(t testing.TB) (*Keeper, sdk.Context) {
+// nftAccountKeeper only resolves module addresses for the nft keeper
+type nftAccountKeeper struct{}
+
+func (nftAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (nftAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return nil
+}
+
+// keeperDeps holds the optional keepers the misestm keeper is built with
+type keeperDeps struct {
+	ak types.AccountKeeper
+	bk types.BankKeeper
+	nk *nftkeeper.Keeper
+
+	noParams bool
+}
+
+// keeperOption adds an optional keeper to setupKeeper
+type keeperOption func(*keeperDeps)
+
+func withAccountKeeper(ak types.AccountKeeper) keeperOption {
+	return func(deps *keeperDeps) { deps.ak = ak }
+}
+
+func withBankKeeper(bk types.BankKeeper) keeperOption {
+	return func(deps *keeperDeps) { deps.bk = bk }
+}
+
+// withNFTKeeper mounts the nft store and sets nk to the nft keeper given to the misestm keeper
+func withNFTKeeper(nk *nftkeeper.Keeper) keeperOption {
+	return func(deps *keeperDeps) { deps.nk = nk }
+}
+
+// withoutParams leaves the param store empty, as it is on a chain started before the params
+func withoutParams() keeperOption {
+	return func(deps *keeperDeps) { deps.noParams = true }
+}
+
+func setupKeeper(t testing.TB, opts ...keeperOption) (*Keeper, sdk.Context) {
+	deps := keeperDeps{}
+	for _, opt := range opts {
+		opt(&deps)
+	}
+
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	nftStoreKey := sdk.NewKVStoreKey(nft.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+	if deps.nk != nil {
+		stateStore.MountStoreWithDB(nftStoreKey, sdk.StoreTypeIAVL, db)
+	}
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
-	keeper := NewKeeper(codec.NewProtoCodec(registry), storeKey, memStoreKey, nil, nil, nil, nil)
+	cdc := codec.NewProtoCodec(registry)
+	var nk types.NFTKeeper
+	if deps.nk != nil {
+		*deps.nk = nftkeeper.NewKeeper(nftStoreKey, cdc, nftAccountKeeper{}, nil)
+		nk = *deps.nk
+	}
+	paramsSubspace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	keeper := NewKeeper(cdc, storeKey, memStoreKey, paramsSubspace, deps.ak, deps.bk, nil, nk, nftStoreKey, nil)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	if !deps.noParams {
+		keeper.SetParams(ctx, types.DefaultParams())
+	}
 	return keeper, ctx
 }
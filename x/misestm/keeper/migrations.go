@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+//
+// Version 1 stored no params, relations carrying only the built-in bools and
+// app domains without index, version 2 needs every param, the relation type
+// bitmask and the domain index. Relations are re-encoded with the bitmask and
+// their exist index is rebuilt, app domains are indexed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.setMissingParams(ctx)
+	m.keeper.migrateUserRelationsV2(ctx)
+	m.keeper.migrateAppDomainsV2(ctx)
+	return nil
+}
+
+// setMissingParams sets the params missing from the store to their defaults
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramstore.Has(ctx, pair.Key) {
+			k.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// migrateUserRelationsV2 re-encodes relations with their relation type bitmask
+func (k Keeper) migrateUserRelationsV2(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRelationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var relations []types.UserRelation
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UserRelation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		relations = append(relations, val)
+	}
+	iterator.Close()
+
+	for _, rel := range relations {
+		rel.SetRelTypeMask(rel.GetRelTypeMask())
+		k.SetUserRelation(ctx, rel)
+		k.SetUserRelationExist(ctx, rel.UidFrom, rel.UidTo, rel.Id)
+	}
+}
+
+// migrateAppDomainsV2 indexes the domains claimed by apps. Version 1 did not
+// check domains were unique, a domain claimed by several apps is kept by the
+// app registered first and the later claims are left out of the index.
+func (k Keeper) migrateAppDomainsV2(ctx sdk.Context) {
+	for _, info := range k.GetAllAppInfo(ctx) {
+		if info.PubInfo == nil {
+			continue
+		}
+		for _, domain := range info.PubInfo.Domains {
+			if types.NormalizeAppDomain(domain) == "" {
+				continue
+			}
+			if owner, found := k.GetAppDomain(ctx, domain); found && owner != info.Appid {
+				k.Logger(ctx).Info("app domain already claimed", "domain", domain, "app", info.Appid, "owner", owner)
+				continue
+			}
+			k.SetAppDomain(ctx, domain, info.Appid)
+		}
+	}
+}
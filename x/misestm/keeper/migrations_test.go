@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	keeper, ctx := setupKeeper(t, withoutParams())
+
+	// a version 1 param store is empty
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		require.False(t, keeper.paramstore.Has(ctx, pair.Key))
+	}
+	// a param set before the migration is kept
+	keeper.paramstore.Set(ctx, types.KeyMaxRelationBatch, uint64(50))
+
+	// a version 1 relation only carries the built-in bools and has no exist index
+	from := types.DIDPrefixForUser + sdk.AccAddress([]byte("migrate from")).String()
+	to := types.DIDPrefixForUser + sdk.AccAddress([]byte("migrate to")).String()
+	keeper.SetUserRelation(ctx, types.UserRelation{Id: 3, UidFrom: from, UidTo: to, IsFollowing: true, IsBlocking: true})
+	keeper.SetUserRelationCount(ctx, 4)
+
+	// version 1 app domains are not indexed and may be claimed by several apps
+	app1 := types.DIDPrefixForApp + sdk.AccAddress([]byte("migrate app 1")).String()
+	app2 := types.DIDPrefixForApp + sdk.AccAddress([]byte("migrate app 2")).String()
+	keeper.AppendAppInfo(ctx, types.AppInfo{Appid: app1, PubInfo: &types.PublicAppInfo{Domains: []string{"App1.example.com", "shared.example.com"}}})
+	keeper.AppendAppInfo(ctx, types.AppInfo{Appid: app2, PubInfo: &types.PublicAppInfo{Domains: []string{"shared.example.com", "app2.example.com"}}})
+
+	require.NoError(t, NewMigrator(*keeper).Migrate1to2(ctx))
+
+	for _, pair := range defaults.ParamSetPairs() {
+		require.True(t, keeper.paramstore.Has(ctx, pair.Key))
+	}
+	expected := types.DefaultParams()
+	expected.MaxRelationBatch = 50
+	require.Equal(t, expected, keeper.GetParams(ctx))
+
+	require.True(t, keeper.HasUserRelationByMisesID(ctx, from, to))
+	rel := keeper.GetUserRelationByMisesID(ctx, from, to)
+	require.Equal(t, uint64(3), rel.Id)
+	require.Equal(t, types.RelTypeBitFollow|types.RelTypeBitBlock, rel.RelType)
+
+	for domain, appid := range map[string]string{
+		"app1.example.com":   app1,
+		"shared.example.com": app1,
+		"app2.example.com":   app2,
+	} {
+		owner, found := keeper.GetAppDomain(ctx, domain)
+		require.True(t, found)
+		require.Equal(t, appid, owner)
+	}
+
+	_, broken := AllInvariants(*keeper)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func (k msgServer) AuthorizeApp(goCtx context.Context, msg *types.MsgAuthorizeApp) (*types.MsgAuthorizeAppResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	uidAddr, uidOk := types.CheckDid(msg.Uid, types.DIDTypeUser)
+	if !uidOk || uidAddr != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect uid")
+	}
+	if msg.Expiration != nil && !ctx.BlockTime().Before(*msg.Expiration) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiration must be in the future")
+	}
+
+	appMgr := NewAppMgrImpl(k.Keeper)
+	misesAcc, err := appMgr.GetAppAccount(ctx, msg.Appid)
+	if err != nil {
+		return nil, err
+	}
+	if misesAcc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s not exists", msg.Appid)
+	}
+
+	var version uint64
+	if old, found := k.GetAppAuthorization(ctx, msg.Uid, msg.Appid); found {
+		version = old.Version + 1
+	}
+	k.SetAppAuthorization(ctx, types.AppAuthorization{
+		Uid:         msg.Uid,
+		Appid:       msg.Appid,
+		Scopes:      msg.Scopes,
+		Expiration:  msg.Expiration,
+		GrantHeight: ctx.BlockHeight(),
+		Version:     version,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppAuthorized{
+		Uid:     msg.Uid,
+		Appid:   msg.Appid,
+		Scopes:  msg.Scopes,
+		Version: version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAuthorizeAppResponse{}, nil
+}
+
+func (k msgServer) RevokeAppAuthorization(goCtx context.Context, msg *types.MsgRevokeAppAuthorization) (*types.MsgRevokeAppAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	uidAddr, uidOk := types.CheckDid(msg.Uid, types.DIDTypeUser)
+	if !uidOk || uidAddr != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect uid")
+	}
+	if _, found := k.GetAppAuthorization(ctx, msg.Uid, msg.Appid); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("authorization of %s by %s doesn't exist", msg.Appid, msg.Uid))
+	}
+
+	k.RemoveAppAuthorization(ctx, msg.Uid, msg.Appid)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppAuthorizationRevoked{
+		Uid:   msg.Uid,
+		Appid: msg.Appid,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAppAuthorizationResponse{}, nil
+}
+
+func (k msgServer) ExecAppAuthorized(goCtx context.Context, msg *types.MsgExecAppAuthorized) (*types.MsgExecAppAuthorizedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAppAdmin(ctx, msg.Appid, msg.Creator); err != nil {
+		return nil, err
+	}
+	if maxMsgs := k.GetParams(ctx).MaxAppExecMsgs; uint64(len(msg.Msgs)) > maxMsgs {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d msgs", maxMsgs)
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		scope, ok := types.AppAuthScopeOfMsg(m)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can't be executed by apps", sdk.MsgTypeURL(m))
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization can be given to msg with only one signer")
+		}
+		uid := types.DIDPrefixForUser + signers[0].String()
+		if !k.IsAppAuthorized(ctx, uid, msg.Appid, scope) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s didn't authorize %s for %s", uid, msg.Appid, scope)
+		}
+		if err := k.dispatchAppAuthorizedMsg(goCtx, m); err != nil {
+			return nil, err
+		}
+		msgTypeURLs = append(msgTypeURLs, sdk.MsgTypeURL(m))
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppAuthorizedExec{
+		Appid:       msg.Appid,
+		Creator:     msg.Creator,
+		MsgTypeUrls: msgTypeURLs,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecAppAuthorizedResponse{}, nil
+}
+
+// dispatchAppAuthorizedMsg runs a message as if its signer submitted it
+func (k msgServer) dispatchAppAuthorizedMsg(goCtx context.Context, msg sdk.Msg) error {
+	var err error
+	switch m := msg.(type) {
+	case *types.MsgUpdateUserInfo:
+		_, err = k.UpdateUserInfo(goCtx, m)
+	case *types.MsgUpdateUserRelation:
+		_, err = k.UpdateUserRelation(goCtx, m)
+	case *types.MsgCreateUserList:
+		_, err = k.CreateUserList(goCtx, m)
+	case *types.MsgRenameUserList:
+		_, err = k.RenameUserList(goCtx, m)
+	case *types.MsgAddUserListMembers:
+		_, err = k.AddUserListMembers(goCtx, m)
+	case *types.MsgRemoveUserListMembers:
+		_, err = k.RemoveUserListMembers(goCtx, m)
+	case *types.MsgDeleteUserList:
+		_, err = k.DeleteUserList(goCtx, m)
+	case *types.MsgJoinCommunity:
+		_, err = k.JoinCommunity(goCtx, m)
+	case *types.MsgKickCommunityMember:
+		_, err = k.KickCommunityMember(goCtx, m)
+	case *types.MsgApproveCommunityMember:
+		_, err = k.ApproveCommunityMember(goCtx, m)
+	default:
+		err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func (k msgServer) NewDenom(goCtx context.Context, msg *types.MsgNewDenom) (*types.MsgNewDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAppAdmin(ctx, msg.Appid, msg.Sender); err != nil {
+		return nil, err
+	}
+	metadata, err := types.AppDenomMetadata(msg.Appid, msg.Id, msg.DenomMeta)
+	if err != nil {
+		return nil, err
+	}
+	denom := metadata.Base
+	if _, found := k.GetAppDenom(ctx, denom); found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s already exists", denom)
+	}
+	if _, found := k.bk.GetDenomMetaData(ctx, denom); found || k.bk.GetSupply(ctx, denom).IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s already exists", denom)
+	}
+	params := k.GetParams(ctx)
+	if params.MaxAppDenoms > 0 && k.GetAppDenomCount(ctx, msg.Appid) >= params.MaxAppDenoms {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "app can not issue more than %d denoms", params.MaxAppDenoms)
+	}
+
+	k.bk.SetDenomMetaData(ctx, metadata)
+	k.SetAppDenom(ctx, types.AppDenom{
+		Appid:        msg.Appid,
+		Denom:        denom,
+		Id:           msg.Id,
+		Creator:      msg.Sender,
+		CreateHeight: ctx.BlockHeight(),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppDenomCreated{
+		Appid:   msg.Appid,
+		Denom:   denom,
+		Creator: msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	if msg.Amount.IsPositive() {
+		if err := k.mintAppDenom(ctx, denom, msg.Amount, msg.Recipient); err != nil {
+			return nil, err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAppDenomMinted{
+			Appid:     msg.Appid,
+			Denom:     denom,
+			Amount:    msg.Amount,
+			Recipient: msg.Recipient,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgNewDenomResponse{Denom: denom}, nil
+}
+
+func (k msgServer) MintAppDenom(goCtx context.Context, msg *types.MsgMintAppDenom) (*types.MsgMintAppDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.getAdministeredAppDenom(ctx, msg.Appid, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.mintAppDenom(ctx, denom, msg.Amount, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppDenomMinted{
+		Appid:     msg.Appid,
+		Denom:     denom,
+		Amount:    msg.Amount,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintAppDenomResponse{}, nil
+}
+
+func (k msgServer) BurnAppDenom(goCtx context.Context, msg *types.MsgBurnAppDenom) (*types.MsgBurnAppDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.getAdministeredAppDenom(ctx, msg.Appid, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.bk.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppDenomBurned{
+		Appid:  msg.Appid,
+		Denom:  denom,
+		Amount: msg.Amount,
+		Burner: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnAppDenomResponse{}, nil
+}
+
+// getAdministeredAppDenom returns the bank denom of a denom issued by an app administered by the creator
+func (k msgServer) getAdministeredAppDenom(ctx sdk.Context, appid string, id string, creator string) (string, error) {
+	if err := k.checkAppAdmin(ctx, appid, creator); err != nil {
+		return "", err
+	}
+	denom, err := types.AppDenomOf(appid, id)
+	if err != nil {
+		return "", err
+	}
+	if _, found := k.GetAppDenom(ctx, denom); !found {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "denom %s doesn't exist", denom)
+	}
+	return denom, nil
+}
+
+// mintAppDenom mints a denom issued by an app to the recipient within the supply cap
+func (k Keeper) mintAppDenom(ctx sdk.Context, denom string, amount sdk.Int, recipient string) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+	maxSupply := k.GetParams(ctx).MaxAppDenomSupply
+	if k.bk.GetSupply(ctx, denom).Amount.Add(amount).GT(maxSupply) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "supply of %s can not exceed %s", denom, maxSupply)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins)
+}
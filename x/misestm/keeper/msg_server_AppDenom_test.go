@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// denomAccountKeeper knows every account
+type denomAccountKeeper struct {
+	types.AccountKeeper
+}
+
+func (denomAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+// denomBankKeeper keeps balances and supplies in memory
+type denomBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+	metadata map[string]banktypes.Metadata
+}
+
+func (bk *denomBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	bk.supply = bk.supply.Add(amounts...)
+	bk.balances[moduleName] = bk.balances[moduleName].Add(amounts...)
+	return nil
+}
+
+func (bk *denomBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	bk.supply = bk.supply.Sub(amounts)
+	bk.balances[moduleName] = bk.balances[moduleName].Sub(amounts)
+	return nil
+}
+
+func (bk *denomBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[fromAddr.String()].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.balances[fromAddr.String()] = balance
+	bk.balances[toAddr.String()] = bk.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (bk *denomBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.balances[senderModule] = bk.balances[senderModule].Sub(amt)
+	bk.balances[recipientAddr.String()] = bk.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (bk *denomBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[senderAddr.String()].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.balances[senderAddr.String()] = balance
+	bk.balances[recipientModule] = bk.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (bk *denomBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bk.supply.AmountOf(denom))
+}
+
+func (bk *denomBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := bk.metadata[denom]
+	return metadata, found
+}
+
+func (bk *denomBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {
+	bk.metadata[denomMetaData.Base] = denomMetaData
+}
+
+func newDenomBankKeeper() *denomBankKeeper {
+	return &denomBankKeeper{balances: map[string]sdk.Coins{}, metadata: map[string]banktypes.Metadata{}}
+}
+
+func setupDenomKeeper(t testing.TB) (*Keeper, *denomBankKeeper, sdk.Context) {
+	bk := newDenomBankKeeper()
+	keeper, ctx := setupKeeper(t, withAccountKeeper(denomAccountKeeper{}), withBankKeeper(bk))
+	return keeper, bk, ctx
+}
+
+func TestAppDenomMsgServer(t *testing.T) {
+	keeper, bk, ctx := setupDenomKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress([]byte("app owner")).String()
+	other := sdk.AccAddress([]byte("other")).String()
+	appid := types.DIDPrefixForApp + owner
+	infoID := keeper.AppendAppInfo(ctx, types.AppInfo{Creator: owner, Appid: appid})
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: appid, DidType: types.DIDTypeApp, InfoID: infoID})
+
+	params := keeper.GetParams(ctx)
+	params.MaxAppDenomSupply = sdk.NewInt(1000)
+	params.MaxAppDenoms = 1
+	keeper.SetParams(ctx, params)
+
+	metadata := &banktypes.Metadata{
+		Name:       "Points",
+		Symbol:     "PTS",
+		Display:    "point",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "upoint"}, {Denom: "point", Exponent: 2}},
+	}
+	_, err := srv.NewDenom(wctx, types.NewMsgNewDenom("upoint", sdk.NewInt(100), metadata, other, other, appid))
+	require.Error(t, err)
+	res, err := srv.NewDenom(wctx, types.NewMsgNewDenom("upoint", sdk.NewInt(100), metadata, owner, other, appid))
+	require.NoError(t, err)
+	denom := "app/" + owner + "/upoint"
+	assert.Equal(t, denom, res.Denom)
+	assert.Equal(t, "app/"+owner+"/point", bk.metadata[denom].Display)
+	assert.Equal(t, sdk.NewInt(100), bk.balances[other].AmountOf(denom))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	minted, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+	require.NoError(t, err)
+	assert.Equal(t, &types.EventAppDenomMinted{Appid: appid, Denom: denom, Amount: sdk.NewInt(100), Recipient: other}, minted)
+
+	// the denom can't be issued twice and the app reached its denom cap
+	_, err = srv.NewDenom(wctx, types.NewMsgNewDenom("upoint", sdk.ZeroInt(), nil, owner, "", appid))
+	require.Error(t, err)
+	_, err = srv.NewDenom(wctx, types.NewMsgNewDenom("ustar", sdk.ZeroInt(), nil, owner, "", appid))
+	require.Error(t, err)
+
+	// minting stays within the supply cap
+	_, err = srv.MintAppDenom(wctx, types.NewMsgMintAppDenom(owner, appid, "upoint", sdk.NewInt(900), owner))
+	require.NoError(t, err)
+	_, err = srv.MintAppDenom(wctx, types.NewMsgMintAppDenom(owner, appid, "upoint", sdk.NewInt(1), owner))
+	require.Error(t, err)
+	_, err = srv.MintAppDenom(wctx, types.NewMsgMintAppDenom(other, appid, "upoint", sdk.NewInt(1), other))
+	require.Error(t, err)
+
+	_, err = srv.BurnAppDenom(wctx, types.NewMsgBurnAppDenom(owner, appid, "upoint", sdk.NewInt(400)))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(600), bk.GetSupply(ctx, denom).Amount)
+	_, err = srv.BurnAppDenom(wctx, types.NewMsgBurnAppDenom(owner, appid, "upoint", sdk.NewInt(501)))
+	require.Error(t, err)
+
+	denoms, err := keeper.AppDenoms(wctx, &types.QueryAppDenomsRequest{Appid: appid})
+	require.NoError(t, err)
+	require.Len(t, denoms.Denoms, 1)
+	assert.Equal(t, "upoint", denoms.Denoms[0].Id)
+}
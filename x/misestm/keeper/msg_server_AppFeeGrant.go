@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func (k msgServer) SetAppFeeGrantTemplate(goCtx context.Context, msg *types.MsgSetAppFeeGrantTemplate) (*types.MsgSetAppFeeGrantTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getAppFeeGranter(ctx, msg.Appid, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkAppFeeGrantTemplateName(ctx, msg.Name); err != nil {
+		return nil, err
+	}
+
+	var version uint64
+	if old, found := k.GetAppFeeGrantTemplate(ctx, msg.Appid, msg.Name); found {
+		version = old.Version + 1
+	}
+	k.Keeper.SetAppFeeGrantTemplate(ctx, types.AppFeeGrantTemplate{
+		Appid:            msg.Appid,
+		Name:             msg.Name,
+		Period:           msg.Period,
+		SpendLimit:       msg.SpendLimit,
+		PeriodSpendLimit: msg.PeriodSpendLimit,
+		Expiry:           msg.Expiry,
+		Version:          version,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppFeeGrantTemplateSet{
+		Appid:   msg.Appid,
+		Name:    msg.Name,
+		Version: version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAppFeeGrantTemplateResponse{}, nil
+}
+
+func (k msgServer) DeleteAppFeeGrantTemplate(goCtx context.Context, msg *types.MsgDeleteAppFeeGrantTemplate) (*types.MsgDeleteAppFeeGrantTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getAppFeeGranter(ctx, msg.Appid, msg.Creator); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetAppFeeGrantTemplate(ctx, msg.Appid, msg.Name); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("template %s of %s doesn't exist", msg.Name, msg.Appid))
+	}
+
+	// grants made with the template keep running until renewed or revoked
+	k.RemoveAppFeeGrantTemplate(ctx, msg.Appid, msg.Name)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppFeeGrantTemplateDeleted{
+		Appid: msg.Appid,
+		Name:  msg.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteAppFeeGrantTemplateResponse{}, nil
+}
+
+func (k msgServer) GrantAppFeeAllowances(goCtx context.Context, msg *types.MsgGrantAppFeeAllowances) (*types.MsgGrantAppFeeAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := k.getAppFeeGranter(ctx, msg.Appid, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkAppFeeGrantBatch(ctx, msg.Grantees); err != nil {
+		return nil, err
+	}
+	template, found := k.GetAppFeeGrantTemplate(ctx, msg.Appid, msg.Template)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("template %s of %s doesn't exist", msg.Template, msg.Appid))
+	}
+
+	for _, grantee := range msg.Grantees {
+		if _, found := k.GetAppFeeGrantee(ctx, msg.Appid, grantee); found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already granted, renew instead", grantee)
+		}
+		if err := k.grantAppFeeAllowance(ctx, granter, template, grantee); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppFeeAllowancesGranted{
+		Appid:    msg.Appid,
+		Template: msg.Template,
+		Grantees: msg.Grantees,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantAppFeeAllowancesResponse{}, nil
+}
+
+func (k msgServer) RenewAppFeeAllowances(goCtx context.Context, msg *types.MsgRenewAppFeeAllowances) (*types.MsgRenewAppFeeAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := k.getAppFeeGranter(ctx, msg.Appid, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkAppFeeGrantBatch(ctx, msg.Grantees); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range msg.Grantees {
+		templateName := msg.Template
+		if templateName == "" {
+			old, found := k.GetAppFeeGrantee(ctx, msg.Appid, grantee)
+			if !found {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no template to renew %s with", grantee)
+			}
+			templateName = old.Template
+		}
+		template, found := k.GetAppFeeGrantTemplate(ctx, msg.Appid, templateName)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("template %s of %s doesn't exist", templateName, msg.Appid))
+		}
+
+		// allowances granted outside of misestm are replaced as well
+		granteeAddr, _, err := types.AddrFromDid(grantee)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := k.fk.GetAllowance(ctx, granter, granteeAddr); err == nil {
+			if err := k.fk.RevokeAllowance(ctx, granter, granteeAddr); err != nil {
+				return nil, err
+			}
+		}
+		if err := k.grantAppFeeAllowance(ctx, granter, template, grantee); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppFeeAllowancesRenewed{
+		Appid:    msg.Appid,
+		Template: msg.Template,
+		Grantees: msg.Grantees,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewAppFeeAllowancesResponse{}, nil
+}
+
+func (k msgServer) RevokeAppFeeAllowances(goCtx context.Context, msg *types.MsgRevokeAppFeeAllowances) (*types.MsgRevokeAppFeeAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := k.getAppFeeGranter(ctx, msg.Appid, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkAppFeeGrantBatch(ctx, msg.Grantees); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range msg.Grantees {
+		granteeAddr, _, err := types.AddrFromDid(grantee)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.fk.RevokeAllowance(ctx, granter, granteeAddr); err != nil {
+			return nil, err
+		}
+		k.RemoveAppFeeGrantee(ctx, msg.Appid, grantee)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppFeeAllowancesRevoked{
+		Appid:    msg.Appid,
+		Grantees: msg.Grantees,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAppFeeAllowancesResponse{}, nil
+}
+
+// checkAppFeeGrantTemplateName checks the length of a template name against the params
+func (k msgServer) checkAppFeeGrantTemplateName(ctx sdk.Context, name string) error {
+	if maxLength := k.GetParams(ctx).MaxAppFeeGrantTemplateNameLength; uint64(len(name)) > maxLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "template name longer than %d", maxLength)
+	}
+	return nil
+}
+
+// checkAppFeeGrantBatch checks the number of grantees of a message against the params
+func (k msgServer) checkAppFeeGrantBatch(ctx sdk.Context, grantees []string) error {
+	if maxBatch := k.GetParams(ctx).MaxAppFeeGrantBatch; uint64(len(grantees)) > maxBatch {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d grantees", maxBatch)
+	}
+	return nil
+}
+
+// getAppFeeGranter checks the creator is an admin of the app and returns the address paying the fees
+func (k msgServer) getAppFeeGranter(ctx sdk.Context, appid string, creator string) (sdk.AccAddress, error) {
+	if err := k.checkAppAdmin(ctx, appid, creator); err != nil {
+		return nil, err
+	}
+	granter, _, err := types.AddrFromDid(appid)
+	if err != nil {
+		return nil, err
+	}
+	return granter, nil
+}
+
+func (k msgServer) grantAppFeeAllowance(ctx sdk.Context, granter sdk.AccAddress, template types.AppFeeGrantTemplate, grantee string) error {
+	granteeAddr, _, err := types.AddrFromDid(grantee)
+	if err != nil {
+		return err
+	}
+	if err := k.fk.GrantAllowance(ctx, granter, granteeAddr, template.Allowance(ctx.BlockTime())); err != nil {
+		return err
+	}
+	k.SetAppFeeGrantee(ctx, types.AppFeeGrantee{
+		Appid:       template.Appid,
+		Grantee:     grantee,
+		Template:    template.Name,
+		GrantHeight: ctx.BlockHeight(),
+	})
+	return nil
+}
@@ -28,13 +28,26 @@ func (k msgServer) UpdateAppInfo(goCtx context.Context, msg *types.MsgUpdateAppI
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("app info key %d doesn't exist", misesAcc.InfoID))
 	}
 	oldAppInfo := k.GetAppInfo(ctx, misesAcc.InfoID)
-	if msg.Creator != oldAppInfo.Creator {
+	if !oldAppInfo.IsAdmin(msg.Creator) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 	if msg.Version != oldAppInfo.Version+1 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect version")
 	}
 	var AppInfo = oldAppInfo
+	// the verification vouches for the name and domains, changing them revokes it
+	if oldAppInfo.Verified && (oldAppInfo.PubInfo == nil || oldAppInfo.PubInfo.Name != msg.Name || !equalDomains(oldAppInfo.PubInfo.Domains, msg.Domains)) {
+		AppInfo.Verified = false
+		AppInfo.VerificationReason = "app name or domains changed"
+		AppInfo.VerificationHeight = ctx.BlockHeight()
+	}
+	var oldDomains []string
+	if oldAppInfo.PubInfo != nil {
+		oldDomains = oldAppInfo.PubInfo.Domains
+	}
+	if err := k.UpdateAppDomains(ctx, msg.Appid, oldDomains, msg.Domains); err != nil {
+		return nil, err
+	}
 	AppInfo.PubInfo = &types.PublicAppInfo{
 		Name:      msg.Name,
 		Domains:   msg.Domains,
@@ -45,5 +58,188 @@ func (k msgServer) UpdateAppInfo(goCtx context.Context, msg *types.MsgUpdateAppI
 	AppInfo.Version = msg.Version
 	k.SetAppInfo(ctx, AppInfo)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppInfoUpdated{
+		Appid:    msg.Appid,
+		Creator:  msg.Creator,
+		Version:  msg.Version,
+		Verified: AppInfo.Verified,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateAppInfoResponse{}, nil
 }
+
+// getAppInfo returns the AppInfo of an app DID
+func (k msgServer) getAppInfo(ctx sdk.Context, appid string) (types.AppInfo, error) {
+	appMgr := NewAppMgrImpl(k.Keeper)
+	misesAcc, err := appMgr.GetAppAccount(ctx, appid)
+	if err != nil {
+		return types.AppInfo{}, err
+	}
+	if misesAcc == nil {
+		return types.AppInfo{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s not exists", appid)
+	}
+	if !k.HasAppInfo(ctx, misesAcc.InfoID) {
+		return types.AppInfo{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("app info key %d doesn't exist", misesAcc.InfoID))
+	}
+	return k.GetAppInfo(ctx, misesAcc.InfoID), nil
+}
+
+// checkAppAdmin checks the app exists and the creator is one of its admins
+func (k msgServer) checkAppAdmin(ctx sdk.Context, appid string, creator string) error {
+	AppInfo, err := k.getAppInfo(ctx, appid)
+	if err != nil {
+		return err
+	}
+	if !AppInfo.IsAdmin(creator) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	return nil
+}
+
+// getOwnedAppInfo returns the AppInfo of an app owned by the creator
+func (k msgServer) getOwnedAppInfo(ctx sdk.Context, appid string, creator string) (types.AppInfo, error) {
+	AppInfo, err := k.getAppInfo(ctx, appid)
+	if err != nil {
+		return AppInfo, err
+	}
+	if creator != AppInfo.CurrentOwner() {
+		return AppInfo, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	return AppInfo, nil
+}
+
+func (k msgServer) AddAppAdmin(goCtx context.Context, msg *types.MsgAddAppAdmin) (*types.MsgAddAppAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	AppInfo, err := k.getOwnedAppInfo(ctx, msg.Appid, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if AppInfo.IsAdmin(msg.Admin) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already an admin", msg.Admin)
+	}
+	if maxAdmins := k.GetParams(ctx).MaxAppAdmins; uint64(len(AppInfo.Admins)) >= maxAdmins {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d admins", maxAdmins)
+	}
+
+	AppInfo.Admins = append(AppInfo.Admins, msg.Admin)
+	k.SetAppInfo(ctx, AppInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppAdminAdded{
+		Appid: msg.Appid,
+		Admin: msg.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAppAdminResponse{}, nil
+}
+
+func (k msgServer) RemoveAppAdmin(goCtx context.Context, msg *types.MsgRemoveAppAdmin) (*types.MsgRemoveAppAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	AppInfo, err := k.getAppInfo(ctx, msg.Appid)
+	if err != nil {
+		return nil, err
+	}
+	// admins can step down by themselves
+	if msg.Creator != AppInfo.CurrentOwner() && msg.Creator != msg.Admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	admins := make([]string, 0, len(AppInfo.Admins))
+	for _, admin := range AppInfo.Admins {
+		if admin != msg.Admin {
+			admins = append(admins, admin)
+		}
+	}
+	if len(admins) == len(AppInfo.Admins) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not an admin", msg.Admin)
+	}
+
+	AppInfo.Admins = admins
+	k.SetAppInfo(ctx, AppInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppAdminRemoved{
+		Appid: msg.Appid,
+		Admin: msg.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAppAdminResponse{}, nil
+}
+
+func (k msgServer) TransferAppOwnership(goCtx context.Context, msg *types.MsgTransferAppOwnership) (*types.MsgTransferAppOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	AppInfo, err := k.getOwnedAppInfo(ctx, msg.Appid, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if msg.NewOwner == AppInfo.CurrentOwner() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already owns the app", msg.NewOwner)
+	}
+
+	AppInfo.PendingOwner = msg.NewOwner
+	k.SetAppInfo(ctx, AppInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppOwnershipTransferStarted{
+		Appid:        msg.Appid,
+		Owner:        msg.Creator,
+		PendingOwner: msg.NewOwner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferAppOwnershipResponse{}, nil
+}
+
+func (k msgServer) AcceptAppOwnership(goCtx context.Context, msg *types.MsgAcceptAppOwnership) (*types.MsgAcceptAppOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	AppInfo, err := k.getAppInfo(ctx, msg.Appid)
+	if err != nil {
+		return nil, err
+	}
+	if AppInfo.PendingOwner == "" || msg.Creator != AppInfo.PendingOwner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no pending ownership transfer to the creator")
+	}
+
+	// the new owner is an admin by ownership, the previous owner loses access
+	admins := make([]string, 0, len(AppInfo.Admins))
+	for _, admin := range AppInfo.Admins {
+		if admin != msg.Creator {
+			admins = append(admins, admin)
+		}
+	}
+	previousOwner := AppInfo.CurrentOwner()
+	AppInfo.Admins = admins
+	AppInfo.Owner = msg.Creator
+	AppInfo.PendingOwner = ""
+	k.SetAppInfo(ctx, AppInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAppOwnershipAccepted{
+		Appid:         msg.Appid,
+		PreviousOwner: previousOwner,
+		Owner:         msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAppOwnershipResponse{}, nil
+}
+
+func equalDomains(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func (k msgServer) CreateCommunity(goCtx context.Context, msg *types.MsgCreateCommunity) (*types.MsgCreateCommunityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCommunityDid(ctx, msg.Owner, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkCommunityText(ctx, msg.Name, msg.Description); err != nil {
+		return nil, err
+	}
+
+	var Community = types.Community{
+		Creator:     msg.Creator,
+		Owner:       msg.Owner,
+		Name:        msg.Name,
+		Description: msg.Description,
+		JoinPolicy:  msg.JoinPolicy,
+		MemberCount: 1,
+		Version:     0,
+	}
+	id := k.AppendCommunity(ctx, Community)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCommunityCreated{
+		Id:    id,
+		Owner: msg.Owner,
+		Name:  msg.Name,
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.updateCommunityMember(ctx, types.CommunityMember{
+		CommunityId: id,
+		Member:      msg.Owner,
+		Role:        types.CommunityRoleOwner,
+		Status:      types.CommunityMemberStatusActive,
+		SinceHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateCommunityResponse{Id: id}, nil
+}
+
+func (k msgServer) JoinCommunity(goCtx context.Context, msg *types.MsgJoinCommunity) (*types.MsgJoinCommunityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCommunityDid(ctx, msg.Member, msg.Creator); err != nil {
+		return nil, err
+	}
+	Community, err := k.getCommunity(ctx, msg.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	member, found := k.GetCommunityMember(ctx, msg.CommunityId, msg.Member)
+	if found {
+		switch member.Status {
+		case types.CommunityMemberStatusActive:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a member", msg.Member)
+		case types.CommunityMemberStatusPending:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "join request of %s is pending", msg.Member)
+		}
+		// accept the invitation
+		if err := k.activateCommunityMember(ctx, Community, member); err != nil {
+			return nil, err
+		}
+		return &types.MsgJoinCommunityResponse{}, nil
+	}
+
+	member = types.CommunityMember{
+		CommunityId: msg.CommunityId,
+		Member:      msg.Member,
+		Role:        types.CommunityRoleMember,
+		SinceHeight: ctx.BlockHeight(),
+	}
+	switch Community.JoinPolicy {
+	case types.CommunityJoinPolicyOpen:
+		err = k.activateCommunityMember(ctx, Community, member)
+	case types.CommunityJoinPolicyApproval:
+		member.Status = types.CommunityMemberStatusPending
+		err = k.updateCommunityMember(ctx, member)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "community is invite only")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinCommunityResponse{}, nil
+}
+
+func (k msgServer) ApproveCommunityMember(goCtx context.Context, msg *types.MsgApproveCommunityMember) (*types.MsgApproveCommunityMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	Community, operator, err := k.getCommunityOperator(ctx, msg.CommunityId, msg.Operator, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.CanModerate() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "operator is not a moderator")
+	}
+
+	member, found := k.GetCommunityMember(ctx, msg.CommunityId, msg.Member)
+	if !found {
+		// invite the DID, the membership becomes active when it joins
+		if !k.HasMisesAccount(ctx, msg.Member) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s not exists", msg.Member)
+		}
+		if err := k.updateCommunityMember(ctx, types.CommunityMember{
+			CommunityId: msg.CommunityId,
+			Member:      msg.Member,
+			Role:        types.CommunityRoleMember,
+			Status:      types.CommunityMemberStatusInvited,
+			SinceHeight: ctx.BlockHeight(),
+		}); err != nil {
+			return nil, err
+		}
+		return &types.MsgApproveCommunityMemberResponse{}, nil
+	}
+
+	switch member.Status {
+	case types.CommunityMemberStatusActive:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a member", msg.Member)
+	case types.CommunityMemberStatusInvited:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already invited", msg.Member)
+	}
+	member.SinceHeight = ctx.BlockHeight()
+	if err := k.activateCommunityMember(ctx, Community, member); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveCommunityMemberResponse{}, nil
+}
+
+func (k msgServer) KickCommunityMember(goCtx context.Context, msg *types.MsgKickCommunityMember) (*types.MsgKickCommunityMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var Community types.Community
+	var operator types.CommunityMember
+	var err error
+	if msg.Operator == msg.Member {
+		// leaving or withdrawing a join request does not need an active membership
+		if err = k.checkCommunityDid(ctx, msg.Operator, msg.Creator); err == nil {
+			Community, err = k.getCommunity(ctx, msg.CommunityId)
+		}
+	} else {
+		Community, operator, err = k.getCommunityOperator(ctx, msg.CommunityId, msg.Operator, msg.Creator)
+	}
+	if err != nil {
+		return nil, err
+	}
+	member, found := k.GetCommunityMember(ctx, msg.CommunityId, msg.Member)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not a member", msg.Member)
+	}
+	if member.Role == types.CommunityRoleOwner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the owner can not be removed")
+	}
+	if msg.Operator != msg.Member {
+		if !operator.CanModerate() {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "operator is not a moderator")
+		}
+		if member.Role == types.CommunityRoleModerator && operator.Role != types.CommunityRoleOwner {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the owner can remove a moderator")
+		}
+	}
+
+	k.RemoveCommunityMember(ctx, msg.CommunityId, msg.Member)
+	if member.IsActive() {
+		Community.MemberCount--
+		Community.Version++
+		k.SetCommunity(ctx, Community)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCommunityMemberRemoved{
+		CommunityId: msg.CommunityId,
+		Member:      msg.Member,
+		Operator:    msg.Operator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgKickCommunityMemberResponse{}, nil
+}
+
+func (k msgServer) ChangeCommunityRole(goCtx context.Context, msg *types.MsgChangeCommunityRole) (*types.MsgChangeCommunityRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	Community, operator, err := k.getCommunityOperator(ctx, msg.CommunityId, msg.Operator, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if operator.Role != types.CommunityRoleOwner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the owner can change roles")
+	}
+	if msg.Operator == msg.Member {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the owner can not change its own role")
+	}
+	member, found := k.GetCommunityMember(ctx, msg.CommunityId, msg.Member)
+	if !found || !member.IsActive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not a member", msg.Member)
+	}
+
+	member.Role = msg.Role
+	if err := k.updateCommunityMember(ctx, member); err != nil {
+		return nil, err
+	}
+	if msg.Role == types.CommunityRoleOwner {
+		operator.Role = types.CommunityRoleModerator
+		if err := k.updateCommunityMember(ctx, operator); err != nil {
+			return nil, err
+		}
+
+		Community.Owner = msg.Member
+		Community.Version++
+		k.SetCommunity(ctx, Community)
+	}
+
+	return &types.MsgChangeCommunityRoleResponse{}, nil
+}
+
+// checkCommunityText checks the lengths of a community name and description against the params
+func (k msgServer) checkCommunityText(ctx sdk.Context, name string, description string) error {
+	params := k.GetParams(ctx)
+	if uint64(len(name)) > params.MaxCommunityNameLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "name longer than %d", params.MaxCommunityNameLength)
+	}
+	if uint64(len(description)) > params.MaxCommunityDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description longer than %d", params.MaxCommunityDescriptionLength)
+	}
+	return nil
+}
+
+// checkCommunityDid checks that the DID is an existing user account controlled by the creator,
+// or an app administered by the creator
+func (k msgServer) checkCommunityDid(ctx sdk.Context, did string, creator string) error {
+	if _, ok := types.CheckDid(did, types.DIDTypeApp); ok {
+		// the app key only acts for the app while it owns or administers it
+		return k.checkAppAdmin(ctx, did, creator)
+	}
+	addr, ok := types.CheckDid(did, types.DIDTypeUser)
+	if !ok || addr != creator {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect mises id")
+	}
+	misesAcc, err := NewUserMgrImpl(k.Keeper).GetUserAccount(ctx, did)
+	if misesAcc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s not exists", did)
+	}
+	return err
+}
+
+func (k msgServer) getCommunity(ctx sdk.Context, id uint64) (types.Community, error) {
+	// Checks that the element exists
+	if !k.HasCommunity(ctx, id) {
+		return types.Community{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("community key %d doesn't exist", id))
+	}
+	return k.GetCommunity(ctx, id), nil
+}
+
+// getCommunityOperator loads a Community and the active membership of the operator DID
+func (k msgServer) getCommunityOperator(ctx sdk.Context, communityID uint64, operatorDid string, creator string) (types.Community, types.CommunityMember, error) {
+	if err := k.checkCommunityDid(ctx, operatorDid, creator); err != nil {
+		return types.Community{}, types.CommunityMember{}, err
+	}
+	Community, err := k.getCommunity(ctx, communityID)
+	if err != nil {
+		return types.Community{}, types.CommunityMember{}, err
+	}
+	operator, found := k.GetCommunityMember(ctx, communityID, operatorDid)
+	if !found || !operator.IsActive() {
+		return types.Community{}, types.CommunityMember{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "operator is not a member")
+	}
+	return Community, operator, nil
+}
+
+func (k msgServer) activateCommunityMember(ctx sdk.Context, Community types.Community, member types.CommunityMember) error {
+	member.Status = types.CommunityMemberStatusActive
+	Community.MemberCount++
+	Community.Version++
+	k.SetCommunity(ctx, Community)
+
+	return k.updateCommunityMember(ctx, member)
+}
+
+// updateCommunityMember stores a membership and emits its new role and status
+func (k msgServer) updateCommunityMember(ctx sdk.Context, member types.CommunityMember) error {
+	k.SetCommunityMember(ctx, member)
+	return ctx.EventManager().EmitTypedEvent(&types.EventCommunityMemberUpdated{
+		CommunityId: member.CommunityId,
+		Member:      member.Member,
+		Role:        member.Role,
+		Status:      member.Status,
+	})
+}
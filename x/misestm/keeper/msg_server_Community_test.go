@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommunityMsgServerTransferredApp(t *testing.T) {
+	keeper, _, ctx := setupDenomKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	appKey := sdk.AccAddress([]byte("community app")).String()
+	newOwner := sdk.AccAddress([]byte("community app owner")).String()
+	appid := types.DIDPrefixForApp + appKey
+	infoID := keeper.AppendAppInfo(ctx, types.AppInfo{Creator: appKey, Appid: appid})
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: appid, DidType: types.DIDTypeApp, InfoID: infoID})
+
+	// the app key acts for the app while it owns it
+	res, err := srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(appKey, appid, "before", "", types.CommunityJoinPolicyOpen))
+	require.NoError(t, err)
+
+	_, err = srv.TransferAppOwnership(wctx, types.NewMsgTransferAppOwnership(appKey, appid, newOwner))
+	require.NoError(t, err)
+	_, err = srv.AcceptAppOwnership(wctx, types.NewMsgAcceptAppOwnership(newOwner, appid))
+	require.NoError(t, err)
+
+	// the transferred-away key can no longer create or moderate as the app
+	_, err = srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(appKey, appid, "after", "", types.CommunityJoinPolicyOpen))
+	require.Error(t, err)
+	_, err = srv.ChangeCommunityRole(wctx, &types.MsgChangeCommunityRole{Creator: appKey, CommunityId: res.Id, Operator: appid, Member: appid, Role: types.CommunityRoleMember})
+	require.Error(t, err)
+	_, err = srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(newOwner, appid, "after", "", types.CommunityJoinPolicyOpen))
+	require.NoError(t, err)
+}
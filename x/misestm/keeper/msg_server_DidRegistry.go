@@ -23,6 +23,10 @@ func (k msgServer) CreateDidRegistry(goCtx context.Context, msg *types.MsgCreate
 		PkeyMultibase: msg.PkeyMultibase,
 		Version:       msg.Version,
 	}
+	if err := k.ConsumeRateLimit(ctx, types.RateLimitActionCreateDidRegistry, msg.Creator, k.GetParams(ctx).MaxDidCreations); err != nil {
+		return nil, err
+	}
+
 	ak := k.ak
 	userMgr := NewUserMgrImpl(k.Keeper)
 	misesAcc, _ := userMgr.GetUserAccount(ctx, DidRegistry.Did)
@@ -45,6 +49,28 @@ func (k msgServer) CreateDidRegistry(goCtx context.Context, msg *types.MsgCreate
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "did and pubKey mismatch")
 	}
 
+	if msg.Sponsor != "" {
+		// the app pays for the creation, the user proves it owns the key
+		if err := k.checkAppAdmin(ctx, msg.Sponsor, msg.Creator); err != nil {
+			return nil, err
+		}
+		signBytes := types.SponsoredDidSignBytes(ctx.ChainID(), msg.Did, msg.PkeyMultibase, msg.Sponsor)
+		if !pubKey.VerifySignature(signBytes, msg.Signature) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid user signature")
+		}
+	}
+
+	if fee := k.GetParams(ctx).DidCreationFee; !fee.IsZero() {
+		// the creator pays, the sponsoring app admin for sponsored dids
+		creator, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bk.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, fee); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to pay the did creation fee")
+		}
+	}
+
 	acc := ak.GetAccount(ctx, addr)
 	if acc == nil {
 		baseAccount := ak.NewAccountWithAddress(ctx, addr)
@@ -104,8 +130,21 @@ func (k msgServer) CreateDidRegistry(goCtx context.Context, msg *types.MsgCreate
 		DidRegistryID: regID,
 		InfoID:        infoID,
 		DidType:       didType,
+		Sponsor:       msg.Sponsor,
 	}
 	k.SetMisesAccount(ctx, newMisesAcc)
+	if msg.Sponsor != "" {
+		k.SetAppSponsoredAccount(ctx, msg.Sponsor, msg.Did)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidCreated{
+		Did:     msg.Did,
+		Creator: msg.Creator,
+		DidType: didType,
+		Sponsor: msg.Sponsor,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateDidRegistryResponse{}, nil
 }
@@ -17,6 +17,9 @@ func (k msgServer) UpdateUserInfo(goCtx context.Context, msg *types.MsgUpdateUse
 	if !uidOk || uidAddr != msg.Creator {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect uid")
 	}
+	if err := k.ConsumeRateLimit(ctx, types.RateLimitActionUpdateUserInfo, msg.Uid, k.GetParams(ctx).MaxUserInfoUpdates); err != nil {
+		return nil, err
+	}
 
 	// query first
 	misesAcc, err := userMgr.GetUserAccount(ctx, msg.Uid)
@@ -42,6 +45,9 @@ func (k msgServer) UpdateUserInfo(goCtx context.Context, msg *types.MsgUpdateUse
 	if msg.Version != oldUserInfo.Version+1 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect version")
 	}
+	if err := k.GetParams(ctx).CheckUserInfo(msg.PubInfo, msg.PriInfo); err != nil {
+		return nil, err
+	}
 
 	var UserInfo = oldUserInfo
 	UserInfo.PubInfo = msg.PubInfo
@@ -50,5 +56,12 @@ func (k msgServer) UpdateUserInfo(goCtx context.Context, msg *types.MsgUpdateUse
 
 	k.SetUserInfo(ctx, UserInfo)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserInfoUpdated{
+		Uid:     msg.Uid,
+		Version: msg.Version,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateUserInfoResponse{}, nil
 }
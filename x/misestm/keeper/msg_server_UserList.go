@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+func (k msgServer) CreateUserList(goCtx context.Context, msg *types.MsgCreateUserList) (*types.MsgCreateUserListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	userMgr := NewUserMgrImpl(k.Keeper)
+
+	ownerAddr, ownerOk := types.CheckDid(msg.Owner, types.DIDTypeUser)
+	if !ownerOk || ownerAddr != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	misesAcc, err := userMgr.GetUserAccount(ctx, msg.Owner)
+	if misesAcc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s not exists", msg.Owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkUserListText(ctx, msg.Name, msg.Description); err != nil {
+		return nil, err
+	}
+	if maxMembers := k.GetParams(ctx).MaxUserListMembers; uint64(len(msg.Members)) > maxMembers {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d members", maxMembers)
+	}
+	if err := k.checkUserListMembers(ctx, msg.Members); err != nil {
+		return nil, err
+	}
+
+	var UserList = types.UserList{
+		Creator:     msg.Creator,
+		Owner:       msg.Owner,
+		Name:        msg.Name,
+		Description: msg.Description,
+		Visibility:  msg.Visibility,
+		Members:     msg.Members,
+		Version:     0,
+	}
+	id := k.AppendUserList(ctx, UserList)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserListCreated{
+		Id:    id,
+		Owner: msg.Owner,
+		Name:  msg.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateUserListResponse{Id: id}, nil
+}
+
+func (k msgServer) RenameUserList(goCtx context.Context, msg *types.MsgRenameUserList) (*types.MsgRenameUserListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkUserListText(ctx, msg.Name, ""); err != nil {
+		return nil, err
+	}
+	UserList, err := k.getOwnedUserList(ctx, msg.Creator, msg.Id, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+	UserList.Name = msg.Name
+	UserList.Version = msg.Version
+
+	k.SetUserList(ctx, *UserList)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserListRenamed{
+		Id:      msg.Id,
+		Name:    msg.Name,
+		Version: msg.Version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenameUserListResponse{}, nil
+}
+
+func (k msgServer) AddUserListMembers(goCtx context.Context, msg *types.MsgAddUserListMembers) (*types.MsgAddUserListMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	UserList, err := k.getOwnedUserList(ctx, msg.Creator, msg.Id, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkUserListMembers(ctx, msg.Members); err != nil {
+		return nil, err
+	}
+	for _, member := range msg.Members {
+		if UserList.HasMember(member) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "member %s already in list", member)
+		}
+	}
+	if maxMembers := k.GetParams(ctx).MaxUserListMembers; uint64(len(UserList.Members)+len(msg.Members)) > maxMembers {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d members", maxMembers)
+	}
+	UserList.Members = append(UserList.Members, msg.Members...)
+	UserList.Version = msg.Version
+
+	k.SetUserList(ctx, *UserList)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserListMembersAdded{
+		Id:      msg.Id,
+		Members: msg.Members,
+		Version: msg.Version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddUserListMembersResponse{}, nil
+}
+
+func (k msgServer) RemoveUserListMembers(goCtx context.Context, msg *types.MsgRemoveUserListMembers) (*types.MsgRemoveUserListMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	UserList, err := k.getOwnedUserList(ctx, msg.Creator, msg.Id, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+	removed := make(map[string]bool)
+	for _, member := range msg.Members {
+		if !UserList.HasMember(member) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "member %s not in list", member)
+		}
+		removed[member] = true
+	}
+	members := []string{}
+	for _, member := range UserList.Members {
+		if !removed[member] {
+			members = append(members, member)
+		}
+	}
+	UserList.Members = members
+	UserList.Version = msg.Version
+
+	k.SetUserList(ctx, *UserList)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserListMembersRemoved{
+		Id:      msg.Id,
+		Members: msg.Members,
+		Version: msg.Version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveUserListMembersResponse{}, nil
+}
+
+func (k msgServer) DeleteUserList(goCtx context.Context, msg *types.MsgDeleteUserList) (*types.MsgDeleteUserListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	UserList, err := k.getOwnedUserList(ctx, msg.Creator, msg.Id, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveUserList(ctx, UserList.Id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserListDeleted{
+		Id:    UserList.Id,
+		Owner: UserList.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteUserListResponse{}, nil
+}
+
+// getOwnedUserList loads a UserList for an update by its creator at the next version
+func (k msgServer) getOwnedUserList(ctx sdk.Context, creator string, id uint64, version uint64) (*types.UserList, error) {
+	// Checks that the element exists
+	if !k.HasUserList(ctx, id) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("user list key %d doesn't exist", id))
+	}
+
+	UserList := k.GetUserList(ctx, id)
+	if creator != UserList.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if version != UserList.Version+1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect version")
+	}
+	return &UserList, nil
+}
+
+// checkUserListText checks the lengths of a user list name and description against the params
+func (k msgServer) checkUserListText(ctx sdk.Context, name string, description string) error {
+	params := k.GetParams(ctx)
+	if uint64(len(name)) > params.MaxUserListNameLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "name longer than %d", params.MaxUserListNameLength)
+	}
+	if uint64(len(description)) > params.MaxUserListDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description longer than %d", params.MaxUserListDescriptionLength)
+	}
+	return nil
+}
+
+// checkUserListMembers checks that all members are existing user accounts
+func (k msgServer) checkUserListMembers(ctx sdk.Context, members []string) error {
+	userMgr := NewUserMgrImpl(k.Keeper)
+	for _, member := range members {
+		misesAcc, err := userMgr.GetUserAccount(ctx, member)
+		if misesAcc == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "member account %s not exists", member)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -21,42 +21,75 @@ func (k msgServer) UpdateUserRelation(goCtx context.Context, msg *types.MsgUpdat
 	if !toOk {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect to")
 	}
+	params := k.GetParams(ctx)
+	relType := msg.GetRelTypeMask()
+	if relType&^params.RelTypeMask() != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown relation type %d", relType&^params.RelTypeMask())
+	}
+	if msg.ExpireHeight != 0 && msg.ExpireHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expire height must be in the future")
+	}
+	if msg.ExpireTime != nil && !msg.ExpireTime.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expire time must be in the future")
+	}
+	if err := k.ConsumeRateLimit(ctx, types.RateLimitActionUpdateUserRelation, msg.UidFrom, params.MaxRelationUpdates); err != nil {
+		return nil, err
+	}
 
 	oldRelation, err := userMgr.GetUserRelation(ctx, msg.UidFrom, msg.UidTo)
 	if err != nil {
 		return nil, err
 	}
 	var newRelation types.UserRelation
+	var oldRelType uint64
 	if oldRelation == nil {
 
 		newRelation = types.UserRelation{
-			Creator:      msg.Creator,
-			UidFrom:      msg.UidFrom,
-			UidTo:        msg.UidTo,
-			IsFollowing:  msg.IsFollowing,
-			IsBlocking:   msg.IsBlocking,
-			IsReferredBy: msg.IsReferredBy,
-			Version:      0,
+			Creator: msg.Creator,
+			UidFrom: msg.UidFrom,
+			UidTo:   msg.UidTo,
+			Version: 0,
 		}
+		newRelation.UpdateRelType(relType, ctx.BlockHeight(), ctx.BlockTime())
+		newRelation.SetFlagExpiry(msg.ExpiringRelType, msg.ExpireHeight, msg.ExpireTime)
 
 		id := k.AppendUserRelation(
 			ctx,
 			newRelation,
 		)
 		newRelation.Id = id
+		k.SetUserRelationExpiryQueue(ctx, newRelation)
 	} else {
 		if msg.Creator != oldRelation.Creator {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 		}
 
+		oldRelType = oldRelation.GetRelTypeMask()
 		newRelation = *oldRelation
-		newRelation.IsFollowing = msg.IsFollowing
-		newRelation.IsBlocking = msg.IsBlocking
+		// the referred by relation is only set on creation
+		referredBy := oldRelation.GetRelTypeMask() & types.RelTypeBitReferredBy
+		newRelation.UpdateRelType(relType&^types.RelTypeBitReferredBy|referredBy, ctx.BlockHeight(), ctx.BlockTime())
+		// flags set again by this message are permanent unless they expire again
+		newRelation.SetFlagExpiry(relType&^msg.ExpiringRelType&^types.RelTypeBitReferredBy, 0, nil)
+		newRelation.SetFlagExpiry(msg.ExpiringRelType, msg.ExpireHeight, msg.ExpireTime)
 		newRelation.Version++
+		k.RemoveUserRelationExpiryQueue(ctx, *oldRelation)
 		k.SetUserRelation(
 			ctx,
 			newRelation,
 		)
+		k.SetUserRelationExpiryQueue(ctx, newRelation)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRelationChanged{
+		UidFrom:      msg.UidFrom,
+		UidTo:        msg.UidTo,
+		OldRelType:   oldRelType,
+		RelType:      newRelation.GetRelTypeMask(),
+		RelTypeNames: params.RelTypeNames(newRelation.GetRelTypeMask()),
+		Version:      newRelation.Version,
+	}); err != nil {
+		return nil, err
 	}
 	return &types.MsgUpdateUserRelationResponse{}, nil
 }
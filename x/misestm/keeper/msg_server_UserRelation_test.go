@@ -3,8 +3,10 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/mises-id/mises-tm/x/misestm/types"
 )
@@ -45,3 +47,28 @@ func TestUserRelationMsgServerUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRelationMsgServerEvents(t *testing.T) {
+	keeper, _, ctx := setupDenomKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+
+	from := sdk.AccAddress([]byte("relation from")).String()
+	to := sdk.AccAddress([]byte("relation to")).String()
+	for _, addr := range []string{from, to} {
+		keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: types.DIDPrefixForUser + addr, DidType: types.DIDTypeUser})
+	}
+	msg := types.NewMsgUpdateUserRelation(from, types.DIDPrefixForUser+from, types.DIDPrefixForUser+to, types.RelTypeBitFollow, 0)
+	_, err := srv.UpdateUserRelation(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	parsed, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRelationChanged{
+		UidFrom:      msg.UidFrom,
+		UidTo:        msg.UidTo,
+		RelType:      types.RelTypeBitFollow,
+		RelTypeNames: []string{"following"},
+	}, parsed)
+}
@@ -9,11 +9,6 @@ import (
 	"github.com/mises-id/mises-tm/x/misestm/types"
 )
 
-func (k msgServer) NewDenom(goCtx context.Context, msg *types.MsgNewDenom) (*types.MsgNewDenomResponse, error) {
-
-	return &types.MsgNewDenomResponse{}, nil
-}
-
 func (k msgServer) NewNFTClass(goCtx context.Context, msg *types.MsgNewNFTClass) (*types.MsgNewNFTClassResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	var nftClass = nft.Class{
@@ -29,6 +24,11 @@ func (k msgServer) NewNFTClass(goCtx context.Context, msg *types.MsgNewNFTClass)
 	if err != nil {
 		return nil, err
 	}
+	if msg.Schema != "" {
+		if _, err := types.ParseNFTSchema(msg.Schema, k.GetParams(ctx).NFTSchemaLimits()); err != nil {
+			return nil, err
+		}
+	}
 	nk := k.nk
 
 	if err := nk.SaveClass(ctx, nftClass); err != nil {
@@ -36,10 +36,58 @@ func (k msgServer) NewNFTClass(goCtx context.Context, msg *types.MsgNewNFTClass)
 	}
 
 	nk.SetClassOwner(ctx, msg.Id, sender)
+	k.SetNFTClassInfo(ctx, types.NFTClassInfo{
+		ClassId:            msg.Id,
+		RoyaltyRecipient:   msg.RoyaltyRecipient,
+		RoyaltyBasisPoints: msg.RoyaltyBasisPoints,
+		Soulbound:          msg.Soulbound,
+		Schema:             msg.Schema,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTClassCreated{
+		ClassId:   msg.Id,
+		Owner:     msg.Sender,
+		Soulbound: msg.Soulbound,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgNewNFTClassResponse{}, nil
 }
 
 func (k msgServer) UpdateNFTClass(goCtx context.Context, msg *types.MsgUpdateNFTClass) (*types.MsgUpdateNFTClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	nk := k.nk
+
+	nftClass, found := nk.GetClass(ctx, msg.ClassId)
+	if !found {
+		return nil, sdkerrors.Wrapf(nft.ErrClassNotExists, "nft class %s", msg.ClassId)
+	}
+	owner := nk.GetClassOwner(ctx, msg.ClassId)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft class %s", sender, msg.ClassId)
+	}
+
+	nftClass.Name = msg.Name
+	nftClass.Uri = msg.Uri
+	// the data is kept when the message does not carry any
+	if msg.Data != nil {
+		nftClass.Data = msg.Data
+	}
+	if err := nk.UpdateClass(ctx, nftClass); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTClassUpdated{
+		ClassId: msg.ClassId,
+		Owner:   msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateNFTClassResponse{}, nil
 }
@@ -68,20 +116,259 @@ func (k msgServer) MintNFT(goCtx context.Context, msg *types.MsgMintNFT) (*types
 	if err != nil {
 		return nil, err
 	}
+	if err := k.validateNFTData(ctx, msg.ClassId, msg.Data); err != nil {
+		return nil, err
+	}
 
 	if err := nk.Mint(ctx, nft, receiver); err != nil {
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTMinted{
+		ClassId:   msg.ClassId,
+		Id:        msg.Id,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgMintNFTResponse{}, nil
 }
 
 func (k msgServer) UpdateNFT(goCtx context.Context, msg *types.MsgUpdateNFT) (*types.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var err error
+	if k.IsSoulboundNFTClass(ctx, msg.ClassId) {
+		// soulbound nfts are badges controlled by their issuer, not by their holder
+		err = k.checkNFTClassOwner(ctx, msg.ClassId, msg.Sender)
+	} else {
+		err = k.checkNFTOwner(ctx, msg.ClassId, msg.Id, msg.Sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	nk := k.nk
+
+	token, found := nk.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(nft.ErrNFTNotExists, "nft %s of class %s", msg.Id, msg.ClassId)
+	}
+	token.Uri = msg.Uri
+	// the data is kept when the message does not carry any
+	if msg.Data != nil {
+		if err := k.validateNFTData(ctx, msg.ClassId, msg.Data); err != nil {
+			return nil, err
+		}
+		token.Data = msg.Data
+	}
+	if err := nk.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTUpdated{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   nk.GetOwner(ctx, msg.ClassId, msg.Id).String(),
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateNFTResponse{}, nil
 }
 
 func (k msgServer) BurnNFT(goCtx context.Context, msg *types.MsgBurnNFT) (*types.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNFTOwner(ctx, msg.ClassId, msg.Id, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.nk.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTBurned{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgBurnNFTResponse{}, nil
 }
+
+func (k msgServer) TransferNFT(goCtx context.Context, msg *types.MsgTransferNFT) (*types.MsgTransferNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNFTOwner(ctx, msg.ClassId, msg.Id, msg.Sender); err != nil {
+		return nil, err
+	}
+	if k.IsSoulboundNFTClass(ctx, msg.ClassId) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s can not be transferred", msg.ClassId)
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	royalty, err := k.payNFTPrice(ctx, msg.ClassId, sender, recipient, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.nk.Transfer(ctx, msg.ClassId, msg.Id, recipient); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTTransferred{
+		ClassId:   msg.ClassId,
+		Id:        msg.Id,
+		Sender:    msg.Sender,
+		Recipient: msg.Recipient,
+		Price:     msg.Price,
+		Royalty:   royalty,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNFTResponse{Royalty: royalty}, nil
+}
+
+// payNFTPrice makes the recipient of a sold nft pay the class royalty and the rest of the price to the sender
+func (k msgServer) payNFTPrice(ctx sdk.Context, classID string, sender sdk.AccAddress, recipient sdk.AccAddress, price sdk.Coins) (sdk.Coins, error) {
+	royalty := sdk.NewCoins()
+	if price.Empty() {
+		return royalty, nil
+	}
+	info, _ := k.GetNFTClassInfo(ctx, classID)
+	royalty = info.Royalty(price)
+	if royalty.IsAllPositive() {
+		royaltyRecipient, err := sdk.AccAddressFromBech32(info.RoyaltyRecipient)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bk.SendCoins(ctx, recipient, royaltyRecipient, royalty); err != nil {
+			return nil, err
+		}
+	}
+	proceeds := price.Sub(royalty)
+	if !proceeds.Empty() {
+		if err := k.bk.SendCoins(ctx, recipient, sender, proceeds); err != nil {
+			return nil, err
+		}
+	}
+	return royalty, nil
+}
+
+func (k msgServer) RevokeNFT(goCtx context.Context, msg *types.MsgRevokeNFT) (*types.MsgRevokeNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	nk := k.nk
+
+	if !k.IsSoulboundNFTClass(ctx, msg.ClassId) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only nfts of soulbound classes can be revoked")
+	}
+	owner := nk.GetClassOwner(ctx, msg.ClassId)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft class %s", sender, msg.ClassId)
+	}
+	holder := nk.GetOwner(ctx, msg.ClassId, msg.Id)
+	if err := nk.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTRevoked{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   holder.String(),
+		Issuer:  msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeNFTResponse{}, nil
+}
+
+// checkNFTOwner checks that the NFT exists and is owned by the sender
+func (k msgServer) checkNFTOwner(ctx sdk.Context, classID string, nftID string, sender string) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	owner := k.nk.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return sdkerrors.Wrapf(nft.ErrNFTNotExists, "nft %s of class %s", nftID, classID)
+	}
+	if !owner.Equals(senderAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+	return nil
+}
+
+func (k msgServer) checkNFTClassOwner(ctx sdk.Context, classID string, sender string) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	if owner := k.nk.GetClassOwner(ctx, classID); !owner.Equals(senderAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft class %s", sender, classID)
+	}
+	return nil
+}
+
+func (k msgServer) TransferDidNFT(goCtx context.Context, msg *types.MsgTransferDidNFT) (*types.MsgTransferDidNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := k.getMisesAccountAddress(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := k.getMisesAccountAddress(ctx, msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkNFTOwner(ctx, msg.ClassId, msg.Id, sender.String()); err != nil {
+		return nil, err
+	}
+	if k.IsSoulboundNFTClass(ctx, msg.ClassId) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s can not be transferred", msg.ClassId)
+	}
+	royalty, err := k.payNFTPrice(ctx, msg.ClassId, sender, recipient, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.nk.Transfer(ctx, msg.ClassId, msg.Id, recipient); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTTransferred{
+		ClassId:   msg.ClassId,
+		Id:        msg.Id,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Price:     msg.Price,
+		Royalty:   royalty,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferDidNFTResponse{Royalty: royalty}, nil
+}
+
+// getMisesAccountAddress returns the address of a registered mises id
+func (k msgServer) getMisesAccountAddress(ctx sdk.Context, did string) (sdk.AccAddress, error) {
+	addr, _, err := types.AddrFromDid(did)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid mises id %s (%s)", did, err)
+	}
+	if !k.HasMisesAccount(ctx, did) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mises id %s not exists", did)
+	}
+	return addr, nil
+}
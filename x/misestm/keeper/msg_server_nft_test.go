@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupNFTKeeper(t testing.TB) (*Keeper, nftkeeper.Keeper, *denomBankKeeper, sdk.Context) {
+	var nk nftkeeper.Keeper
+	bk := newDenomBankKeeper()
+	keeper, ctx := setupKeeper(t, withBankKeeper(bk), withNFTKeeper(&nk))
+	return keeper, nk, bk, ctx
+}
+
+func TestNFTMsgServerUpdateAndBurn(t *testing.T) {
+	keeper, nk, _, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	owner := sdk.AccAddress([]byte("class owner")).String()
+	holder := sdk.AccAddress([]byte("nft holder")).String()
+
+	_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("class1", "Class", "uri", "", "CLS", nil, owner, "", 0, false))
+	require.NoError(t, err)
+	_, err = srv.MintNFT(wctx, types.NewMsgMintNFT("nft1", "class1", "", "uri", nil, owner, holder))
+	require.NoError(t, err)
+
+	// class updates need the class owner
+	_, err = srv.UpdateNFTClass(wctx, types.NewMsgUpdateNFTClass("class1", "class1", "New", "new-uri", nil, holder))
+	require.Error(t, err)
+	_, err = srv.UpdateNFTClass(wctx, types.NewMsgUpdateNFTClass("class1", "class1", "New", "new-uri", nil, owner))
+	require.NoError(t, err)
+	class, _ := nk.GetClass(ctx, "class1")
+	assert.Equal(t, "New", class.Name)
+	assert.Equal(t, "new-uri", class.Uri)
+	assert.Equal(t, "CLS", class.Symbol)
+	_, err = srv.UpdateNFTClass(wctx, types.NewMsgUpdateNFTClass("class2", "class2", "New", "", nil, owner))
+	require.Error(t, err)
+
+	// nft updates and burns need the nft owner
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("nft1", "class1", "new-uri", nil, owner))
+	require.Error(t, err)
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("nft1", "class1", "new-uri", nil, holder))
+	require.NoError(t, err)
+	token, _ := nk.GetNFT(ctx, "class1", "nft1")
+	assert.Equal(t, "new-uri", token.Uri)
+
+	_, err = srv.BurnNFT(wctx, types.NewMsgBurnNFT("nft1", "class1", owner))
+	require.Error(t, err)
+	_, err = srv.BurnNFT(wctx, types.NewMsgBurnNFT("nft1", "class1", holder))
+	require.NoError(t, err)
+	assert.False(t, nk.HasNFT(ctx, "class1", "nft1"))
+	_, err = srv.BurnNFT(wctx, types.NewMsgBurnNFT("nft1", "class1", holder))
+	require.Error(t, err)
+}
+
+func TestNFTMsgServerTransferRoyalty(t *testing.T) {
+	keeper, nk, bk, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	artist := sdk.AccAddress([]byte("artist")).String()
+	seller := sdk.AccAddress([]byte("seller")).String()
+	buyer := sdk.AccAddress([]byte("buyer")).String()
+	bk.balances[buyer] = sdk.NewCoins(sdk.NewInt64Coin("umis", 1000))
+
+	_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("art", "Art", "uri", "", "ART", nil, artist, artist, 250, false))
+	require.NoError(t, err)
+	_, err = srv.MintNFT(wctx, types.NewMsgMintNFT("piece1", "art", "", "uri", nil, artist, seller))
+	require.NoError(t, err)
+
+	royalty, err := keeper.NFTClassRoyalty(wctx, &types.QueryNFTClassRoyaltyRequest{ClassId: "art", Price: "1000umis"})
+	require.NoError(t, err)
+	assert.Equal(t, artist, royalty.RoyaltyRecipient)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umis", 25)), royalty.Royalty)
+
+	// a sale routes the royalty to the artist
+	_, err = srv.TransferNFT(wctx, types.NewMsgTransferNFT("piece1", "art", buyer, seller, nil))
+	require.Error(t, err)
+	res, err := srv.TransferNFT(wctx, types.NewMsgTransferNFT("piece1", "art", seller, buyer, sdk.NewCoins(sdk.NewInt64Coin("umis", 400))))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umis", 10)), res.Royalty)
+	assert.Equal(t, sdk.NewInt(10), bk.balances[artist].AmountOf("umis"))
+	assert.Equal(t, sdk.NewInt(390), bk.balances[seller].AmountOf("umis"))
+	assert.Equal(t, sdk.NewInt(600), bk.balances[buyer].AmountOf("umis"))
+	assert.Equal(t, buyer, nk.GetOwner(ctx, "art", "piece1").String())
+
+	// the buyer can't pay more than it has, a gift pays nothing
+	_, err = srv.TransferNFT(wctx, types.NewMsgTransferNFT("piece1", "art", buyer, seller, sdk.NewCoins(sdk.NewInt64Coin("umis", 1000))))
+	require.Error(t, err)
+	_, err = srv.TransferNFT(wctx, types.NewMsgTransferNFT("piece1", "art", buyer, seller, nil))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(600), bk.balances[buyer].AmountOf("umis"))
+	assert.Equal(t, seller, nk.GetOwner(ctx, "art", "piece1").String())
+
+	// a sale between mises ids routes the royalty too
+	sellerDid, buyerDid := types.DIDPrefixForUser+seller, types.DIDPrefixForUser+buyer
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: sellerDid, DidType: types.DIDTypeUser})
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: buyerDid, DidType: types.DIDTypeUser})
+	artistBalance, sellerBalance, buyerBalance := bk.balances[artist], bk.balances[seller], bk.balances[buyer]
+	didMsg := types.NewMsgTransferDidNFT("piece1", "art", sellerDid, buyerDid, sdk.NewCoins(sdk.NewInt64Coin("umis", 200)))
+	require.Len(t, didMsg.GetSigners(), 2)
+	didRes, err := srv.TransferDidNFT(wctx, didMsg)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umis", 5)), didRes.Royalty)
+	assert.Equal(t, artistBalance.Add(sdk.NewInt64Coin("umis", 5)), bk.balances[artist])
+	assert.Equal(t, sellerBalance.Add(sdk.NewInt64Coin("umis", 195)), bk.balances[seller])
+	assert.Equal(t, buyerBalance.Sub(sdk.NewCoins(sdk.NewInt64Coin("umis", 200))), bk.balances[buyer])
+	assert.Equal(t, buyer, nk.GetOwner(ctx, "art", "piece1").String())
+}
+
+func TestNFTMsgServerSoulbound(t *testing.T) {
+	keeper, nk, _, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	issuer := sdk.AccAddress([]byte("issuer")).String()
+	holder := sdk.AccAddress([]byte("holder")).String()
+	other := sdk.AccAddress([]byte("other")).String()
+
+	_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("badge", "Badge", "uri", "", "BDG", nil, issuer, "", 0, true))
+	require.NoError(t, err)
+	assert.True(t, keeper.IsSoulboundNFTClass(ctx, "badge"))
+	for _, id := range []string{"badge1", "badge2"} {
+		_, err = srv.MintNFT(wctx, types.NewMsgMintNFT(id, "badge", "", "uri", nil, issuer, holder))
+		require.NoError(t, err)
+	}
+
+	// only the issuer updates a badge
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("badge1", "badge", "gold", nil, holder))
+	require.Error(t, err)
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("badge1", "badge", "silver", nil, issuer))
+	require.NoError(t, err)
+	token, _ := nk.GetNFT(ctx, "badge", "badge1")
+	assert.Equal(t, "silver", token.Uri)
+	assert.Equal(t, holder, nk.GetOwner(ctx, "badge", "badge1").String())
+
+	// soulbound nfts can't be transferred
+	_, err = srv.TransferNFT(wctx, types.NewMsgTransferNFT("badge1", "badge", holder, other, nil))
+	require.Error(t, err)
+
+	// the issuer revokes, the holder burns
+	_, err = srv.RevokeNFT(wctx, types.NewMsgRevokeNFT("badge1", "badge", holder))
+	require.Error(t, err)
+	_, err = srv.RevokeNFT(wctx, types.NewMsgRevokeNFT("badge1", "badge", issuer))
+	require.NoError(t, err)
+	assert.False(t, nk.HasNFT(ctx, "badge", "badge1"))
+	_, err = srv.BurnNFT(wctx, types.NewMsgBurnNFT("badge2", "badge", holder))
+	require.NoError(t, err)
+
+	// nfts of other classes can't be revoked
+	_, err = srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("art", "Art", "uri", "", "ART", nil, issuer, "", 0, false))
+	require.NoError(t, err)
+	_, err = srv.MintNFT(wctx, types.NewMsgMintNFT("piece1", "art", "", "uri", nil, issuer, holder))
+	require.NoError(t, err)
+	_, err = srv.RevokeNFT(wctx, types.NewMsgRevokeNFT("piece1", "art", issuer))
+	require.Error(t, err)
+}
+
+func TestNFTMsgServerSchema(t *testing.T) {
+	keeper, _, _, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	issuer := sdk.AccAddress([]byte("issuer")).String()
+	holder := sdk.AccAddress([]byte("holder")).String()
+	metadata := func(json string) *codectypes.Any {
+		data, err := codectypes.NewAnyWithValue(&types.NFTMetadata{Json: json})
+		require.NoError(t, err)
+		return data
+	}
+
+	schema := `{"type":"object","required":["level"],"additionalProperties":false,"properties":{` +
+		`"level":{"type":"integer","minimum":1,"maximum":10},"tags":{"type":"array","maxItems":2,"items":{"type":"string","maxLength":8}}}}`
+	_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", `{"type":"object","pattern":"x"}`, "CRD", nil, issuer, "", 0, false))
+	require.Error(t, err)
+	_, err = srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", schema, "CRD", nil, issuer, "", 0, false))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		data *codectypes.Any
+		err  bool
+	}{
+		{desc: "Valid", data: metadata(`{"level":3,"tags":["rare"]}`)},
+		{desc: "NoData", err: true},
+		{desc: "NotMetadata", data: &codectypes.Any{TypeUrl: "/cosmos.nft.v1beta1.NFT"}, err: true},
+		{desc: "InvalidJSON", data: metadata(`{"level":3`), err: true},
+		{desc: "Missing", data: metadata(`{"tags":[]}`), err: true},
+		{desc: "NotInteger", data: metadata(`{"level":3.5}`), err: true},
+		{desc: "OutOfRange", data: metadata(`{"level":11}`), err: true},
+		{desc: "TooManyItems", data: metadata(`{"level":1,"tags":["a","b","c"]}`), err: true},
+		{desc: "TooLong", data: metadata(`{"level":1,"tags":["legendary"]}`), err: true},
+		{desc: "Additional", data: metadata(`{"level":1,"owner":"me"}`), err: true},
+		{desc: "HugeExponent", data: metadata(`{"level":1e1000000}`), err: true},
+		{desc: "LongNumber", data: metadata(`{"level":1.` + strings.Repeat("0", 64) + `}`), err: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.MintNFT(wctx, types.NewMsgMintNFT("card-"+tc.desc, "card", "", "uri", tc.data, issuer, holder))
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// validation is charged per byte and per validated value
+	parsed, err := types.ParseNFTSchema(schema, types.DefaultParams().NFTSchemaLimits())
+	require.NoError(t, err)
+	data := `{"level":1,"tags":["a","b"]}`
+	gasMeter := sdk.NewInfiniteGasMeter()
+	require.NoError(t, parsed.Validate([]byte(data), gasMeter))
+	require.Equal(t, types.NFTDataGasPerByte*uint64(len(data))+5*types.NFTDataGasPerNode, gasMeter.GasConsumed())
+	// the schema of the class is charged as well when it is parsed for the data
+	gasMeter = sdk.NewInfiniteGasMeter()
+	classInfo := types.NFTClassInfo{ClassId: "card", Schema: schema}
+	require.NoError(t, classInfo.ValidateData(metadata(data), types.DefaultParams().NFTSchemaLimits(), gasMeter))
+	require.Equal(t, types.NFTDataGasPerByte*uint64(len(schema)+len(data))+5*types.NFTDataGasPerNode, gasMeter.GasConsumed())
+	// number literals are bounded before being parsed
+	err = parsed.Validate([]byte(`{"level":1e1000000}`), sdk.NewInfiniteGasMeter())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exponent")
+
+	// updates are checked as well, keeping the data needs no check
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("card-Valid", "card", "uri2", metadata(`{"level":0}`), holder))
+	require.Error(t, err)
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("card-Valid", "card", "uri2", metadata(`{"level":10}`), holder))
+	require.NoError(t, err)
+	_, err = srv.UpdateNFT(wctx, types.NewMsgUpdateNFT("card-Valid", "card", "uri3", nil, holder))
+	require.NoError(t, err)
+}
+
+func TestNFTDidTransferAndQuery(t *testing.T) {
+	keeper, nk, _, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	issuer := sdk.AccAddress([]byte("issuer")).String()
+	holder := sdk.AccAddress([]byte("holder")).String()
+	holderDid := types.DIDPrefixForUser + holder
+	otherDid := types.DIDPrefixForUser + sdk.AccAddress([]byte("other")).String()
+	unknownDid := types.DIDPrefixForUser + sdk.AccAddress([]byte("unknown")).String()
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: holderDid, DidType: types.DIDTypeUser})
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: otherDid, DidType: types.DIDTypeUser})
+
+	for _, classID := range []string{"art", "badge", "empty"} {
+		_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass(classID, classID, "uri", "", "SYM", nil, issuer, "", 0, classID == "badge"))
+		require.NoError(t, err)
+	}
+	for _, token := range [][2]string{{"art", "piece1"}, {"art", "piece2"}, {"badge", "badge1"}} {
+		_, err := srv.MintNFT(wctx, types.NewMsgMintNFT(token[1], token[0], "", "uri", nil, issuer, holder))
+		require.NoError(t, err)
+	}
+
+	// classes not created by misestm, like the ICS-721 vouchers, are listed too
+	for _, classID := range []string{"ibc/voucher", "plain"} {
+		require.NoError(t, nk.SaveClass(ctx, nft.Class{Id: classID}))
+		require.NoError(t, nk.Mint(ctx, nft.NFT{ClassId: classID, Id: "token1"}, sdk.AccAddress([]byte("holder"))))
+	}
+
+	res, err := keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: holderDid})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 4)
+	assert.Equal(t, "art", res.Classes[0].ClassId)
+	assert.Len(t, res.Classes[0].Nfts, 2)
+	assert.Equal(t, "badge", res.Classes[1].ClassId)
+	assert.Equal(t, "ibc/voucher", res.Classes[2].ClassId)
+	assert.Equal(t, "plain", res.Classes[3].ClassId)
+	res, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: holderDid, ClassId: "badge"})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 1)
+
+	// pages are counted in nfts, a class may span several pages
+	res, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: holderDid, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 1)
+	assert.Equal(t, []nft.NFT{{ClassId: "art", Id: "piece1", Uri: "uri"}}, res.Classes[0].Nfts)
+	res, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: holderDid, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 2)
+	assert.Equal(t, "piece2", res.Classes[0].Nfts[0].Id)
+	assert.Equal(t, "badge1", res.Classes[1].Nfts[0].Id)
+	res, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: holderDid, ClassId: "art", Pagination: &query.PageRequest{Offset: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 1)
+	assert.Equal(t, "piece2", res.Classes[0].Nfts[0].Id)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	_, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: unknownDid})
+	require.Error(t, err)
+
+	// both mises ids must exist and the sender must own the nft
+	_, err = srv.TransferDidNFT(wctx, types.NewMsgTransferDidNFT("piece1", "art", holderDid, unknownDid, nil))
+	require.Error(t, err)
+	_, err = srv.TransferDidNFT(wctx, types.NewMsgTransferDidNFT("piece1", "art", otherDid, holderDid, nil))
+	require.Error(t, err)
+	_, err = srv.TransferDidNFT(wctx, types.NewMsgTransferDidNFT("badge1", "badge", holderDid, otherDid, nil))
+	require.Error(t, err)
+	_, err = srv.TransferDidNFT(wctx, types.NewMsgTransferDidNFT("piece1", "art", holderDid, otherDid, nil))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.AccAddress([]byte("other")), nk.GetOwner(ctx, "art", "piece1"))
+
+	res, err = keeper.QueryNFT(wctx, &types.RestQueryNFTRequest{MisesId: otherDid})
+	require.NoError(t, err)
+	require.Len(t, res.Classes, 1)
+	assert.Equal(t, "piece1", res.Classes[0].Nfts[0].Id)
+}
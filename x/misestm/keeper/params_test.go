@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetParams(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+
+	keeper.SetParams(ctx, params)
+
+	require.EqualValues(t, params, keeper.GetParams(ctx))
+}
+
+func TestRelationTypeRegistry(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	params := keeper.GetParams(ctx)
+
+	relType, err := params.RelTypeFromNames("following,muting")
+	require.NoError(t, err)
+	require.Equal(t, types.RelTypeBitFollow|types.RelTypeBitMute, relType)
+	require.Equal(t, []string{"following", "muting"}, params.RelTypeNames(relType))
+
+	_, err = params.RelTypeFromNames("unknown")
+	require.Error(t, err)
+
+	params.RelationTypes = append(params.RelationTypes, types.RelationType{Bit: 3, Name: "invalid"})
+	require.Error(t, params.Validate())
+
+	params.RelationTypes = params.RelationTypes[1:5]
+	require.Error(t, params.Validate())
+}
+
+func TestParamsQuery(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.MaxRelationBatch = 50
+	params.DidCreationFee = sdk.NewCoins(sdk.NewInt64Coin("umis", 1000))
+	keeper.SetParams(ctx, params)
+
+	res, err := keeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, params, res.Params)
+
+	_, err = keeper.Params(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestParamLimits(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MaxProfileFieldLength = 4
+	params.MaxProfileListItems = 1
+	params.MaxPrivateInfoLength = 8
+	require.NoError(t, params.CheckUserInfo(&types.PublicUserInfo{Name: "abcd", Emails: []string{"a@b"}}, &types.PrivateUserInfo{EncData: "1234", Iv: "5678"}))
+	require.Error(t, params.CheckUserInfo(&types.PublicUserInfo{Name: "abcde"}, nil))
+	require.Error(t, params.CheckUserInfo(&types.PublicUserInfo{Emails: []string{"a@b", "c@d"}}, nil))
+	require.Error(t, params.CheckUserInfo(&types.PublicUserInfo{Telephones: []string{"123456"}}, nil))
+	require.Error(t, params.CheckUserInfo(nil, &types.PrivateUserInfo{EncData: "123456789"}))
+
+	params = types.DefaultParams()
+	params.MaxUserListMembers = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DidCreationFee = sdk.Coins{sdk.Coin{Denom: "umis", Amount: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+}
+
+func TestTextLengthParams(t *testing.T) {
+	keeper, _, ctx := setupDenomKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sdk.AccAddress([]byte("text owner")).String()
+	owner := types.DIDPrefixForUser + creator
+	keeper.SetMisesAccount(ctx, types.MisesAccount{MisesID: owner, DidType: types.DIDTypeUser})
+
+	params := keeper.GetParams(ctx)
+	params.MaxUserListNameLength = 4
+	params.MaxUserListDescriptionLength = 4
+	params.MaxCommunityNameLength = 4
+	params.MaxCommunityDescriptionLength = 4
+	keeper.SetParams(ctx, params)
+
+	_, err := srv.CreateUserList(wctx, types.NewMsgCreateUserList(creator, owner, "abcde", "", types.UserListVisibilityPublic, nil))
+	require.Error(t, err)
+	_, err = srv.CreateUserList(wctx, types.NewMsgCreateUserList(creator, owner, "abcd", "abcde", types.UserListVisibilityPublic, nil))
+	require.Error(t, err)
+	res, err := srv.CreateUserList(wctx, types.NewMsgCreateUserList(creator, owner, "abcd", "abcd", types.UserListVisibilityPublic, nil))
+	require.NoError(t, err)
+	_, err = srv.RenameUserList(wctx, types.NewMsgRenameUserList(creator, res.Id, "abcde", 1))
+	require.Error(t, err)
+
+	_, err = srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(creator, owner, "abcde", "", types.CommunityJoinPolicyOpen))
+	require.Error(t, err)
+	_, err = srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(creator, owner, "abcd", "abcde", types.CommunityJoinPolicyOpen))
+	require.Error(t, err)
+	_, err = srv.CreateCommunity(wctx, types.NewMsgCreateCommunity(creator, owner, "abcd", "abcd", types.CommunityJoinPolicyOpen))
+	require.NoError(t, err)
+}
+
+func TestNFTSchemaAndProposalParams(t *testing.T) {
+	keeper, _, _, ctx := setupNFTKeeper(t)
+	srv := NewMsgServerImpl(*keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	issuer := sdk.AccAddress([]byte("issuer")).String()
+	schema := `{"type":"object","properties":{"level":{"type":"integer","maximum":100},"tags":{"type":"array","items":{"type":"string"}}}}`
+
+	params := keeper.GetParams(ctx)
+	params.MaxNftSchemaLength = uint64(len(schema)) - 1
+	keeper.SetParams(ctx, params)
+	_, err := srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", schema, "CARD", nil, issuer, "", 0, false))
+	require.Error(t, err)
+
+	params.MaxNftSchemaLength = uint64(len(schema))
+	params.MaxNftSchemaDepth = 1
+	keeper.SetParams(ctx, params)
+	_, err = srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", schema, "CARD", nil, issuer, "", 0, false))
+	require.Error(t, err)
+
+	params.MaxNftSchemaDepth = 2
+	params.MaxNftNumberLength = 2
+	keeper.SetParams(ctx, params)
+	_, err = srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", schema, "CARD", nil, issuer, "", 0, false))
+	require.Error(t, err)
+
+	params.MaxNftNumberLength = 3
+	params.MaxNftNumberExponent = 2
+	keeper.SetParams(ctx, params)
+	_, err = srv.NewNFTClass(wctx, types.NewMsgNewNFTClass("card", "Card", "uri", schema, "CARD", nil, issuer, "", 0, false))
+	require.NoError(t, err)
+	limits := params.NFTSchemaLimits()
+	parsed, err := types.ParseNFTSchema(schema, limits)
+	require.NoError(t, err)
+	require.NoError(t, parsed.Validate([]byte(`{"level":1e2}`), sdk.NewInfiniteGasMeter()))
+	require.Error(t, parsed.Validate([]byte(`{"level":1e-3}`), sdk.NewInfiniteGasMeter()))
+
+	params.MaxAppVerificationReasonLength = 4
+	keeper.SetParams(ctx, params)
+	proposal := types.NewAppVerificationProposal("title", "description", types.DIDPrefixForApp+issuer, true, "abcde")
+	require.NoError(t, proposal.ValidateBasic())
+	require.Error(t, keeper.HandleAppVerificationProposal(ctx, proposal))
+}
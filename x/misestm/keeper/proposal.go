@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// HandleAppVerificationProposal marks an app as verified or revokes the verification
+func (k Keeper) HandleAppVerificationProposal(ctx sdk.Context, p *types.AppVerificationProposal) error {
+	if maxLength := k.GetParams(ctx).MaxAppVerificationReasonLength; uint64(len(p.Reason)) > maxLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reason longer than %d", maxLength)
+	}
+	appMgr := NewAppMgrImpl(k)
+	misesAcc, err := appMgr.GetAppAccount(ctx, p.Appid)
+	if err != nil {
+		return err
+	}
+	if misesAcc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "app %s not exists", p.Appid)
+	}
+	if !k.HasAppInfo(ctx, misesAcc.InfoID) {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("app info key %d doesn't exist", misesAcc.InfoID))
+	}
+
+	AppInfo := k.GetAppInfo(ctx, misesAcc.InfoID)
+	AppInfo.Verified = p.Verified
+	AppInfo.VerificationReason = p.Reason
+	AppInfo.VerificationHeight = ctx.BlockHeight()
+	k.SetAppInfo(ctx, AppInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAppVerification,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAppid, p.Appid),
+			sdk.NewAttribute(types.AttributeKeyVerified, strconv.FormatBool(p.Verified)),
+		),
+	)
+	return nil
+}
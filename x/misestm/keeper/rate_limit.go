@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// ConsumeRateLimit counts one more action of the given kind for the given account
+// in the current rate limit window, and fails once the quota of the window is used up.
+// A zero quota disables the limit.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, action string, account string, quota uint64) error {
+	if quota == 0 {
+		return nil
+	}
+	window := uint64(ctx.BlockHeight()) / k.GetParams(ctx).RateLimitWindow
+
+	lastWindow, count := k.GetRateLimitCounter(ctx, action, account)
+	if lastWindow != window {
+		count = 0
+	}
+	if count >= quota {
+		return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "%s of %s exceeds %d per window", action, account, quota)
+	}
+	k.SetRateLimitCounter(ctx, action, account, window, count+1)
+	return nil
+}
+
+// GetRateLimitCounter returns the window of the last counted action and the count in that window
+func (k Keeper) GetRateLimitCounter(ctx sdk.Context, action string, account string) (window uint64, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKey))
+	bz := store.Get(GetRateLimitKeyBytes(action, account))
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:])
+}
+
+// SetRateLimitCounter set the counter of an action in the given window
+func (k Keeper) SetRateLimitCounter(ctx sdk.Context, action string, account string, window uint64, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKey))
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], window)
+	binary.BigEndian.PutUint64(bz[8:], count)
+	store.Set(GetRateLimitKeyBytes(action, account), bz)
+}
+
+func GetRateLimitKeyBytes(action string, account string) []byte {
+	return []byte(action + "/" + account)
+}
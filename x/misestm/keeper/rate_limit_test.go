@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumeRateLimit(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.RateLimitWindow = 10
+	keeper.SetParams(ctx, params)
+
+	action := types.RateLimitActionUpdateUserRelation
+	quota := uint64(3)
+	ctx = ctx.WithBlockHeight(1)
+	for i := uint64(0); i < quota; i++ {
+		require.NoError(t, keeper.ConsumeRateLimit(ctx, action, "did:mises:a", quota))
+	}
+	require.ErrorIs(t, keeper.ConsumeRateLimit(ctx, action, "did:mises:a", quota), types.ErrRateLimitExceeded)
+
+	// other accounts and actions have their own counters
+	require.NoError(t, keeper.ConsumeRateLimit(ctx, action, "did:mises:b", quota))
+	require.NoError(t, keeper.ConsumeRateLimit(ctx, types.RateLimitActionUpdateUserInfo, "did:mises:a", quota))
+
+	// a zero quota never limits
+	require.NoError(t, keeper.ConsumeRateLimit(ctx, action, "did:mises:a", 0))
+
+	// the counter resets in the next window
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, keeper.ConsumeRateLimit(ctx, action, "did:mises:a", quota))
+	window, count := keeper.GetRateLimitCounter(ctx, action, "did:mises:a")
+	require.Equal(t, uint64(1), window)
+	require.Equal(t, uint64(1), count)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -100,18 +101,44 @@ func (k *userMgr) userRelationFromBsonBytes(rawResult []byte) (*types.UserRelati
 	if val, ok := bsonVal.Map()["isreferredby"]; ok {
 		UserRelation.IsReferredBy = val.(bool)
 	}
+	if val, ok := bsonVal.Map()["reltype"]; ok {
+		UserRelation.RelType = uint64(val.(int64))
+	}
 	if val, ok := bsonVal.Map()["version"]; ok {
 		UserRelation.Version = uint64(val.(int64))
 	}
+	var flagsDoc struct {
+		Flags []types.RelationFlag
+	}
+	if err := bson.Unmarshal(rawResult, &flagsDoc); err != nil {
+		return nil, err
+	}
+	UserRelation.Flags = flagsDoc.Flags
+	UserRelation.SetRelTypeMask(UserRelation.GetRelTypeMask())
 	return &UserRelation, nil
 }
 
-func (k *userMgr) GetUserRelations(ctx sdk.Context, relType uint64, didFrom string, lastDidTo string, limit int) ([]*types.UserRelation, error) {
+func (k *userMgr) GetUserRelations(ctx sdk.Context, relType uint64, didFrom string, lastKey string, limit int, sort string, followedSince int64) ([]*types.UserRelation, error) {
+	lastDidTo := lastKey
+	lastHeight := int64(0)
+	switch sort {
+	case "":
+	case types.UserRelationSortFollowedSince:
+		if lastKey != "" {
+			var err error
+			lastHeight, lastDidTo, err = types.ParseFollowedSinceKey(lastKey)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pagination key %s", lastKey)
+			}
+		}
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown sort %s", sort)
+	}
 	if didFrom == lastDidTo {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "from user must diff from last to user")
 	}
-	if limit > 100 {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit must small than 100")
+	if maxBatch := k.GetParams(ctx).MaxRelationBatch; uint64(limit) > maxBatch {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit must small than %d", maxBatch)
 	}
 	if limit < 1 {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit must large than 1")
@@ -143,7 +170,7 @@ func (k *userMgr) GetUserRelations(ctx sdk.Context, relType uint64, didFrom stri
 			"uidfrom":  bson.M{"$eq": didFrom},
 			"isLatest": bson.M{"$eq": 1},
 		}
-		if lastDidTo != "" {
+		if lastDidTo != "" && sort == "" {
 			filter["uidto"] = bson.M{"$gt": lastDidTo}
 		}
 		if relType&types.RelTypeBitFollow != 0 {
@@ -158,10 +185,53 @@ func (k *userMgr) GetUserRelations(ctx sdk.Context, relType uint64, didFrom stri
 			filter["isreferredby"] = bson.M{"$eq": true}
 		}
 
-		findOptions := options.Find()
-		findOptions.SetSort(bson.M{"uidto": 1})
+		// relations stored before the relation type registry carry only the built-in bools
+		builtin := types.RelTypeBitFollow | types.RelTypeBitBlock | types.RelTypeBitReferredBy
+		if relType&^builtin != 0 {
+			filter["reltype"] = bson.M{"$bitsAllSet": int64(relType &^ builtin)}
+		}
 
-		cursor, err := collection.Find(context.Background(), filter, findOptions)
+		// relations followed before the since metadata was recorded carry no flags
+		// and are left out when sorting or filtering by follow time
+		var cursor *mongo.Cursor
+		if sort != "" || followedSince != 0 {
+			followFlag := bson.M{"reltype": int64(types.RelTypeBitFollow)}
+			if followedSince != 0 {
+				followFlag["sincetime"] = bson.M{"$gte": time.Unix(followedSince, 0).UTC()}
+			}
+			filter["flags"] = bson.M{"$elemMatch": followFlag}
+		}
+		if sort == types.UserRelationSortFollowedSince {
+			followedSinceHeight := bson.M{"$arrayElemAt": bson.A{
+				bson.M{"$map": bson.M{
+					"input": bson.M{"$filter": bson.M{
+						"input": "$flags",
+						"as":    "f",
+						"cond":  bson.M{"$eq": bson.A{"$$f.reltype", int64(types.RelTypeBitFollow)}},
+					}},
+					"as": "f",
+					"in": "$$f.sinceheight",
+				}},
+				0,
+			}}
+			pipeline := bson.A{
+				bson.M{"$match": filter},
+				bson.M{"$addFields": bson.M{"followedsince": followedSinceHeight}},
+			}
+			if lastDidTo != "" {
+				pipeline = append(pipeline, bson.M{"$match": bson.M{"$or": bson.A{
+					bson.M{"followedsince": bson.M{"$lt": lastHeight}},
+					bson.M{"followedsince": lastHeight, "uidto": bson.M{"$gt": lastDidTo}},
+				}}})
+			}
+			pipeline = append(pipeline, bson.M{"$sort": bson.D{{Key: "followedsince", Value: -1}, {Key: "uidto", Value: 1}}})
+			cursor, err = collection.Aggregate(context.Background(), pipeline)
+		} else {
+			findOptions := options.Find()
+			findOptions.SetSort(bson.M{"uidto": 1})
+
+			cursor, err = collection.Find(context.Background(), filter, findOptions)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,22 @@
+package misestm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/mises-id/mises-tm/x/misestm/keeper"
+	"github.com/mises-id/mises-tm/x/misestm/types"
+)
+
+// NewProposalHandler creates a governance handler for the misestm proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AppVerificationProposal:
+			return k.HandleAppVerificationProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
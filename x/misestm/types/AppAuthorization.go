@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// scopes a user can authorize an app for
+const (
+	AppAuthScopeReadPrivateInfo = "read-private-info"
+	AppAuthScopeUpdateUserInfo  = "update-user-info"
+	AppAuthScopeUpdateRelations = "update-relations"
+	AppAuthScopeManageLists     = "manage-lists"
+	AppAuthScopeCommunities     = "communities"
+	AppAuthScopePostContent     = "post-content"
+)
+
+// AppAuthScopes lists the known scopes, scopes without messages are only recorded for apps to check
+var AppAuthScopes = []string{
+	AppAuthScopeReadPrivateInfo,
+	AppAuthScopeUpdateUserInfo,
+	AppAuthScopeUpdateRelations,
+	AppAuthScopeManageLists,
+	AppAuthScopeCommunities,
+	AppAuthScopePostContent,
+}
+
+// IsValidAppAuthScope checks the scope is known
+func IsValidAppAuthScope(scope string) bool {
+	for _, s := range AppAuthScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AppAuthScopeOfMsg returns the scope an app needs to execute the message for a user
+func AppAuthScopeOfMsg(msg sdk.Msg) (string, bool) {
+	switch msg.(type) {
+	case *MsgUpdateUserInfo:
+		return AppAuthScopeUpdateUserInfo, true
+	case *MsgUpdateUserRelation:
+		return AppAuthScopeUpdateRelations, true
+	case *MsgCreateUserList, *MsgRenameUserList, *MsgAddUserListMembers, *MsgRemoveUserListMembers, *MsgDeleteUserList:
+		return AppAuthScopeManageLists, true
+	case *MsgJoinCommunity, *MsgKickCommunityMember, *MsgApproveCommunityMember:
+		return AppAuthScopeCommunities, true
+	}
+	return "", false
+}
+
+// HasScope checks the authorization grants the scope
+func (a AppAuthorization) HasScope(scope string) bool {
+	for _, s := range a.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired checks whether the authorization expired at the given block time
+func (a AppAuthorization) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
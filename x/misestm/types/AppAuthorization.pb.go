@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/AppAuthorization.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AppAuthorization records the scopes a user DID authorized an app DID for
+type AppAuthorization struct {
+	Uid    string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Appid  string   `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// nil means the authorization never expires
+	Expiration  *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	GrantHeight int64      `protobuf:"varint,5,opt,name=grant_height,json=grantHeight,proto3" json:"grant_height,omitempty"`
+	Version     uint64     `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *AppAuthorization) Reset()         { *m = AppAuthorization{} }
+func (m *AppAuthorization) String() string { return proto.CompactTextString(m) }
+func (*AppAuthorization) ProtoMessage()    {}
+func (*AppAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32517879451c4e52, []int{0}
+}
+func (m *AppAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppAuthorization.Merge(m, src)
+}
+func (m *AppAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppAuthorization proto.InternalMessageInfo
+
+func (m *AppAuthorization) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AppAuthorization) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *AppAuthorization) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *AppAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *AppAuthorization) GetGrantHeight() int64 {
+	if m != nil {
+		return m.GrantHeight
+	}
+	return 0
+}
+
+func (m *AppAuthorization) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AppAuthorization)(nil), "misesid.misestm.v1beta1.AppAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("misestm/v1beta1/AppAuthorization.proto", fileDescriptor_32517879451c4e52)
+}
+
+var fileDescriptor_32517879451c4e52 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x18, 0x84, 0x6b, 0xd2, 0x16, 0xd5, 0x65, 0xa8, 0xac, 0x0a, 0xa2, 0x0e, 0x69, 0x60, 0x40, 0x19,
+	0xc0, 0x56, 0xe1, 0x05, 0x68, 0x07, 0xc4, 0x1c, 0x31, 0xb1, 0xa0, 0xa4, 0x31, 0x8e, 0x25, 0x5c,
+	0x5b, 0xf1, 0x9f, 0xaa, 0xf0, 0x14, 0x7d, 0x2c, 0xc6, 0xb2, 0xb1, 0x81, 0xda, 0x17, 0x41, 0x71,
+	0x12, 0x09, 0xb1, 0xdd, 0x9d, 0x3e, 0xfb, 0x4e, 0x36, 0xbe, 0x54, 0xd2, 0x72, 0x0b, 0x8a, 0xad,
+	0x67, 0x29, 0x87, 0x64, 0xc6, 0xe6, 0xc6, 0xcc, 0x4b, 0xc8, 0x75, 0x21, 0xdf, 0x13, 0x90, 0x7a,
+	0x45, 0x4d, 0xa1, 0x41, 0x93, 0x33, 0xc7, 0xc9, 0x8c, 0x36, 0x3c, 0x6d, 0xf8, 0xc9, 0x58, 0x68,
+	0xa1, 0x1d, 0xc3, 0x2a, 0x55, 0xe3, 0x93, 0xa9, 0xd0, 0x5a, 0xbc, 0x72, 0xe6, 0x5c, 0x5a, 0xbe,
+	0x30, 0x90, 0x8a, 0x5b, 0x48, 0x94, 0xa9, 0x81, 0x8b, 0x4f, 0x84, 0x47, 0xff, 0xab, 0xc8, 0x08,
+	0x7b, 0xa5, 0xcc, 0x7c, 0x14, 0xa2, 0x68, 0x10, 0x57, 0x92, 0x8c, 0x71, 0x2f, 0x31, 0x46, 0x66,
+	0xfe, 0x91, 0xcb, 0x6a, 0x43, 0x4e, 0x71, 0xdf, 0x2e, 0xb5, 0xe1, 0xd6, 0xf7, 0x42, 0x2f, 0x1a,
+	0xc4, 0x8d, 0x23, 0x77, 0x18, 0xf3, 0x8d, 0x91, 0x85, 0xbb, 0xcd, 0xef, 0x86, 0x28, 0x1a, 0xde,
+	0x4c, 0x68, 0x3d, 0x85, 0xb6, 0x53, 0xe8, 0x63, 0x3b, 0x65, 0xd1, 0xdd, 0x7e, 0x4f, 0x51, 0xfc,
+	0xe7, 0x0c, 0x39, 0xc7, 0x27, 0xa2, 0x48, 0x56, 0xf0, 0x9c, 0x73, 0x29, 0x72, 0xf0, 0x7b, 0x21,
+	0x8a, 0xbc, 0x78, 0xe8, 0xb2, 0x07, 0x17, 0x11, 0x1f, 0x1f, 0xaf, 0x79, 0x61, 0xab, 0x86, 0x7e,
+	0x88, 0xa2, 0x6e, 0xdc, 0xda, 0xc5, 0xfd, 0xc7, 0x3e, 0x40, 0xbb, 0x7d, 0x80, 0x7e, 0xf6, 0x01,
+	0xda, 0x1e, 0x82, 0xce, 0xee, 0x10, 0x74, 0xbe, 0x0e, 0x41, 0xe7, 0xe9, 0x4a, 0x48, 0xc8, 0xcb,
+	0x94, 0x2e, 0xb5, 0x62, 0xee, 0x01, 0xaf, 0x65, 0xd6, 0x08, 0x50, 0x6c, 0xc3, 0xda, 0x4f, 0x80,
+	0x37, 0xc3, 0x6d, 0xda, 0x77, 0x53, 0x6f, 0x7f, 0x07, 0x00, 0xd8, 0x62, 0xe6, 0xe1, 0x9c, 0x01,
+	0x00, 0x00,
+}
+
+func (m *AppAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintAppAuthorization(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GrantHeight != 0 {
+		i = encodeVarintAppAuthorization(dAtA, i, uint64(m.GrantHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAppAuthorization(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintAppAuthorization(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintAppAuthorization(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintAppAuthorization(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAppAuthorization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAppAuthorization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovAppAuthorization(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovAppAuthorization(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAppAuthorization(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAppAuthorization(uint64(l))
+	}
+	if m.GrantHeight != 0 {
+		n += 1 + sovAppAuthorization(uint64(m.GrantHeight))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAppAuthorization(uint64(m.Version))
+	}
+	return n
+}
+
+func sovAppAuthorization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAppAuthorization(x uint64) (n int) {
+	return sovAppAuthorization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantHeight", wireType)
+			}
+			m.GrantHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAppAuthorization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAppAuthorization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAppAuthorization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAppAuthorization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAppAuthorization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAppAuthorization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAppAuthorization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAppAuthorization = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AppDenomNamespace prefixes the denoms issued by apps
+const AppDenomNamespace = "app"
+
+var reAppDenomID = regexp.MustCompile(`^[a-z][a-z0-9]{2,31}$`)
+
+// ValidateAppDenomID checks the id of a denom within an app
+func ValidateAppDenomID(id string) error {
+	if !reAppDenomID.MatchString(id) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom id %s", id)
+	}
+	return nil
+}
+
+// AppDenomPrefix returns the namespace of the denoms issued by an app
+func AppDenomPrefix(appid string) (string, error) {
+	addr, ok := CheckDid(appid, DIDTypeApp)
+	if !ok {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid appid %s", appid)
+	}
+	return fmt.Sprintf("%s/%s/", AppDenomNamespace, addr), nil
+}
+
+// AppDenomOf returns the bank denom of a denom issued by an app
+func AppDenomOf(appid string, id string) (string, error) {
+	prefix, err := AppDenomPrefix(appid)
+	if err != nil {
+		return "", err
+	}
+	return prefix + id, nil
+}
+
+// AppDenomMetadata namespaces the units of the metadata given by an app under the app,
+// the base unit is the denom id, a missing metadata only describes the base unit
+func AppDenomMetadata(appid string, id string, meta *banktypes.Metadata) (banktypes.Metadata, error) {
+	prefix, err := AppDenomPrefix(appid)
+	if err != nil {
+		return banktypes.Metadata{}, err
+	}
+	if meta == nil {
+		meta = &banktypes.Metadata{Name: id, Symbol: id}
+	}
+
+	metadata := *meta
+	metadata.Base = prefix + id
+	if metadata.Display == "" {
+		metadata.Display = metadata.Base
+	} else {
+		metadata.Display = prefix + metadata.Display
+	}
+	if len(metadata.DenomUnits) == 0 {
+		metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: id}}
+	}
+	units := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		namespaced := *unit
+		namespaced.Denom = prefix + unit.Denom
+		units = append(units, &namespaced)
+	}
+	metadata.DenomUnits = units
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return metadata, nil
+}
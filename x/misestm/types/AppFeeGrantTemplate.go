@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// Allowance builds the periodic fee allowance described by the template,
+// the allowance starts at the given block time
+func (t AppFeeGrantTemplate) Allowance(blockTime time.Time) *feegrant.PeriodicAllowance {
+	var expiration *time.Time
+	if t.Expiry > 0 {
+		exp := blockTime.Add(t.Expiry)
+		expiration = &exp
+	}
+	periodSpendLimit := t.PeriodSpendLimit
+	if periodSpendLimit.Empty() {
+		periodSpendLimit = t.SpendLimit
+	}
+	return &feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: t.SpendLimit,
+			Expiration: expiration,
+		},
+		Period:           t.Period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(t.Period),
+	}
+}
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/AppFeeGrantTemplate.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AppFeeGrantTemplate describes the periodic fee allowance an app grants to its users
+type AppFeeGrantTemplate struct {
+	Appid  string        `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name   string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// total spend limit of the allowance, empty means unlimited
+	SpendLimit       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// lifetime of a grant counted from the block it is granted or renewed in, zero means no expiry
+	Expiry  time.Duration `protobuf:"bytes,6,opt,name=expiry,proto3,stdduration" json:"expiry"`
+	Version uint64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *AppFeeGrantTemplate) Reset()         { *m = AppFeeGrantTemplate{} }
+func (m *AppFeeGrantTemplate) String() string { return proto.CompactTextString(m) }
+func (*AppFeeGrantTemplate) ProtoMessage()    {}
+func (*AppFeeGrantTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_507ba0fea5a6e118, []int{0}
+}
+func (m *AppFeeGrantTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppFeeGrantTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppFeeGrantTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppFeeGrantTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppFeeGrantTemplate.Merge(m, src)
+}
+func (m *AppFeeGrantTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppFeeGrantTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppFeeGrantTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppFeeGrantTemplate proto.InternalMessageInfo
+
+func (m *AppFeeGrantTemplate) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *AppFeeGrantTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppFeeGrantTemplate) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *AppFeeGrantTemplate) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *AppFeeGrantTemplate) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *AppFeeGrantTemplate) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *AppFeeGrantTemplate) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// AppFeeGrantee records a user granted a fee allowance by an app
+type AppFeeGrantee struct {
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Grantee     string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Template    string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	GrantHeight int64  `protobuf:"varint,4,opt,name=grant_height,json=grantHeight,proto3" json:"grant_height,omitempty"`
+}
+
+func (m *AppFeeGrantee) Reset()         { *m = AppFeeGrantee{} }
+func (m *AppFeeGrantee) String() string { return proto.CompactTextString(m) }
+func (*AppFeeGrantee) ProtoMessage()    {}
+func (*AppFeeGrantee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_507ba0fea5a6e118, []int{1}
+}
+func (m *AppFeeGrantee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppFeeGrantee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppFeeGrantee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppFeeGrantee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppFeeGrantee.Merge(m, src)
+}
+func (m *AppFeeGrantee) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppFeeGrantee) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppFeeGrantee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppFeeGrantee proto.InternalMessageInfo
+
+func (m *AppFeeGrantee) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *AppFeeGrantee) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *AppFeeGrantee) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *AppFeeGrantee) GetGrantHeight() int64 {
+	if m != nil {
+		return m.GrantHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AppFeeGrantTemplate)(nil), "misesid.misestm.v1beta1.AppFeeGrantTemplate")
+	proto.RegisterType((*AppFeeGrantee)(nil), "misesid.misestm.v1beta1.AppFeeGrantee")
+}
+
+func init() {
+	proto.RegisterFile("misestm/v1beta1/AppFeeGrantTemplate.proto", fileDescriptor_507ba0fea5a6e118)
+}
+
+var fileDescriptor_507ba0fea5a6e118 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xb1, 0x8e, 0x13, 0x31,
+	0x10, 0x8d, 0x49, 0x2e, 0xb9, 0x73, 0x40, 0x42, 0xe6, 0x24, 0x4c, 0x8a, 0xcd, 0x72, 0xd5, 0x22,
+	0x71, 0x36, 0x07, 0xe5, 0x55, 0x04, 0x74, 0x50, 0x50, 0x2d, 0x54, 0x34, 0xd1, 0x6e, 0x76, 0xd8,
+	0x58, 0xac, 0xd7, 0xd6, 0xda, 0x39, 0x5d, 0x1a, 0xbe, 0x81, 0x92, 0x6f, 0xe0, 0x1b, 0xf8, 0x80,
+	0x2b, 0xaf, 0xa4, 0xe2, 0x50, 0xf2, 0x23, 0x68, 0x6d, 0xef, 0x91, 0x02, 0x0a, 0x24, 0xaa, 0x9d,
+	0x19, 0xcf, 0x9b, 0xf7, 0x3c, 0x6f, 0x8d, 0x1f, 0x49, 0x61, 0xc0, 0x58, 0xc9, 0xcf, 0x4f, 0x72,
+	0xb0, 0xd9, 0x09, 0x7f, 0xae, 0xf5, 0x19, 0xc0, 0xab, 0x26, 0xab, 0xed, 0x3b, 0x90, 0xba, 0xca,
+	0x2c, 0x30, 0xdd, 0x28, 0xab, 0xc8, 0x7d, 0xd7, 0x2a, 0x0a, 0x16, 0x20, 0x2c, 0x40, 0x26, 0x87,
+	0xa5, 0x2a, 0x95, 0xeb, 0xe1, 0x6d, 0xe4, 0xdb, 0x27, 0x51, 0xa9, 0x54, 0x59, 0x01, 0x77, 0x59,
+	0xbe, 0xfa, 0xc0, 0x8b, 0x55, 0x93, 0x59, 0xa1, 0xea, 0xee, 0x7c, 0xa1, 0x8c, 0x54, 0x86, 0xe7,
+	0x99, 0x81, 0x1b, 0xf6, 0x85, 0x12, 0xe1, 0xfc, 0xe8, 0x5b, 0x1f, 0xdf, 0xfb, 0x83, 0x18, 0x72,
+	0x88, 0xf7, 0x32, 0xad, 0x45, 0x41, 0x51, 0x8c, 0x92, 0x83, 0xd4, 0x27, 0x84, 0xe0, 0x41, 0x9d,
+	0x49, 0xa0, 0xb7, 0x5c, 0xd1, 0xc5, 0xe4, 0x14, 0x0f, 0x35, 0x34, 0x42, 0x15, 0xb4, 0x1f, 0xa3,
+	0x64, 0xfc, 0xf4, 0x01, 0xf3, 0x92, 0x58, 0x27, 0x89, 0xbd, 0x0c, 0x92, 0x66, 0xfb, 0x97, 0x3f,
+	0xa6, 0xbd, 0x2f, 0xd7, 0x53, 0x94, 0x06, 0x08, 0xa9, 0xf0, 0xd8, 0x68, 0xa8, 0x8b, 0x79, 0x25,
+	0xa4, 0xb0, 0x74, 0x10, 0xf7, 0xdd, 0x04, 0x2f, 0x9a, 0xb5, 0xa2, 0xbb, 0xfb, 0xb3, 0x17, 0x4a,
+	0xd4, 0xb3, 0x27, 0xed, 0x84, 0xaf, 0xd7, 0xd3, 0xa4, 0x14, 0x76, 0xb9, 0xca, 0xd9, 0x42, 0x49,
+	0x1e, 0x6e, 0xe8, 0x3f, 0xc7, 0xa6, 0xf8, 0xc8, 0xed, 0x5a, 0x83, 0x71, 0x00, 0x93, 0x62, 0x37,
+	0xff, 0x4d, 0x3b, 0x9e, 0xac, 0x31, 0xf1, 0xbc, 0xf3, 0x5d, 0xd2, 0xbd, 0xff, 0x4f, 0x7a, 0xd7,
+	0xd3, 0xbc, 0xfd, 0x4d, 0x7d, 0x8a, 0x87, 0x70, 0xa1, 0x45, 0xb3, 0xa6, 0xc3, 0x7f, 0xd8, 0x92,
+	0x87, 0x10, 0x8a, 0x47, 0xe7, 0xd0, 0x18, 0xa1, 0x6a, 0x3a, 0x8a, 0x51, 0x32, 0x48, 0xbb, 0xf4,
+	0xe8, 0x13, 0xbe, 0xb3, 0xe3, 0x1e, 0xfc, 0xcd, 0x37, 0x8a, 0x47, 0xa5, 0x6f, 0x08, 0xd6, 0x75,
+	0x29, 0x99, 0xe0, 0x7d, 0x1b, 0x3c, 0x77, 0xfe, 0x1d, 0xa4, 0x37, 0x39, 0x79, 0x88, 0x6f, 0xbb,
+	0xb6, 0xf9, 0x12, 0x44, 0xb9, 0x6c, 0xdd, 0x41, 0x49, 0x3f, 0x1d, 0xbb, 0xda, 0x6b, 0x57, 0x9a,
+	0x9d, 0x5d, 0x6e, 0x22, 0x74, 0xb5, 0x89, 0xd0, 0xcf, 0x4d, 0x84, 0x3e, 0x6f, 0xa3, 0xde, 0xd5,
+	0x36, 0xea, 0x7d, 0xdf, 0x46, 0xbd, 0xf7, 0x8f, 0x77, 0x96, 0xe5, 0x7e, 0xe5, 0x63, 0x51, 0x84,
+	0xc0, 0x4a, 0x7e, 0xc1, 0xbb, 0x17, 0xe1, 0xd6, 0x96, 0x0f, 0xdd, 0x1a, 0x9e, 0xfd, 0x1a, 0x00,
+	0x2f, 0xbe, 0xce, 0xaa, 0x29, 0x03, 0x00, 0x00,
+}
+
+func (m *AppFeeGrantTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppFeeGrantTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppFeeGrantTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppFeeGrantee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppFeeGrantee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppFeeGrantee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GrantHeight != 0 {
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(m.GrantHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintAppFeeGrantTemplate(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAppFeeGrantTemplate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAppFeeGrantTemplate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppFeeGrantTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovAppFeeGrantTemplate(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *AppFeeGrantee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovAppFeeGrantTemplate(uint64(l))
+	}
+	if m.GrantHeight != 0 {
+		n += 1 + sovAppFeeGrantTemplate(uint64(m.GrantHeight))
+	}
+	return n
+}
+
+func sovAppFeeGrantTemplate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAppFeeGrantTemplate(x uint64) (n int) {
+	return sovAppFeeGrantTemplate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppFeeGrantTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppFeeGrantTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppFeeGrantTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppFeeGrantTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppFeeGrantTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppFeeGrantee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppFeeGrantTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppFeeGrantee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppFeeGrantee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantHeight", wireType)
+			}
+			m.GrantHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppFeeGrantTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppFeeGrantTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAppFeeGrantTemplate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAppFeeGrantTemplate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppFeeGrantTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAppFeeGrantTemplate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAppFeeGrantTemplate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAppFeeGrantTemplate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAppFeeGrantTemplate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAppFeeGrantTemplate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAppFeeGrantTemplate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,29 @@
+package types
+
+import "strings"
+
+// CurrentOwner returns the address owning the app, the creator until the ownership is transferred
+func (a AppInfo) CurrentOwner() string {
+	if a.Owner == "" {
+		return a.Creator
+	}
+	return a.Owner
+}
+
+// IsAdmin checks the address can manage the app, the owner is always an admin
+func (a AppInfo) IsAdmin(addr string) bool {
+	if addr == a.CurrentOwner() {
+		return true
+	}
+	for _, admin := range a.Admins {
+		if admin == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeAppDomain returns the form a domain is indexed under
+func NormalizeAppDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
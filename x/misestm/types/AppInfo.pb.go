@@ -105,6 +105,15 @@ type AppInfo struct {
 	Appid   string         `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
 	PubInfo *PublicAppInfo `protobuf:"bytes,4,opt,name=pub_info,json=pubInfo,proto3" json:"pub_info,omitempty"`
 	Version uint64         `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// set by governance through AppVerificationProposal
+	Verified           bool   `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerificationReason string `protobuf:"bytes,7,opt,name=verification_reason,json=verificationReason,proto3" json:"verification_reason,omitempty"`
+	VerificationHeight int64  `protobuf:"varint,8,opt,name=verification_height,json=verificationHeight,proto3" json:"verification_height,omitempty"`
+	// empty means the creator still owns the app
+	Owner  string   `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	Admins []string `protobuf:"bytes,10,rep,name=admins,proto3" json:"admins,omitempty"`
+	// set while an ownership transfer waits to be accepted
+	PendingOwner string `protobuf:"bytes,11,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
 func (m *AppInfo) Reset()         { *m = AppInfo{} }
@@ -175,6 +184,48 @@ func (m *AppInfo) GetVersion() uint64 {
 	return 0
 }
 
+func (m *AppInfo) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *AppInfo) GetVerificationReason() string {
+	if m != nil {
+		return m.VerificationReason
+	}
+	return ""
+}
+
+func (m *AppInfo) GetVerificationHeight() int64 {
+	if m != nil {
+		return m.VerificationHeight
+	}
+	return 0
+}
+
+func (m *AppInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AppInfo) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *AppInfo) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PublicAppInfo)(nil), "misesid.misestm.v1beta1.PublicAppInfo")
 	proto.RegisterType((*AppInfo)(nil), "misesid.misestm.v1beta1.AppInfo")
@@ -183,28 +234,34 @@ func init() {
 func init() { proto.RegisterFile("misestm/v1beta1/AppInfo.proto", fileDescriptor_1d7a5e7aca201ac2) }
 
 var fileDescriptor_1d7a5e7aca201ac2 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xeb, 0x34, 0xfd, 0xd3, 0xfa, 0x17, 0x0c, 0x56, 0x25, 0x0c, 0x82, 0xa8, 0xea, 0x80,
-	0x3a, 0x40, 0xa2, 0xc2, 0x13, 0x94, 0x01, 0x89, 0x0d, 0x45, 0xea, 0xc2, 0x52, 0x25, 0xb1, 0xdb,
-	0x5a, 0x8a, 0x73, 0x2d, 0xc7, 0xa9, 0xe0, 0x2d, 0xe0, 0x25, 0x78, 0x16, 0xc6, 0x8e, 0x8c, 0xa8,
-	0x7d, 0x11, 0x14, 0x37, 0x16, 0x62, 0x60, 0x3b, 0xc7, 0xe7, 0xd8, 0xfe, 0x74, 0x2f, 0xbe, 0x90,
-	0xa2, 0xe2, 0x95, 0x91, 0xf1, 0x66, 0x9a, 0x71, 0x93, 0x4e, 0xe3, 0x99, 0x52, 0x0f, 0xe5, 0x12,
-	0x22, 0xa5, 0xc1, 0x00, 0x39, 0xb1, 0xb1, 0x60, 0x51, 0x5b, 0x8b, 0xda, 0xda, 0xd9, 0x70, 0x05,
-	0x2b, 0xb0, 0x9d, 0xb8, 0x51, 0x87, 0xfa, 0xf8, 0x0d, 0xe1, 0xa3, 0xc7, 0x3a, 0x2b, 0x44, 0xde,
-	0x3e, 0x43, 0x08, 0xf6, 0xcb, 0x54, 0x72, 0x8a, 0x46, 0x68, 0x32, 0x48, 0xac, 0x26, 0x14, 0x07,
-	0x0c, 0x64, 0x2a, 0xca, 0x8a, 0x7a, 0xa3, 0xee, 0x64, 0x90, 0x38, 0x4b, 0xce, 0xf1, 0x80, 0xf1,
-	0x0d, 0x2f, 0x40, 0x71, 0x4d, 0xbb, 0xf6, 0xca, 0xcf, 0x01, 0x39, 0xc5, 0xfd, 0x35, 0x48, 0xbe,
-	0xa8, 0x75, 0x41, 0x7d, 0x1b, 0x06, 0x8d, 0x9f, 0xeb, 0xa2, 0x89, 0x44, 0x0e, 0xa5, 0x8d, 0x7a,
-	0x87, 0xa8, 0xf1, 0x73, 0x5d, 0x8c, 0xdf, 0x11, 0x0e, 0x1c, 0x0d, 0xc5, 0x41, 0xae, 0x79, 0x6a,
-	0x40, 0xb7, 0x40, 0xce, 0x92, 0x63, 0xec, 0x09, 0x46, 0xbd, 0x11, 0x9a, 0xf8, 0x89, 0x27, 0x18,
-	0x19, 0xe2, 0x5e, 0xaa, 0x94, 0x60, 0x2d, 0xc5, 0xc1, 0x90, 0x19, 0xee, 0xab, 0x3a, 0x5b, 0x88,
-	0x72, 0x09, 0x96, 0xe0, 0xff, 0xcd, 0x65, 0xf4, 0xc7, 0x84, 0xa2, 0x5f, 0x73, 0x48, 0x02, 0x55,
-	0x67, 0x0e, 0x61, 0xc3, 0x75, 0x25, 0xa0, 0xb4, 0xa0, 0x7e, 0xe2, 0xec, 0xdd, 0xfd, 0xc7, 0x2e,
-	0x44, 0xdb, 0x5d, 0x88, 0xbe, 0x76, 0x21, 0x7a, 0xdd, 0x87, 0x9d, 0xed, 0x3e, 0xec, 0x7c, 0xee,
-	0xc3, 0xce, 0xd3, 0xd5, 0x4a, 0x98, 0x75, 0x9d, 0x45, 0x39, 0xc8, 0xd8, 0x7e, 0x73, 0x2d, 0x58,
-	0x2b, 0x8c, 0x8c, 0x9f, 0x63, 0xb7, 0x43, 0xf3, 0xa2, 0x78, 0x95, 0xfd, 0xb3, 0xbb, 0xb8, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x82, 0x0f, 0xbb, 0xdb, 0x01, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xeb, 0xb4, 0xdb, 0xa4, 0x5e, 0x96, 0x83, 0x59, 0x81, 0x59, 0x41, 0x14, 0x2d, 0x12,
+	0xca, 0x01, 0x12, 0x2d, 0x3c, 0xc1, 0x72, 0x40, 0x70, 0x02, 0x45, 0xda, 0x0b, 0x97, 0x2a, 0x89,
+	0xdd, 0x74, 0xa4, 0xc4, 0xb6, 0x1c, 0xa7, 0xc0, 0x5b, 0xc0, 0x5b, 0x71, 0xdc, 0x23, 0x47, 0xd4,
+	0x3e, 0x04, 0x57, 0x94, 0x49, 0x02, 0x54, 0x88, 0xdb, 0x7c, 0xf3, 0xcf, 0x6f, 0xfd, 0x1e, 0x9b,
+	0x3e, 0x6e, 0xa0, 0x95, 0xad, 0x6b, 0xd2, 0xdd, 0x55, 0x21, 0x5d, 0x7e, 0x95, 0x5e, 0x1b, 0xf3,
+	0x56, 0x6d, 0x74, 0x62, 0xac, 0x76, 0x9a, 0x3d, 0x40, 0x19, 0x44, 0x32, 0x8e, 0x25, 0xe3, 0xd8,
+	0xc5, 0x79, 0xa5, 0x2b, 0x8d, 0x33, 0x69, 0x5f, 0x0d, 0xe3, 0x97, 0x5f, 0x09, 0x3d, 0x7b, 0xdf,
+	0x15, 0x35, 0x94, 0xe3, 0x31, 0x8c, 0xd1, 0x85, 0xca, 0x1b, 0xc9, 0x49, 0x44, 0xe2, 0x55, 0x86,
+	0x35, 0xe3, 0xd4, 0x17, 0xba, 0xc9, 0x41, 0xb5, 0xdc, 0x8b, 0xe6, 0xf1, 0x2a, 0x9b, 0x90, 0x3d,
+	0xa2, 0x2b, 0x21, 0x77, 0xb2, 0xd6, 0x46, 0x5a, 0x3e, 0x47, 0xcb, 0x9f, 0x06, 0x7b, 0x48, 0x83,
+	0xad, 0x6e, 0xe4, 0xba, 0xb3, 0x35, 0x5f, 0xa0, 0xe8, 0xf7, 0x7c, 0x63, 0xeb, 0x5e, 0x82, 0x52,
+	0x2b, 0x94, 0x4e, 0x06, 0xa9, 0xe7, 0x1b, 0x5b, 0x5f, 0xfe, 0xf4, 0xa8, 0x3f, 0xa5, 0xe1, 0xd4,
+	0x2f, 0xad, 0xcc, 0x9d, 0xb6, 0x63, 0xa0, 0x09, 0xd9, 0x5d, 0xea, 0x81, 0xe0, 0x5e, 0x44, 0xe2,
+	0x45, 0xe6, 0x81, 0x60, 0xe7, 0xf4, 0x24, 0x37, 0x06, 0xc4, 0x98, 0x62, 0x00, 0x76, 0x4d, 0x03,
+	0xd3, 0x15, 0x6b, 0x50, 0x1b, 0x8d, 0x09, 0x4e, 0x5f, 0x3c, 0x4d, 0xfe, 0xb3, 0xa1, 0xe4, 0x68,
+	0x0f, 0x99, 0x6f, 0xba, 0x62, 0x8a, 0xb0, 0x93, 0xb6, 0x05, 0xad, 0x30, 0xe8, 0x22, 0x9b, 0x90,
+	0x5d, 0xd0, 0x60, 0x27, 0x2d, 0x6c, 0x40, 0x0a, 0xbe, 0x8c, 0x48, 0x1c, 0x64, 0xbf, 0x99, 0xa5,
+	0xf4, 0xde, 0x50, 0x97, 0xb9, 0x03, 0xad, 0xd6, 0x56, 0xe6, 0xad, 0x56, 0xdc, 0xc7, 0x70, 0xec,
+	0x6f, 0x29, 0x43, 0xe5, 0x1f, 0xc3, 0x56, 0x42, 0xb5, 0x75, 0x3c, 0x88, 0x48, 0x3c, 0x3f, 0x36,
+	0xbc, 0x41, 0xa5, 0xbf, 0xb0, 0xfe, 0xa8, 0xa4, 0xe5, 0xab, 0xe1, 0xc2, 0x08, 0xec, 0x3e, 0x5d,
+	0xe6, 0xa2, 0xe9, 0x5f, 0x8a, 0xe2, 0x4b, 0x8d, 0xc4, 0x9e, 0xd0, 0x33, 0x23, 0x95, 0x00, 0x55,
+	0xad, 0x07, 0xd7, 0x29, 0xba, 0xee, 0x8c, 0xcd, 0x77, 0x7d, 0xef, 0xd5, 0xeb, 0x6f, 0xfb, 0x90,
+	0xdc, 0xee, 0x43, 0xf2, 0x63, 0x1f, 0x92, 0x2f, 0x87, 0x70, 0x76, 0x7b, 0x08, 0x67, 0xdf, 0x0f,
+	0xe1, 0xec, 0xc3, 0xb3, 0x0a, 0xdc, 0xb6, 0x2b, 0x92, 0x52, 0x37, 0x29, 0xee, 0xed, 0x39, 0x88,
+	0xb1, 0x70, 0x4d, 0xfa, 0x29, 0x9d, 0x3e, 0xa5, 0xfb, 0x6c, 0x64, 0x5b, 0x2c, 0xf1, 0x73, 0xbd,
+	0xfc, 0x35, 0x00, 0x49, 0x0e, 0x23, 0x55, 0xac, 0x02, 0x00, 0x00,
 }
 
 func (m *PublicAppInfo) Marshal() (dAtA []byte, err error) {
@@ -287,6 +344,51 @@ func (m *AppInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintAppInfo(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintAppInfo(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAppInfo(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VerificationHeight != 0 {
+		i = encodeVarintAppInfo(dAtA, i, uint64(m.VerificationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.VerificationReason) > 0 {
+		i -= len(m.VerificationReason)
+		copy(dAtA[i:], m.VerificationReason)
+		i = encodeVarintAppInfo(dAtA, i, uint64(len(m.VerificationReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Version != 0 {
 		i = encodeVarintAppInfo(dAtA, i, uint64(m.Version))
 		i--
@@ -392,6 +494,30 @@ func (m *AppInfo) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovAppInfo(uint64(m.Version))
 	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.VerificationReason)
+	if l > 0 {
+		n += 1 + l + sovAppInfo(uint64(l))
+	}
+	if m.VerificationHeight != 0 {
+		n += 1 + sovAppInfo(uint64(m.VerificationHeight))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAppInfo(uint64(l))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovAppInfo(uint64(l))
+		}
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovAppInfo(uint64(l))
+	}
 	return n
 }
 
@@ -778,6 +904,173 @@ func (m *AppInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHeight", wireType)
+			}
+			m.VerificationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAppInfo(dAtA[iNdEx:])
@@ -0,0 +1,12 @@
+package types
+
+// CanModerate returns true if the member can approve and kick members
+func (m *CommunityMember) CanModerate() bool {
+	return m.Status == CommunityMemberStatusActive &&
+		(m.Role == CommunityRoleOwner || m.Role == CommunityRoleModerator)
+}
+
+// IsActive returns true if the DID is a member of the community
+func (m *CommunityMember) IsActive() bool {
+	return m.Status == CommunityMemberStatusActive
+}
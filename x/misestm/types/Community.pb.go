@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/Community.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CommunityJoinPolicy defines how a DID becomes a member of a community
+type CommunityJoinPolicy int32
+
+const (
+	// anyone can join
+	CommunityJoinPolicyOpen CommunityJoinPolicy = 0
+	// join requests are approved by a moderator
+	CommunityJoinPolicyApproval CommunityJoinPolicy = 1
+	// only DIDs invited by a moderator can join
+	CommunityJoinPolicyInviteOnly CommunityJoinPolicy = 2
+)
+
+var CommunityJoinPolicy_name = map[int32]string{
+	0: "COMMUNITY_JOIN_POLICY_OPEN",
+	1: "COMMUNITY_JOIN_POLICY_APPROVAL",
+	2: "COMMUNITY_JOIN_POLICY_INVITE_ONLY",
+}
+
+var CommunityJoinPolicy_value = map[string]int32{
+	"COMMUNITY_JOIN_POLICY_OPEN":        0,
+	"COMMUNITY_JOIN_POLICY_APPROVAL":    1,
+	"COMMUNITY_JOIN_POLICY_INVITE_ONLY": 2,
+}
+
+func (x CommunityJoinPolicy) String() string {
+	return proto.EnumName(CommunityJoinPolicy_name, int32(x))
+}
+
+func (CommunityJoinPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8c6e3eef431f942d, []int{0}
+}
+
+type CommunityRole int32
+
+const (
+	CommunityRoleMember    CommunityRole = 0
+	CommunityRoleModerator CommunityRole = 1
+	CommunityRoleOwner     CommunityRole = 2
+)
+
+var CommunityRole_name = map[int32]string{
+	0: "COMMUNITY_ROLE_MEMBER",
+	1: "COMMUNITY_ROLE_MODERATOR",
+	2: "COMMUNITY_ROLE_OWNER",
+}
+
+var CommunityRole_value = map[string]int32{
+	"COMMUNITY_ROLE_MEMBER":    0,
+	"COMMUNITY_ROLE_MODERATOR": 1,
+	"COMMUNITY_ROLE_OWNER":     2,
+}
+
+func (x CommunityRole) String() string {
+	return proto.EnumName(CommunityRole_name, int32(x))
+}
+
+func (CommunityRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8c6e3eef431f942d, []int{1}
+}
+
+type CommunityMemberStatus int32
+
+const (
+	CommunityMemberStatusActive CommunityMemberStatus = 0
+	// the DID asked to join and waits for approval
+	CommunityMemberStatusPending CommunityMemberStatus = 1
+	// the DID was invited and becomes active when it joins
+	CommunityMemberStatusInvited CommunityMemberStatus = 2
+)
+
+var CommunityMemberStatus_name = map[int32]string{
+	0: "COMMUNITY_MEMBER_STATUS_ACTIVE",
+	1: "COMMUNITY_MEMBER_STATUS_PENDING",
+	2: "COMMUNITY_MEMBER_STATUS_INVITED",
+}
+
+var CommunityMemberStatus_value = map[string]int32{
+	"COMMUNITY_MEMBER_STATUS_ACTIVE":  0,
+	"COMMUNITY_MEMBER_STATUS_PENDING": 1,
+	"COMMUNITY_MEMBER_STATUS_INVITED": 2,
+}
+
+func (x CommunityMemberStatus) String() string {
+	return proto.EnumName(CommunityMemberStatus_name, int32(x))
+}
+
+func (CommunityMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8c6e3eef431f942d, []int{2}
+}
+
+// Community is a group owned by a user or app DID
+type Community struct {
+	Creator     string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id          uint64              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner       string              `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        string              `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string              `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	JoinPolicy  CommunityJoinPolicy `protobuf:"varint,6,opt,name=joinPolicy,proto3,enum=misesid.misestm.v1beta1.CommunityJoinPolicy" json:"joinPolicy,omitempty"`
+	MemberCount uint64              `protobuf:"varint,7,opt,name=memberCount,proto3" json:"memberCount,omitempty"`
+	Version     uint64              `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *Community) Reset()         { *m = Community{} }
+func (m *Community) String() string { return proto.CompactTextString(m) }
+func (*Community) ProtoMessage()    {}
+func (*Community) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6e3eef431f942d, []int{0}
+}
+func (m *Community) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Community) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Community.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Community) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Community.Merge(m, src)
+}
+func (m *Community) XXX_Size() int {
+	return m.Size()
+}
+func (m *Community) XXX_DiscardUnknown() {
+	xxx_messageInfo_Community.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Community proto.InternalMessageInfo
+
+func (m *Community) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Community) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Community) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Community) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Community) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Community) GetJoinPolicy() CommunityJoinPolicy {
+	if m != nil {
+		return m.JoinPolicy
+	}
+	return CommunityJoinPolicyOpen
+}
+
+func (m *Community) GetMemberCount() uint64 {
+	if m != nil {
+		return m.MemberCount
+	}
+	return 0
+}
+
+func (m *Community) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// CommunityMember is the membership of a DID in a community
+type CommunityMember struct {
+	CommunityId uint64                `protobuf:"varint,1,opt,name=communityId,proto3" json:"communityId,omitempty"`
+	Member      string                `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Role        CommunityRole         `protobuf:"varint,3,opt,name=role,proto3,enum=misesid.misestm.v1beta1.CommunityRole" json:"role,omitempty"`
+	Status      CommunityMemberStatus `protobuf:"varint,4,opt,name=status,proto3,enum=misesid.misestm.v1beta1.CommunityMemberStatus" json:"status,omitempty"`
+	SinceHeight int64                 `protobuf:"varint,5,opt,name=sinceHeight,proto3" json:"sinceHeight,omitempty"`
+}
+
+func (m *CommunityMember) Reset()         { *m = CommunityMember{} }
+func (m *CommunityMember) String() string { return proto.CompactTextString(m) }
+func (*CommunityMember) ProtoMessage()    {}
+func (*CommunityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6e3eef431f942d, []int{1}
+}
+func (m *CommunityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMember.Merge(m, src)
+}
+func (m *CommunityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMember proto.InternalMessageInfo
+
+func (m *CommunityMember) GetCommunityId() uint64 {
+	if m != nil {
+		return m.CommunityId
+	}
+	return 0
+}
+
+func (m *CommunityMember) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *CommunityMember) GetRole() CommunityRole {
+	if m != nil {
+		return m.Role
+	}
+	return CommunityRoleMember
+}
+
+func (m *CommunityMember) GetStatus() CommunityMemberStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CommunityMemberStatusActive
+}
+
+func (m *CommunityMember) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("misesid.misestm.v1beta1.CommunityJoinPolicy", CommunityJoinPolicy_name, CommunityJoinPolicy_value)
+	proto.RegisterEnum("misesid.misestm.v1beta1.CommunityRole", CommunityRole_name, CommunityRole_value)
+	proto.RegisterEnum("misesid.misestm.v1beta1.CommunityMemberStatus", CommunityMemberStatus_name, CommunityMemberStatus_value)
+	proto.RegisterType((*Community)(nil), "misesid.misestm.v1beta1.Community")
+	proto.RegisterType((*CommunityMember)(nil), "misesid.misestm.v1beta1.CommunityMember")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/Community.proto", fileDescriptor_8c6e3eef431f942d) }
+
+var fileDescriptor_8c6e3eef431f942d = []byte{
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x69, 0x9a, 0xd2, 0x41, 0x04, 0x6b, 0xfa, 0xcf, 0x72, 0xc1, 0x75, 0xbb, 0x40,
+	0x55, 0x55, 0x12, 0x1a, 0x36, 0x08, 0x56, 0x69, 0xea, 0x52, 0x57, 0x89, 0x1d, 0x4d, 0xd3, 0xa2,
+	0xb2, 0x89, 0x92, 0x78, 0x94, 0x0e, 0x8a, 0x3d, 0x91, 0x3d, 0x09, 0xe4, 0x06, 0x28, 0x6c, 0xb8,
+	0x80, 0x57, 0x9c, 0x81, 0x3b, 0xb0, 0xec, 0x92, 0x25, 0x6a, 0x17, 0x6c, 0xe1, 0x06, 0xc8, 0x63,
+	0x27, 0x24, 0x95, 0xdb, 0xee, 0xe6, 0x3d, 0xbf, 0xdf, 0x37, 0xef, 0x7d, 0xe3, 0x19, 0xb8, 0xe1,
+	0x50, 0x9f, 0xf8, 0xdc, 0x29, 0x0c, 0xf6, 0x5a, 0x84, 0x37, 0xf7, 0x0a, 0x65, 0xe6, 0x38, 0x7d,
+	0x97, 0xf2, 0x61, 0xbe, 0xe7, 0x31, 0xce, 0xd0, 0x9a, 0x28, 0xa0, 0x76, 0x3e, 0x2e, 0xcc, 0xc7,
+	0x85, 0xca, 0x72, 0x87, 0x75, 0x98, 0xa8, 0x29, 0x84, 0xab, 0xa8, 0x7c, 0xeb, 0x4b, 0x1a, 0x2e,
+	0x4e, 0x24, 0x90, 0x0c, 0x17, 0xda, 0x1e, 0x69, 0x72, 0xe6, 0xc9, 0x40, 0x03, 0xdb, 0x8b, 0x78,
+	0x1c, 0xa2, 0x1c, 0x4c, 0x53, 0x5b, 0x4e, 0x6b, 0x60, 0x3b, 0x83, 0xd3, 0xd4, 0x46, 0xcb, 0x70,
+	0x9e, 0x7d, 0x74, 0x89, 0x27, 0xcf, 0x89, 0xba, 0x28, 0x40, 0x08, 0x66, 0xdc, 0xa6, 0x43, 0xe4,
+	0x8c, 0x48, 0x8a, 0x35, 0xd2, 0xe0, 0x43, 0x9b, 0xf8, 0x6d, 0x8f, 0xf6, 0x38, 0x65, 0xae, 0x3c,
+	0x2f, 0x3e, 0x4d, 0xa7, 0x50, 0x05, 0xc2, 0x0f, 0x8c, 0xba, 0x35, 0xd6, 0xa5, 0xed, 0xa1, 0x9c,
+	0xd5, 0xc0, 0x76, 0xae, 0xb8, 0x9b, 0xbf, 0x65, 0x8e, 0xfc, 0xa4, 0xdb, 0xe3, 0x09, 0x83, 0xa7,
+	0xf8, 0x70, 0x3f, 0x87, 0x38, 0x2d, 0xe2, 0x95, 0x59, 0xdf, 0xe5, 0xf2, 0x82, 0x68, 0x79, 0x3a,
+	0x15, 0x4e, 0x39, 0x20, 0x9e, 0x1f, 0x76, 0xf3, 0x40, 0x7c, 0x1d, 0x87, 0x5b, 0x7f, 0x01, 0x7c,
+	0x3c, 0xd1, 0xaf, 0x0a, 0x24, 0xd4, 0x6b, 0x8f, 0x53, 0x86, 0x2d, 0x7c, 0xc9, 0xe0, 0xe9, 0x14,
+	0x5a, 0x85, 0xd9, 0x48, 0x5e, 0xf8, 0xb3, 0x88, 0xe3, 0x08, 0xbd, 0x86, 0x19, 0x8f, 0x75, 0x89,
+	0xb0, 0x28, 0x57, 0x7c, 0x76, 0xff, 0x44, 0x98, 0x75, 0x09, 0x16, 0x0c, 0x3a, 0x84, 0x59, 0x9f,
+	0x37, 0x79, 0xdf, 0x17, 0x5e, 0xe6, 0x8a, 0xf9, 0xfb, 0xe9, 0xa8, 0xdf, 0x13, 0x41, 0xe1, 0x98,
+	0x0e, 0xbb, 0xf7, 0xa9, 0xdb, 0x26, 0x47, 0x84, 0x76, 0x2e, 0xb8, 0x70, 0x7f, 0x0e, 0x4f, 0xa7,
+	0x76, 0x7e, 0x03, 0xb8, 0x94, 0xe0, 0x29, 0x7a, 0x03, 0x95, 0xb2, 0x55, 0xad, 0x9e, 0x9a, 0x46,
+	0xfd, 0xbc, 0x71, 0x6c, 0x19, 0x66, 0xa3, 0x66, 0x55, 0x8c, 0xf2, 0x79, 0xc3, 0xaa, 0xe9, 0xa6,
+	0x94, 0x52, 0xd6, 0x47, 0x81, 0xb6, 0x96, 0x00, 0x5a, 0x3d, 0xe2, 0xa2, 0x32, 0x54, 0x93, 0xe1,
+	0x52, 0xad, 0x86, 0xad, 0xb3, 0x52, 0x45, 0x02, 0xca, 0xc6, 0x28, 0xd0, 0xd6, 0x13, 0x04, 0x4a,
+	0xbd, 0x9e, 0xc7, 0x06, 0xcd, 0x2e, 0x3a, 0x82, 0x9b, 0xc9, 0x22, 0x86, 0x79, 0x66, 0xd4, 0xf5,
+	0x86, 0x65, 0x56, 0xce, 0xa5, 0xb4, 0xb2, 0x39, 0x0a, 0xb4, 0xa7, 0x09, 0x3a, 0x86, 0x3b, 0xa0,
+	0x9c, 0x58, 0x6e, 0x77, 0xa8, 0x64, 0x3e, 0x7f, 0x53, 0x53, 0x3b, 0xdf, 0x01, 0x7c, 0x34, 0xe3,
+	0x35, 0x2a, 0xc2, 0x95, 0xff, 0x3b, 0x60, 0xab, 0xa2, 0x37, 0xaa, 0x7a, 0x75, 0x5f, 0xc7, 0x52,
+	0x4a, 0x59, 0x1b, 0x05, 0xda, 0xd2, 0x4c, 0x75, 0xfc, 0x3f, 0xbc, 0x82, 0xf2, 0x4d, 0xc6, 0x3a,
+	0xd0, 0x71, 0xa9, 0x6e, 0x61, 0x09, 0x28, 0xca, 0x28, 0xd0, 0x56, 0x67, 0x31, 0x66, 0x13, 0x4f,
+	0xdc, 0xa1, 0x17, 0x70, 0xf9, 0x06, 0x69, 0xbd, 0x33, 0x75, 0x2c, 0xa5, 0x95, 0xd5, 0x51, 0xa0,
+	0xa1, 0x19, 0xca, 0x0a, 0xef, 0x53, 0xdc, 0xf7, 0x1f, 0x00, 0x57, 0x12, 0x4f, 0x79, 0xd6, 0xe6,
+	0xa8, 0xf5, 0xc6, 0x49, 0xbd, 0x54, 0x3f, 0x3d, 0x69, 0x94, 0xca, 0x75, 0xe3, 0x4c, 0x97, 0x52,
+	0x37, 0x6c, 0x9e, 0xc6, 0x4b, 0x6d, 0x4e, 0x07, 0x04, 0xe9, 0x70, 0xe3, 0x36, 0x91, 0x9a, 0x6e,
+	0x1e, 0x18, 0xe6, 0x5b, 0x09, 0x28, 0xda, 0x28, 0xd0, 0x9e, 0x24, 0xaa, 0xd4, 0x88, 0x6b, 0x53,
+	0xb7, 0x73, 0x97, 0x4c, 0x74, 0x5e, 0x07, 0x52, 0xfa, 0x0e, 0x99, 0xe8, 0xb4, 0xec, 0x68, 0xe4,
+	0xfd, 0xc3, 0x1f, 0x57, 0x2a, 0xb8, 0xbc, 0x52, 0xc1, 0xaf, 0x2b, 0x15, 0x7c, 0xbd, 0x56, 0x53,
+	0x97, 0xd7, 0x6a, 0xea, 0xe7, 0xb5, 0x9a, 0x7a, 0xbf, 0xdb, 0xa1, 0xfc, 0xa2, 0xdf, 0xca, 0xb7,
+	0x99, 0x53, 0x10, 0x57, 0xe1, 0x39, 0xb5, 0xe3, 0x05, 0x77, 0x0a, 0x9f, 0x0a, 0xe3, 0xf7, 0x91,
+	0x0f, 0x7b, 0xc4, 0x6f, 0x65, 0xc5, 0x2b, 0xf7, 0xf2, 0xdf, 0x00, 0x73, 0xce, 0xc0, 0xad, 0x37,
+	0x05, 0x00, 0x00,
+}
+
+func (m *Community) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Community) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Community) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MemberCount != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.MemberCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JoinPolicy != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.JoinPolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Role != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintCommunity(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintCommunity(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommunity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommunity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Community) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovCommunity(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.JoinPolicy != 0 {
+		n += 1 + sovCommunity(uint64(m.JoinPolicy))
+	}
+	if m.MemberCount != 0 {
+		n += 1 + sovCommunity(uint64(m.MemberCount))
+	}
+	if m.Version != 0 {
+		n += 1 + sovCommunity(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *CommunityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityId != 0 {
+		n += 1 + sovCommunity(uint64(m.CommunityId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovCommunity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCommunity(uint64(m.Role))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCommunity(uint64(m.Status))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovCommunity(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func sovCommunity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommunity(x uint64) (n int) {
+	return sovCommunity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Community) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Community: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Community: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPolicy", wireType)
+			}
+			m.JoinPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPolicy |= CommunityJoinPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+			}
+			m.MemberCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= CommunityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CommunityMemberStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommunity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommunity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCommunity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCommunity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommunity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCommunity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCommunity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCommunity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCommunity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCommunity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCommunity = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SponsoredDidSignBytes returns the bytes a user signs to let an app sponsor the creation of its DID
+func SponsoredDidSignBytes(chainID string, did string, pkeyMultibase string, sponsor string) []byte {
+	bz, err := json.Marshal(map[string]string{
+		"chain_id":      chainID,
+		"did":           did,
+		"pkeyMultibase": pkeyMultibase,
+		"sponsor":       sponsor,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -28,6 +28,8 @@ type MisesAccount struct {
 	DidRegistryID uint64 `protobuf:"varint,2,opt,name=didRegistryID,proto3" json:"didRegistryID,omitempty"`
 	DidType       uint64 `protobuf:"varint,3,opt,name=didType,proto3" json:"didType,omitempty"`
 	InfoID        uint64 `protobuf:"varint,4,opt,name=infoID,proto3" json:"infoID,omitempty"`
+	// app DID that sponsored the creation of the DID
+	Sponsor string `protobuf:"bytes,5,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
 }
 
 func (m *MisesAccount) Reset()         { *m = MisesAccount{} }
@@ -91,8 +93,76 @@ func (m *MisesAccount) GetInfoID() uint64 {
 	return 0
 }
 
+func (m *MisesAccount) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+type AppSponsorStat struct {
+	Appid               string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	SponsoredCount      uint64 `protobuf:"varint,2,opt,name=sponsored_count,json=sponsoredCount,proto3" json:"sponsored_count,omitempty"`
+	LastSponsoredHeight int64  `protobuf:"varint,3,opt,name=last_sponsored_height,json=lastSponsoredHeight,proto3" json:"last_sponsored_height,omitempty"`
+}
+
+func (m *AppSponsorStat) Reset()         { *m = AppSponsorStat{} }
+func (m *AppSponsorStat) String() string { return proto.CompactTextString(m) }
+func (*AppSponsorStat) ProtoMessage()    {}
+func (*AppSponsorStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b237ba365f526e, []int{1}
+}
+func (m *AppSponsorStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppSponsorStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppSponsorStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppSponsorStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppSponsorStat.Merge(m, src)
+}
+func (m *AppSponsorStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppSponsorStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppSponsorStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppSponsorStat proto.InternalMessageInfo
+
+func (m *AppSponsorStat) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *AppSponsorStat) GetSponsoredCount() uint64 {
+	if m != nil {
+		return m.SponsoredCount
+	}
+	return 0
+}
+
+func (m *AppSponsorStat) GetLastSponsoredHeight() int64 {
+	if m != nil {
+		return m.LastSponsoredHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MisesAccount)(nil), "misesid.misestm.v1beta1.MisesAccount")
+	proto.RegisterType((*AppSponsorStat)(nil), "misesid.misestm.v1beta1.AppSponsorStat")
 }
 
 func init() {
@@ -100,22 +170,27 @@ func init() {
 }
 
 var fileDescriptor_b1b237ba365f526e = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcd, 0x2c, 0x4e,
-	0x2d, 0x2e, 0xc9, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xf7, 0x05, 0xf1, 0x1d,
-	0x93, 0x93, 0xf3, 0x4b, 0xf3, 0x4a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xc1, 0x6a,
-	0x32, 0x53, 0xf4, 0xa0, 0x6a, 0xf5, 0xa0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x6a,
-	0xf4, 0x41, 0x2c, 0x88, 0x72, 0xa5, 0x06, 0x46, 0x2e, 0x1e, 0x64, 0x53, 0x84, 0x24, 0xb8, 0xd8,
-	0xc1, 0x3a, 0x3d, 0x5d, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x21, 0x15, 0x2e,
-	0xde, 0x94, 0xcc, 0x94, 0xa0, 0xd4, 0xf4, 0xcc, 0xe2, 0x92, 0xa2, 0x4a, 0x4f, 0x17, 0x09, 0x26,
-	0x05, 0x46, 0x0d, 0x96, 0x20, 0x54, 0x41, 0x90, 0xfe, 0x94, 0xcc, 0x94, 0x90, 0xca, 0x82, 0x54,
-	0x09, 0x66, 0xb0, 0x3c, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x99, 0x97, 0x96, 0xef, 0xe9, 0x22,
-	0xc1, 0x02, 0x96, 0x80, 0xf2, 0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x27, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xec, 0x0a,
-	0xdd, 0xcc, 0x14, 0x28, 0xa3, 0x24, 0x57, 0xbf, 0x42, 0x1f, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0x1f, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x76, 0x32, 0x6a,
-	0x26, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbd, 0x4e, 0xfb, 0x30,
+	0x14, 0xc5, 0xeb, 0x7f, 0x3f, 0xfe, 0xc2, 0x82, 0x22, 0x99, 0x02, 0x16, 0x83, 0x55, 0x55, 0x48,
+	0x74, 0x80, 0x58, 0x85, 0x27, 0x28, 0x54, 0x88, 0x0e, 0x2c, 0x29, 0x13, 0x4b, 0x95, 0xd6, 0x26,
+	0xb5, 0x44, 0x6a, 0xab, 0xbe, 0x45, 0x64, 0xe6, 0x05, 0x98, 0x79, 0x22, 0xc6, 0x8e, 0x8c, 0x28,
+	0x79, 0x11, 0x14, 0xc7, 0xe1, 0x63, 0xbb, 0xbf, 0x7b, 0xce, 0x49, 0xee, 0xbd, 0xc6, 0xbd, 0x44,
+	0x59, 0x69, 0x21, 0xe1, 0x4f, 0x83, 0x99, 0x84, 0x68, 0xc0, 0x6f, 0x0b, 0x1e, 0xce, 0xe7, 0x7a,
+	0xbd, 0x84, 0xc0, 0xac, 0x34, 0x68, 0x72, 0xe8, 0x3c, 0x4a, 0x04, 0xde, 0x1b, 0x78, 0xef, 0x51,
+	0x27, 0xd6, 0xb1, 0x76, 0x1e, 0x5e, 0x54, 0xa5, 0xbd, 0xf7, 0x86, 0xf0, 0xf6, 0xef, 0xaf, 0x10,
+	0x8a, 0xff, 0xbb, 0xe4, 0x78, 0x44, 0x51, 0x17, 0xf5, 0xb7, 0xc2, 0x0a, 0xc9, 0x31, 0xde, 0x11,
+	0x4a, 0x84, 0x32, 0x56, 0x16, 0x56, 0xe9, 0x78, 0x44, 0xff, 0x75, 0x51, 0xbf, 0x11, 0xfe, 0x6d,
+	0x16, 0x79, 0xa1, 0xc4, 0x5d, 0x6a, 0x24, 0xad, 0x3b, 0xbd, 0x42, 0x72, 0x80, 0x5b, 0x6a, 0xf9,
+	0xa0, 0xc7, 0x23, 0xda, 0x70, 0x82, 0xa7, 0x22, 0x61, 0x8d, 0x5e, 0x5a, 0xbd, 0xa2, 0xcd, 0xf2,
+	0x8f, 0x1e, 0x7b, 0x2f, 0x08, 0xb7, 0x87, 0xc6, 0x4c, 0x4a, 0x9c, 0x40, 0x04, 0xa4, 0x83, 0x9b,
+	0x91, 0x31, 0x4a, 0xf8, 0xe1, 0x4a, 0x20, 0x27, 0x78, 0xd7, 0x67, 0xa4, 0x98, 0xba, 0x3d, 0xfc,
+	0x70, 0xed, 0xef, 0xf6, 0x95, 0xdb, 0xee, 0x1c, 0xef, 0x3f, 0x46, 0x16, 0xa6, 0x3f, 0xee, 0x85,
+	0x54, 0xf1, 0x02, 0xdc, 0xac, 0xf5, 0x70, 0xaf, 0x10, 0x27, 0x95, 0x76, 0xe3, 0xa4, 0xcb, 0xeb,
+	0xf7, 0x8c, 0xa1, 0x4d, 0xc6, 0xd0, 0x67, 0xc6, 0xd0, 0x6b, 0xce, 0x6a, 0x9b, 0x9c, 0xd5, 0x3e,
+	0x72, 0x56, 0xbb, 0x3f, 0x8d, 0x15, 0x2c, 0xd6, 0xb3, 0x60, 0xae, 0x13, 0xee, 0xae, 0x74, 0xa6,
+	0x84, 0x2f, 0x20, 0xe1, 0xcf, 0xbc, 0x7a, 0x2e, 0x48, 0x8d, 0xb4, 0xb3, 0x96, 0xbb, 0xf8, 0xc5,
+	0xd7, 0x00, 0x75, 0xff, 0x70, 0x24, 0xc6, 0x01, 0x00, 0x00,
 }
 
 func (m *MisesAccount) Marshal() (dAtA []byte, err error) {
@@ -138,6 +213,13 @@ func (m *MisesAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintMisesAccount(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.InfoID != 0 {
 		i = encodeVarintMisesAccount(dAtA, i, uint64(m.InfoID))
 		i--
@@ -163,6 +245,46 @@ func (m *MisesAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppSponsorStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppSponsorStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppSponsorStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSponsoredHeight != 0 {
+		i = encodeVarintMisesAccount(dAtA, i, uint64(m.LastSponsoredHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SponsoredCount != 0 {
+		i = encodeVarintMisesAccount(dAtA, i, uint64(m.SponsoredCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintMisesAccount(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMisesAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMisesAccount(v)
 	base := offset
@@ -193,6 +315,29 @@ func (m *MisesAccount) Size() (n int) {
 	if m.InfoID != 0 {
 		n += 1 + sovMisesAccount(uint64(m.InfoID))
 	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovMisesAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *AppSponsorStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovMisesAccount(uint64(l))
+	}
+	if m.SponsoredCount != 0 {
+		n += 1 + sovMisesAccount(uint64(m.SponsoredCount))
+	}
+	if m.LastSponsoredHeight != 0 {
+		n += 1 + sovMisesAccount(uint64(m.LastSponsoredHeight))
+	}
 	return n
 }
 
@@ -320,6 +465,158 @@ func (m *MisesAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMisesAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMisesAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMisesAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMisesAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMisesAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppSponsorStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMisesAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppSponsorStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppSponsorStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMisesAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMisesAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMisesAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredCount", wireType)
+			}
+			m.SponsoredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMisesAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsoredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSponsoredHeight", wireType)
+			}
+			m.LastSponsoredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMisesAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSponsoredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMisesAccount(dAtA[iNdEx:])
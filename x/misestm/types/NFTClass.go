@@ -0,0 +1,68 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRoyaltyBasisPoints is the whole sale price
+const MaxRoyaltyBasisPoints = 10000
+
+// ValidateRoyalty checks the royalty settings of a NFT class
+func ValidateRoyalty(recipient string, basisPoints uint32) error {
+	if basisPoints > MaxRoyaltyBasisPoints {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "royalty can not exceed %d basis points", MaxRoyaltyBasisPoints)
+	}
+	if recipient == "" {
+		if basisPoints != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "royalty without recipient")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient address (%s)", err)
+	}
+	return nil
+}
+
+// Royalty returns the part of the sale price paid to the royalty recipient, rounded down
+func (c NFTClassInfo) Royalty(price sdk.Coins) sdk.Coins {
+	royalty := sdk.NewCoins()
+	if c.RoyaltyRecipient == "" || c.RoyaltyBasisPoints == 0 {
+		return royalty
+	}
+	for _, coin := range price {
+		amount := coin.Amount.MulRaw(int64(c.RoyaltyBasisPoints)).QuoRaw(MaxRoyaltyBasisPoints)
+		royalty = royalty.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return royalty
+}
+
+// NFTData is the data of a NFT, NFTMetadata is the only implementation checked against class schemas
+type NFTData interface {
+	proto.Message
+}
+
+// ValidateData checks the data of a NFT against the schema of its class, classes without schema accept any data
+func (c NFTClassInfo) ValidateData(data *codectypes.Any, limits NFTSchemaLimits, gasMeter sdk.GasMeter) error {
+	if c.Schema == "" {
+		return nil
+	}
+	// the schema is parsed again for every data, charge it like the data
+	gasMeter.ConsumeGas(NFTDataGasPerByte*uint64(len(c.Schema)), "nft schema parsing")
+	schema, err := ParseNFTSchema(c.Schema, limits)
+	if err != nil {
+		return err
+	}
+	if data == nil || data.TypeUrl != "/"+proto.MessageName(&NFTMetadata{}) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nft data of class %s must be a %s", c.ClassId, proto.MessageName(&NFTMetadata{}))
+	}
+	var metadata NFTMetadata
+	if err := metadata.Unmarshal(data.Value); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nft data: %s", err)
+	}
+	return schema.Validate([]byte(metadata.Json), gasMeter)
+}
@@ -0,0 +1,680 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/NFTClass.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NFTClassInfo keeps the misestm settings of a NFT class created through NewNFTClass
+type NFTClassInfo struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// receives the royalty of the sales of the class NFTs made with TransferNFT or TransferDidNFT,
+	// the nft module MsgSend is rejected for classes with a royalty. Transfers without a price and
+	// ICS-721 transfers out of the chain pay no royalty.
+	RoyaltyRecipient string `protobuf:"bytes,2,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	// royalty in basis points of the sale price
+	RoyaltyBasisPoints uint32 `protobuf:"varint,3,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
+	// NFTs of a soulbound class can't be transferred, only burned by their owner or revoked by the class owner
+	Soulbound bool `protobuf:"varint,4,opt,name=soulbound,proto3" json:"soulbound,omitempty"`
+	// JSON schema the data of the class NFTs must conform to, see NFTMetadata
+	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *NFTClassInfo) Reset()         { *m = NFTClassInfo{} }
+func (m *NFTClassInfo) String() string { return proto.CompactTextString(m) }
+func (*NFTClassInfo) ProtoMessage()    {}
+func (*NFTClassInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99e58c8fb936b13, []int{0}
+}
+func (m *NFTClassInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTClassInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTClassInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTClassInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTClassInfo.Merge(m, src)
+}
+func (m *NFTClassInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTClassInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTClassInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTClassInfo proto.InternalMessageInfo
+
+func (m *NFTClassInfo) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTClassInfo) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (m *NFTClassInfo) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *NFTClassInfo) GetSoulbound() bool {
+	if m != nil {
+		return m.Soulbound
+	}
+	return false
+}
+
+func (m *NFTClassInfo) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+// NFTMetadata carries the JSON data of a NFT of a class with a schema
+type NFTMetadata struct {
+	Json string `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (m *NFTMetadata) Reset()         { *m = NFTMetadata{} }
+func (m *NFTMetadata) String() string { return proto.CompactTextString(m) }
+func (*NFTMetadata) ProtoMessage()    {}
+func (*NFTMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99e58c8fb936b13, []int{1}
+}
+func (m *NFTMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTMetadata.Merge(m, src)
+}
+func (m *NFTMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTMetadata proto.InternalMessageInfo
+
+func (m *NFTMetadata) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NFTClassInfo)(nil), "misesid.misestm.v1beta1.NFTClassInfo")
+	proto.RegisterType((*NFTMetadata)(nil), "misesid.misestm.v1beta1.NFTMetadata")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/NFTClass.proto", fileDescriptor_b99e58c8fb936b13) }
+
+var fileDescriptor_b99e58c8fb936b13 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
+	0x1c, 0xc6, 0x17, 0x9d, 0x73, 0x8b, 0x0a, 0x1a, 0x86, 0x56, 0x91, 0x30, 0x77, 0x1a, 0xa8, 0x8d,
+	0xc3, 0x37, 0x98, 0x50, 0xd8, 0xc1, 0x21, 0x65, 0x27, 0x2f, 0x25, 0x6d, 0x62, 0x17, 0x69, 0x9b,
+	0xd2, 0xa4, 0x62, 0xdf, 0xc2, 0x27, 0xf2, 0xec, 0x71, 0x47, 0x8f, 0xd2, 0xbe, 0x88, 0x34, 0xa6,
+	0x78, 0xfb, 0xbe, 0xff, 0xef, 0x47, 0x1b, 0x3e, 0x88, 0x53, 0xa1, 0xb8, 0xd2, 0x29, 0x79, 0x9b,
+	0x87, 0x5c, 0xd3, 0x39, 0x59, 0x79, 0xeb, 0x87, 0x84, 0x2a, 0xe5, 0xe6, 0x85, 0xd4, 0x12, 0x9d,
+	0x19, 0x2e, 0x98, 0x6b, 0x3d, 0xd7, 0x7a, 0x17, 0xe3, 0x58, 0xc6, 0xd2, 0x38, 0xa4, 0x4d, 0x7f,
+	0xfa, 0xf4, 0x13, 0xc0, 0xc3, 0xee, 0x0b, 0xcb, 0xec, 0x45, 0xa2, 0x73, 0x38, 0x8c, 0xda, 0x12,
+	0x08, 0xe6, 0x80, 0x09, 0x98, 0x8d, 0xfc, 0x7d, 0xd3, 0x97, 0x0c, 0x5d, 0xc3, 0x93, 0x42, 0x56,
+	0x34, 0xd1, 0x55, 0x50, 0xf0, 0x48, 0xe4, 0x82, 0x67, 0xda, 0xd9, 0x31, 0xce, 0xb1, 0x05, 0x7e,
+	0x77, 0x47, 0x77, 0x70, 0xdc, 0xc9, 0x21, 0x55, 0x42, 0x05, 0xb9, 0x14, 0x99, 0x56, 0xce, 0xee,
+	0x04, 0xcc, 0x8e, 0x7c, 0x64, 0xd9, 0xa2, 0x45, 0x4f, 0x86, 0xa0, 0x4b, 0x38, 0x52, 0xb2, 0x4c,
+	0x42, 0x59, 0x66, 0xcc, 0xe9, 0x4f, 0xc0, 0x6c, 0xe8, 0xff, 0x1f, 0xd0, 0x29, 0x1c, 0xa8, 0x68,
+	0xc3, 0x53, 0xea, 0xec, 0x99, 0x3f, 0xda, 0x36, 0xbd, 0x82, 0x07, 0x2b, 0x6f, 0xfd, 0xc8, 0x35,
+	0x65, 0x54, 0x53, 0x84, 0x60, 0xff, 0x55, 0xc9, 0xcc, 0x3e, 0xdd, 0xe4, 0x85, 0xf7, 0x55, 0x63,
+	0xb0, 0xad, 0x31, 0xf8, 0xa9, 0x31, 0xf8, 0x68, 0x70, 0x6f, 0xdb, 0xe0, 0xde, 0x77, 0x83, 0x7b,
+	0xcf, 0x37, 0xb1, 0xd0, 0x9b, 0x32, 0x74, 0x23, 0x99, 0x12, 0xb3, 0xd7, 0xad, 0x60, 0x36, 0xe8,
+	0x94, 0xbc, 0x93, 0x6e, 0x6b, 0x5d, 0xe5, 0x5c, 0x85, 0x03, 0x33, 0xd9, 0xfd, 0xef, 0x00, 0x48,
+	0x28, 0x5e, 0x1f, 0x83, 0x01, 0x00, 0x00,
+}
+
+func (m *NFTClassInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTClassInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTClassInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintNFTClass(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Soulbound {
+		i--
+		if m.Soulbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintNFTClass(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintNFTClass(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNFTClass(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintNFTClass(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNFTClass(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNFTClass(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NFTClassInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNFTClass(uint64(l))
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovNFTClass(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovNFTClass(uint64(m.RoyaltyBasisPoints))
+	}
+	if m.Soulbound {
+		n += 2
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovNFTClass(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovNFTClass(uint64(l))
+	}
+	return n
+}
+
+func sovNFTClass(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNFTClass(x uint64) (n int) {
+	return sovNFTClass(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NFTClassInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNFTClass
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTClassInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTClassInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
+			}
+			m.RoyaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soulbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Soulbound = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNFTClass(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNFTClass
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNFTClass(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNFTClass
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNFTClass(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNFTClass
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNFTClass
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNFTClass
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNFTClass
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNFTClass
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNFTClass        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNFTClass          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNFTClass = fmt.Errorf("proto: unexpected end of group")
+)
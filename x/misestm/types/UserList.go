@@ -0,0 +1,11 @@
+package types
+
+// HasMember returns true if the mises id is a member of the list
+func (m *UserList) HasMember(misesID string) bool {
+	for _, member := range m.Members {
+		if member == misesID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/UserList.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UserListVisibility tells clients who may see a list. It is not enforced by
+// the chain, whose state is public: queries return lists whatever their visibility.
+type UserListVisibility int32
+
+const (
+	UserListVisibilityPublic  UserListVisibility = 0
+	UserListVisibilityPrivate UserListVisibility = 1
+)
+
+var UserListVisibility_name = map[int32]string{
+	0: "USER_LIST_VISIBILITY_PUBLIC",
+	1: "USER_LIST_VISIBILITY_PRIVATE",
+}
+
+var UserListVisibility_value = map[string]int32{
+	"USER_LIST_VISIBILITY_PUBLIC":  0,
+	"USER_LIST_VISIBILITY_PRIVATE": 1,
+}
+
+func (x UserListVisibility) String() string {
+	return proto.EnumName(UserListVisibility_name, int32(x))
+}
+
+func (UserListVisibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4a12573a2485361, []int{0}
+}
+
+// UserList is a curated collection of user DIDs owned by a user
+type UserList struct {
+	Creator     string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id          uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner       string             `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        string             `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  UserListVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=misesid.misestm.v1beta1.UserListVisibility" json:"visibility,omitempty"`
+	Members     []string           `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Version     uint64             `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *UserList) Reset()         { *m = UserList{} }
+func (m *UserList) String() string { return proto.CompactTextString(m) }
+func (*UserList) ProtoMessage()    {}
+func (*UserList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4a12573a2485361, []int{0}
+}
+func (m *UserList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserList.Merge(m, src)
+}
+func (m *UserList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserList proto.InternalMessageInfo
+
+func (m *UserList) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *UserList) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserList) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UserList) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserList) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UserList) GetVisibility() UserListVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return UserListVisibilityPublic
+}
+
+func (m *UserList) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *UserList) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("misesid.misestm.v1beta1.UserListVisibility", UserListVisibility_name, UserListVisibility_value)
+	proto.RegisterType((*UserList)(nil), "misesid.misestm.v1beta1.UserList")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/UserList.proto", fileDescriptor_e4a12573a2485361) }
+
+var fileDescriptor_e4a12573a2485361 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0xcb, 0xd3, 0x40,
+	0x18, 0xcc, 0xe6, 0xcd, 0xdb, 0xd6, 0x15, 0x4a, 0x59, 0x0a, 0xae, 0xb1, 0x2e, 0xc1, 0x53, 0xf1,
+	0x23, 0xa1, 0x7a, 0x16, 0xb1, 0x52, 0x21, 0x98, 0x43, 0x49, 0x3f, 0x40, 0x2f, 0x25, 0x1f, 0x4b,
+	0x5d, 0x68, 0xba, 0x65, 0x77, 0x1b, 0xed, 0xd1, 0x9b, 0xf4, 0xe4, 0x59, 0xe8, 0xc9, 0x3f, 0xe3,
+	0xb1, 0x47, 0x8f, 0xd2, 0xfe, 0x11, 0xc9, 0x36, 0x91, 0x42, 0xfb, 0xde, 0x66, 0x9e, 0x99, 0x79,
+	0x78, 0x26, 0x59, 0x48, 0x32, 0x26, 0xa9, 0x54, 0x99, 0x97, 0xf7, 0x62, 0xaa, 0xa2, 0x9e, 0x37,
+	0x91, 0x54, 0x04, 0x4c, 0x2a, 0x77, 0x25, 0xb8, 0xe2, 0xe8, 0x81, 0xd6, 0x59, 0xea, 0x96, 0x3e,
+	0xb7, 0xf4, 0xd9, 0xed, 0x39, 0x9f, 0x73, 0xed, 0xf1, 0x0a, 0x74, 0xb2, 0x3f, 0xf9, 0x66, 0xc2,
+	0x46, 0xb5, 0x01, 0x61, 0x58, 0x4f, 0x04, 0x8d, 0x14, 0x17, 0x18, 0x38, 0xa0, 0x7b, 0x2f, 0xac,
+	0x28, 0x6a, 0x42, 0x93, 0xa5, 0xd8, 0x74, 0x40, 0xd7, 0x0a, 0x4d, 0x96, 0xa2, 0x36, 0xbc, 0xe5,
+	0x5f, 0x96, 0x54, 0xe0, 0x1b, 0xed, 0x3b, 0x11, 0x84, 0xa0, 0xb5, 0x8c, 0x32, 0x8a, 0x2d, 0x3d,
+	0xd4, 0x18, 0x39, 0xf0, 0x7e, 0x4a, 0x65, 0x22, 0xd8, 0x4a, 0x31, 0xbe, 0xc4, 0xb7, 0x5a, 0x3a,
+	0x1f, 0xa1, 0x0f, 0x10, 0xe6, 0x4c, 0xb2, 0x98, 0x2d, 0x98, 0xda, 0xe0, 0x9a, 0x03, 0xba, 0xcd,
+	0x97, 0xcf, 0xdc, 0x3b, 0x6a, 0xb8, 0xd5, 0xb1, 0xd3, 0xff, 0x91, 0xf0, 0x2c, 0x5e, 0x54, 0xc8,
+	0x68, 0x16, 0x53, 0x21, 0x71, 0xdd, 0xb9, 0x29, 0x2a, 0x94, 0xb4, 0x50, 0x72, 0x2a, 0x64, 0x71,
+	0x44, 0x43, 0xf7, 0xa8, 0xe8, 0xd3, 0x9f, 0x00, 0xa2, 0xcb, 0xb5, 0xe8, 0x35, 0x7c, 0x34, 0x19,
+	0x0d, 0xc2, 0x59, 0xe0, 0x8f, 0xc6, 0xb3, 0xa9, 0x3f, 0xf2, 0xfb, 0x7e, 0xe0, 0x8f, 0x3f, 0xce,
+	0x86, 0x93, 0x7e, 0xe0, 0xbf, 0x6b, 0x19, 0x76, 0x67, 0xbb, 0x73, 0xf0, 0x65, 0x70, 0xb8, 0x8e,
+	0x17, 0x2c, 0x41, 0x6f, 0x60, 0xe7, 0x7a, 0x3c, 0xf4, 0xa7, 0x6f, 0xc7, 0x83, 0x16, 0xb0, 0x1f,
+	0x6f, 0x77, 0xce, 0xc3, 0x2b, 0x79, 0xc1, 0xf2, 0x48, 0x51, 0xdb, 0xfa, 0xfe, 0x8b, 0x18, 0xfd,
+	0xf7, 0xbf, 0x0f, 0x04, 0xec, 0x0f, 0x04, 0xfc, 0x3d, 0x10, 0xf0, 0xe3, 0x48, 0x8c, 0xfd, 0x91,
+	0x18, 0x7f, 0x8e, 0xc4, 0xf8, 0xf4, 0x7c, 0xce, 0xd4, 0xe7, 0x75, 0xec, 0x26, 0x3c, 0xf3, 0xf4,
+	0x57, 0x7a, 0xc1, 0xd2, 0x12, 0xa8, 0xcc, 0xfb, 0xea, 0x55, 0x0f, 0x45, 0x6d, 0x56, 0x54, 0xc6,
+	0x35, 0xfd, 0xbf, 0x5f, 0xfd, 0x1b, 0x00, 0x4c, 0x4f, 0xf9, 0x71, 0x40, 0x02, 0x00, 0x00,
+}
+
+func (m *UserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintUserList(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintUserList(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintUserList(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintUserList(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUserList(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintUserList(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintUserList(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintUserList(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUserList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUserList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovUserList(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovUserList(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovUserList(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUserList(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovUserList(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovUserList(uint64(m.Visibility))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovUserList(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovUserList(uint64(m.Version))
+	}
+	return n
+}
+
+func sovUserList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUserList(x uint64) (n int) {
+	return sovUserList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= UserListVisibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUserList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUserList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUserList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUserList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUserList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUserList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUserList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUserList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUserList = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetRelTypeMask returns the relation type bitmask, relations stored before
+// the relation type registry only carry the built-in bools
+func (m *UserRelation) GetRelTypeMask() uint64 {
+	relType := m.RelType
+	if m.IsFollowing {
+		relType |= RelTypeBitFollow
+	}
+	if m.IsBlocking {
+		relType |= RelTypeBitBlock
+	}
+	if m.IsReferredBy {
+		relType |= RelTypeBitReferredBy
+	}
+	return relType
+}
+
+// SetRelTypeMask sets the relation type bitmask and keeps the built-in bools in sync
+func (m *UserRelation) SetRelTypeMask(relType uint64) {
+	m.RelType = relType
+	m.IsFollowing = relType&RelTypeBitFollow != 0
+	m.IsBlocking = relType&RelTypeBitBlock != 0
+	m.IsReferredBy = relType&RelTypeBitReferredBy != 0
+}
+
+// GetFlag returns the metadata of a relation type bit, nil if not recorded
+func (m *UserRelation) GetFlag(relType uint64) *RelationFlag {
+	for i := range m.Flags {
+		if m.Flags[i].RelType == relType {
+			return &m.Flags[i]
+		}
+	}
+	return nil
+}
+
+// UpdateRelType sets the relation type bitmask, records the height and time
+// of newly set bits and drops the metadata of cleared bits
+func (m *UserRelation) UpdateRelType(relType uint64, height int64, blockTime time.Time) {
+	oldRelType := m.GetRelTypeMask()
+	flags := []RelationFlag{}
+	for rest := relType; rest != 0; rest &= rest - 1 {
+		bit := uint64(1) << bits.TrailingZeros64(rest)
+		if flag := m.GetFlag(bit); flag != nil && oldRelType&bit != 0 {
+			flags = append(flags, *flag)
+		} else if oldRelType&bit == 0 {
+			flags = append(flags, RelationFlag{RelType: bit, SinceHeight: height, SinceTime: blockTime})
+		}
+	}
+	m.Flags = flags
+	m.SetRelTypeMask(relType)
+}
+
+// SetFlagExpiry sets the expiry of the flags in relType, a zero height and nil
+// time make the flags permanent
+func (m *UserRelation) SetFlagExpiry(relType uint64, expireHeight int64, expireTime *time.Time) {
+	relType &= m.GetRelTypeMask()
+	for rest := relType; rest != 0; rest &= rest - 1 {
+		bit := uint64(1) << bits.TrailingZeros64(rest)
+		flag := m.GetFlag(bit)
+		if flag == nil {
+			m.Flags = append(m.Flags, RelationFlag{RelType: bit})
+			flag = &m.Flags[len(m.Flags)-1]
+		}
+		flag.ExpireHeight = expireHeight
+		flag.ExpireTime = expireTime
+	}
+}
+
+// HasExpiry returns true if the flag is cleared automatically
+func (m RelationFlag) HasExpiry() bool {
+	return m.ExpireHeight != 0 || m.ExpireTime != nil
+}
+
+// GetRelTypeMask returns the requested relation type bitmask
+func (msg *MsgUpdateUserRelation) GetRelTypeMask() uint64 {
+	relType := msg.RelType
+	if msg.IsFollowing {
+		relType |= RelTypeBitFollow
+	}
+	if msg.IsBlocking {
+		relType |= RelTypeBitBlock
+	}
+	if msg.IsReferredBy {
+		relType |= RelTypeBitReferredBy
+	}
+	return relType
+}
+
+// FollowedSinceKey returns the pagination key of a relation when sorting by follow time
+func FollowedSinceKey(rel *UserRelation) string {
+	height := int64(0)
+	if flag := rel.GetFlag(RelTypeBitFollow); flag != nil {
+		height = flag.SinceHeight
+	}
+	return fmt.Sprintf("%d,%s", height, rel.UidTo)
+}
+
+// ParseFollowedSinceKey parses a pagination key created by FollowedSinceKey
+func ParseFollowedSinceKey(key string) (int64, string, error) {
+	parts := strings.SplitN(key, ",", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("invalid followed since key %s", key)
+	}
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	return height, parts[1], nil
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,14 +28,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type UserRelation struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id           uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	UidFrom      string `protobuf:"bytes,3,opt,name=uidFrom,proto3" json:"uidFrom,omitempty"`
-	UidTo        string `protobuf:"bytes,4,opt,name=uidTo,proto3" json:"uidTo,omitempty"`
-	IsFollowing  bool   `protobuf:"varint,5,opt,name=isFollowing,proto3" json:"isFollowing,omitempty"`
-	IsBlocking   bool   `protobuf:"varint,6,opt,name=isBlocking,proto3" json:"isBlocking,omitempty"`
-	IsReferredBy bool   `protobuf:"varint,7,opt,name=isReferredBy,proto3" json:"isReferredBy,omitempty"`
-	Version      uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Creator      string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id           uint64         `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UidFrom      string         `protobuf:"bytes,3,opt,name=uidFrom,proto3" json:"uidFrom,omitempty"`
+	UidTo        string         `protobuf:"bytes,4,opt,name=uidTo,proto3" json:"uidTo,omitempty"`
+	IsFollowing  bool           `protobuf:"varint,5,opt,name=isFollowing,proto3" json:"isFollowing,omitempty"`
+	IsBlocking   bool           `protobuf:"varint,6,opt,name=isBlocking,proto3" json:"isBlocking,omitempty"`
+	IsReferredBy bool           `protobuf:"varint,7,opt,name=isReferredBy,proto3" json:"isReferredBy,omitempty"`
+	Version      uint64         `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	RelType      uint64         `protobuf:"varint,9,opt,name=relType,proto3" json:"relType,omitempty"`
+	Flags        []RelationFlag `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags"`
 }
 
 func (m *UserRelation) Reset()         { *m = UserRelation{} }
@@ -123,8 +129,102 @@ func (m *UserRelation) GetVersion() uint64 {
 	return 0
 }
 
+func (m *UserRelation) GetRelType() uint64 {
+	if m != nil {
+		return m.RelType
+	}
+	return 0
+}
+
+func (m *UserRelation) GetFlags() []RelationFlag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// RelationFlag records when a relation type bit was last set
+type RelationFlag struct {
+	RelType     uint64    `protobuf:"varint,1,opt,name=relType,proto3" json:"relType,omitempty"`
+	SinceHeight int64     `protobuf:"varint,2,opt,name=sinceHeight,proto3" json:"sinceHeight,omitempty"`
+	SinceTime   time.Time `protobuf:"bytes,3,opt,name=sinceTime,proto3,stdtime" json:"sinceTime"`
+	// the flag is cleared at the end of this block, 0 means no height expiry
+	ExpireHeight int64 `protobuf:"varint,4,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	// the flag is cleared by the first block at or after this time
+	ExpireTime *time.Time `protobuf:"bytes,5,opt,name=expireTime,proto3,stdtime" json:"expireTime,omitempty"`
+}
+
+func (m *RelationFlag) Reset()         { *m = RelationFlag{} }
+func (m *RelationFlag) String() string { return proto.CompactTextString(m) }
+func (*RelationFlag) ProtoMessage()    {}
+func (*RelationFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8abe9dd47d555cd5, []int{1}
+}
+func (m *RelationFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationFlag.Merge(m, src)
+}
+func (m *RelationFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationFlag proto.InternalMessageInfo
+
+func (m *RelationFlag) GetRelType() uint64 {
+	if m != nil {
+		return m.RelType
+	}
+	return 0
+}
+
+func (m *RelationFlag) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+func (m *RelationFlag) GetSinceTime() time.Time {
+	if m != nil {
+		return m.SinceTime
+	}
+	return time.Time{}
+}
+
+func (m *RelationFlag) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *RelationFlag) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserRelation)(nil), "misesid.misestm.v1beta1.UserRelation")
+	proto.RegisterType((*RelationFlag)(nil), "misesid.misestm.v1beta1.RelationFlag")
 }
 
 func init() {
@@ -132,25 +232,35 @@ func init() {
 }
 
 var fileDescriptor_8abe9dd47d555cd5 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0x3f, 0x4e, 0xc3, 0x30,
-	0x14, 0x06, 0xf0, 0xba, 0xf4, 0x1f, 0xa6, 0x62, 0xb0, 0x2a, 0x61, 0x31, 0x58, 0x51, 0xa7, 0x0e,
-	0x10, 0xab, 0xe2, 0x06, 0x1d, 0x7a, 0x80, 0x08, 0x16, 0xb6, 0x36, 0x36, 0xe1, 0x89, 0x24, 0xaf,
-	0xb2, 0x9d, 0x42, 0x6f, 0xc1, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0x64, 0xe1, 0x18, 0x28, 0x4e, 0x22,
-	0x85, 0xcd, 0xdf, 0xa7, 0x9f, 0xac, 0xa7, 0x8f, 0x2e, 0x33, 0xb0, 0xda, 0xba, 0x4c, 0x1e, 0xd7,
-	0x7b, 0xed, 0x76, 0x6b, 0xf9, 0x64, 0xb5, 0x89, 0x74, 0xba, 0x73, 0x80, 0x79, 0x78, 0x30, 0xe8,
-	0x90, 0xdd, 0x78, 0x03, 0x2a, 0x6c, 0x6d, 0xd8, 0xda, 0xdb, 0x45, 0x82, 0x09, 0x7a, 0x23, 0xeb,
-	0x57, 0xc3, 0x97, 0xbf, 0x84, 0xce, 0xfb, 0xbf, 0x30, 0x4e, 0xa7, 0xb1, 0xd1, 0x3b, 0x87, 0x86,
-	0x93, 0x80, 0xac, 0x2e, 0xa3, 0x2e, 0xb2, 0x6b, 0x3a, 0x04, 0xc5, 0x87, 0x01, 0x59, 0x8d, 0xa2,
-	0x21, 0xa8, 0x5a, 0x16, 0xa0, 0xb6, 0x06, 0x33, 0x7e, 0xd1, 0xc8, 0x36, 0xb2, 0x05, 0x1d, 0x17,
-	0xa0, 0x1e, 0x91, 0x8f, 0x7c, 0xdf, 0x04, 0x16, 0xd0, 0x2b, 0xb0, 0x5b, 0x4c, 0x53, 0x7c, 0x87,
-	0x3c, 0xe1, 0xe3, 0x80, 0xac, 0x66, 0x51, 0xbf, 0x62, 0x82, 0x52, 0xb0, 0x9b, 0x14, 0xe3, 0xb7,
-	0x1a, 0x4c, 0x3c, 0xe8, 0x35, 0x6c, 0x49, 0xe7, 0x60, 0x23, 0xfd, 0xa2, 0x8d, 0xd1, 0x6a, 0x73,
-	0xe2, 0x53, 0x2f, 0xfe, 0x75, 0xf5, 0x55, 0x47, 0x6d, 0x2c, 0x60, 0xce, 0x67, 0xfe, 0xd4, 0x2e,
-	0x6e, 0xb6, 0x5f, 0xa5, 0x20, 0xe7, 0x52, 0x90, 0x9f, 0x52, 0x90, 0xcf, 0x4a, 0x0c, 0xce, 0x95,
-	0x18, 0x7c, 0x57, 0x62, 0xf0, 0x7c, 0x97, 0x80, 0x7b, 0x2d, 0xf6, 0x61, 0x8c, 0x99, 0xf4, 0xb3,
-	0xdd, 0x83, 0x6a, 0x1f, 0x2e, 0x93, 0x1f, 0xb2, 0x9b, 0xdd, 0x9d, 0x0e, 0xda, 0xee, 0x27, 0x7e,
-	0xb9, 0x87, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x6f, 0x4e, 0x3b, 0x8e, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8e, 0xda, 0x40,
+	0x14, 0x86, 0x19, 0x30, 0xbb, 0x30, 0xa0, 0x14, 0xa3, 0x95, 0x32, 0xa2, 0x30, 0x16, 0x52, 0x24,
+	0x8a, 0x64, 0xac, 0xdd, 0x5c, 0x20, 0x71, 0x81, 0x52, 0x5b, 0xa4, 0x49, 0x67, 0xf0, 0x63, 0xf6,
+	0x29, 0x36, 0x63, 0xcd, 0x8c, 0x37, 0xcb, 0x2d, 0xf6, 0x52, 0x91, 0xb6, 0xa4, 0x4c, 0x95, 0x44,
+	0x70, 0x85, 0x1c, 0x20, 0xf2, 0xd8, 0x56, 0x4c, 0x11, 0x6d, 0xe7, 0xff, 0x9f, 0xef, 0xe9, 0x7f,
+	0xfa, 0x9f, 0xe9, 0x22, 0x47, 0x03, 0xc6, 0xe6, 0xe1, 0xc3, 0xed, 0x06, 0x6c, 0x72, 0x1b, 0x7e,
+	0x36, 0xa0, 0x63, 0xc8, 0x12, 0x8b, 0x6a, 0x2f, 0x0a, 0xad, 0xac, 0x62, 0xaf, 0x1d, 0x83, 0xa9,
+	0x68, 0x58, 0xd1, 0xb0, 0xb3, 0x1b, 0xa9, 0xa4, 0x72, 0x4c, 0x58, 0x7d, 0xd5, 0xf8, 0x6c, 0x2e,
+	0x95, 0x92, 0x19, 0x84, 0x4e, 0x6d, 0xca, 0x5d, 0x68, 0x31, 0x07, 0x63, 0x93, 0xbc, 0xa8, 0x81,
+	0xc5, 0xf7, 0x3e, 0x9d, 0x76, 0x63, 0x18, 0xa7, 0xd7, 0x5b, 0x0d, 0x89, 0x55, 0x9a, 0x93, 0x80,
+	0x2c, 0xc7, 0x71, 0x2b, 0xd9, 0x2b, 0xda, 0xc7, 0x94, 0xf7, 0x03, 0xb2, 0xf4, 0xe2, 0x3e, 0xa6,
+	0x15, 0x59, 0x62, 0xba, 0xd2, 0x2a, 0xe7, 0x83, 0x9a, 0x6c, 0x24, 0xbb, 0xa1, 0xc3, 0x12, 0xd3,
+	0xb5, 0xe2, 0x9e, 0xf3, 0x6b, 0xc1, 0x02, 0x3a, 0x41, 0xb3, 0x52, 0x59, 0xa6, 0xbe, 0xe1, 0x5e,
+	0xf2, 0x61, 0x40, 0x96, 0xa3, 0xb8, 0x6b, 0x31, 0x9f, 0x52, 0x34, 0x51, 0xa6, 0xb6, 0x5f, 0x2b,
+	0xe0, 0xca, 0x01, 0x1d, 0x87, 0x2d, 0xe8, 0x14, 0x4d, 0x0c, 0x3b, 0xd0, 0x1a, 0xd2, 0xe8, 0xc0,
+	0xaf, 0x1d, 0x71, 0xe1, 0x55, 0x5b, 0x3d, 0x80, 0x36, 0xa8, 0xf6, 0x7c, 0xe4, 0x56, 0x6d, 0x65,
+	0xf5, 0xa2, 0x21, 0x5b, 0x1f, 0x0a, 0xe0, 0xe3, 0xfa, 0xa5, 0x91, 0xec, 0x23, 0x1d, 0xee, 0xb2,
+	0x44, 0x1a, 0x4e, 0x83, 0xc1, 0x72, 0x72, 0xf7, 0x46, 0xfc, 0xa7, 0x64, 0xd1, 0xb6, 0xb4, 0xca,
+	0x12, 0x19, 0x79, 0xcf, 0x3f, 0xe7, 0xbd, 0xb8, 0x9e, 0x5c, 0xfc, 0x21, 0x74, 0xda, 0x7d, 0xed,
+	0xa6, 0x91, 0xcb, 0xb4, 0x80, 0x4e, 0x0c, 0xee, 0xb7, 0xf0, 0x09, 0x50, 0xde, 0x5b, 0x57, 0xe8,
+	0x20, 0xee, 0x5a, 0x2c, 0xa2, 0x63, 0x27, 0xd7, 0x98, 0x83, 0xeb, 0x76, 0x72, 0x37, 0x13, 0xf5,
+	0x25, 0x45, 0x7b, 0x49, 0xb1, 0x6e, 0x2f, 0x19, 0x8d, 0xaa, 0x45, 0x9e, 0x7e, 0xcd, 0x49, 0xfc,
+	0x6f, 0xac, 0xea, 0x0a, 0x1e, 0x0b, 0xd4, 0x6d, 0x8c, 0xe7, 0x62, 0x2e, 0x3c, 0xf6, 0x81, 0xd2,
+	0x5a, 0xbb, 0xa0, 0xe1, 0x8b, 0x41, 0x9e, 0x0b, 0xe9, 0xcc, 0x44, 0xab, 0xe7, 0x93, 0x4f, 0x8e,
+	0x27, 0x9f, 0xfc, 0x3e, 0xf9, 0xe4, 0xe9, 0xec, 0xf7, 0x8e, 0x67, 0xbf, 0xf7, 0xe3, 0xec, 0xf7,
+	0xbe, 0xbc, 0x95, 0x68, 0xef, 0xcb, 0x8d, 0xd8, 0xaa, 0x3c, 0x74, 0x35, 0xbe, 0xc3, 0xb4, 0xf9,
+	0xb0, 0x79, 0xf8, 0x18, 0xb6, 0xff, 0xba, 0x3d, 0x14, 0x60, 0x36, 0x57, 0x2e, 0xed, 0xfd, 0xdf,
+	0x01, 0x00, 0x7d, 0xf8, 0x93, 0x3d, 0x03, 0x03, 0x00, 0x00,
 }
 
 func (m *UserRelation) Marshal() (dAtA []byte, err error) {
@@ -173,6 +283,25 @@ func (m *UserRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUserRelation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RelType != 0 {
+		i = encodeVarintUserRelation(dAtA, i, uint64(m.RelType))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Version != 0 {
 		i = encodeVarintUserRelation(dAtA, i, uint64(m.Version))
 		i--
@@ -237,6 +366,62 @@ func (m *UserRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelationFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintUserRelation(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintUserRelation(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SinceTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SinceTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintUserRelation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.SinceHeight != 0 {
+		i = encodeVarintUserRelation(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RelType != 0 {
+		i = encodeVarintUserRelation(dAtA, i, uint64(m.RelType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUserRelation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUserRelation(v)
 	base := offset
@@ -281,6 +466,39 @@ func (m *UserRelation) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovUserRelation(uint64(m.Version))
 	}
+	if m.RelType != 0 {
+		n += 1 + sovUserRelation(uint64(m.RelType))
+	}
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovUserRelation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelationFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelType != 0 {
+		n += 1 + sovUserRelation(uint64(m.RelType))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovUserRelation(uint64(m.SinceHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SinceTime)
+	n += 1 + l + sovUserRelation(uint64(l))
+	if m.ExpireHeight != 0 {
+		n += 1 + sovUserRelation(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovUserRelation(uint64(l))
+	}
 	return n
 }
 
@@ -513,6 +731,235 @@ func (m *UserRelation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelType", wireType)
+			}
+			m.RelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, RelationFlag{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserRelation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserRelation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelType", wireType)
+			}
+			m.RelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SinceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserRelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserRelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUserRelation(dAtA[iNdEx:])
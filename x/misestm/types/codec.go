@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -14,6 +15,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintNFT{}, "misestm/MintNFT", nil)
 	cdc.RegisterConcrete(&MsgUpdateNFT{}, "misestm/UpdateNFT", nil)
 	cdc.RegisterConcrete(&MsgBurnNFT{}, "misestm/BurnNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferNFT{}, "misestm/TransferNFT", nil)
+	cdc.RegisterConcrete(&MsgRevokeNFT{}, "misestm/RevokeNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferDidNFT{}, "misestm/TransferDidNFT", nil)
 
 	// this line is used by starport scaffolding # 2
 
@@ -21,12 +25,38 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateUserRelation{}, "misestm/UpdateUserRelation", nil)
 	cdc.RegisterConcrete(&MsgUpdateAppInfo{}, "misestm/UpdateAppInfo", nil)
 	cdc.RegisterConcrete(&MsgCreateDidRegistry{}, "misestm/CreateDidRegistry", nil)
+	cdc.RegisterConcrete(&MsgCreateUserList{}, "misestm/CreateUserList", nil)
+	cdc.RegisterConcrete(&MsgRenameUserList{}, "misestm/RenameUserList", nil)
+	cdc.RegisterConcrete(&MsgAddUserListMembers{}, "misestm/AddUserListMembers", nil)
+	cdc.RegisterConcrete(&MsgRemoveUserListMembers{}, "misestm/RemoveUserListMembers", nil)
+	cdc.RegisterConcrete(&MsgDeleteUserList{}, "misestm/DeleteUserList", nil)
+	cdc.RegisterConcrete(&MsgCreateCommunity{}, "misestm/CreateCommunity", nil)
+	cdc.RegisterConcrete(&MsgJoinCommunity{}, "misestm/JoinCommunity", nil)
+	cdc.RegisterConcrete(&MsgApproveCommunityMember{}, "misestm/ApproveCommunityMember", nil)
+	cdc.RegisterConcrete(&MsgKickCommunityMember{}, "misestm/KickCommunityMember", nil)
+	cdc.RegisterConcrete(&MsgChangeCommunityRole{}, "misestm/ChangeCommunityRole", nil)
+	cdc.RegisterConcrete(&MsgSetAppFeeGrantTemplate{}, "misestm/SetAppFeeGrantTemplate", nil)
+	cdc.RegisterConcrete(&MsgDeleteAppFeeGrantTemplate{}, "misestm/DeleteAppFeeGrantTemplate", nil)
+	cdc.RegisterConcrete(&MsgGrantAppFeeAllowances{}, "misestm/GrantAppFeeAllowances", nil)
+	cdc.RegisterConcrete(&MsgRenewAppFeeAllowances{}, "misestm/RenewAppFeeAllowances", nil)
+	cdc.RegisterConcrete(&MsgRevokeAppFeeAllowances{}, "misestm/RevokeAppFeeAllowances", nil)
+	cdc.RegisterConcrete(&MsgAuthorizeApp{}, "misestm/AuthorizeApp", nil)
+	cdc.RegisterConcrete(&MsgRevokeAppAuthorization{}, "misestm/RevokeAppAuthorization", nil)
+	cdc.RegisterConcrete(&MsgExecAppAuthorized{}, "misestm/ExecAppAuthorized", nil)
+	cdc.RegisterConcrete(&MsgAddAppAdmin{}, "misestm/AddAppAdmin", nil)
+	cdc.RegisterConcrete(&MsgRemoveAppAdmin{}, "misestm/RemoveAppAdmin", nil)
+	cdc.RegisterConcrete(&MsgTransferAppOwnership{}, "misestm/TransferAppOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptAppOwnership{}, "misestm/AcceptAppOwnership", nil)
+	cdc.RegisterConcrete(&MsgMintAppDenom{}, "misestm/MintAppDenom", nil)
+	cdc.RegisterConcrete(&MsgBurnAppDenom{}, "misestm/BurnAppDenom", nil)
 
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgNewDenom{},
+		&MsgMintAppDenom{},
+		&MsgBurnAppDenom{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgNewNFTClass{},
@@ -42,6 +72,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBurnNFT{},
+		&MsgTransferNFT{},
+		&MsgRevokeNFT{},
+		&MsgTransferDidNFT{},
+	)
+	registry.RegisterInterface("misesid.misestm.v1beta1.NFTData", (*NFTData)(nil),
+		&NFTMetadata{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -51,10 +87,46 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateUserRelation{},
 	)
-	registry.RegisterImplementations((*sdk.Msg)(nil))
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateAppInfo{},
+		&MsgAddAppAdmin{},
+		&MsgRemoveAppAdmin{},
+		&MsgTransferAppOwnership{},
+		&MsgAcceptAppOwnership{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDidRegistry{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateUserList{},
+		&MsgRenameUserList{},
+		&MsgAddUserListMembers{},
+		&MsgRemoveUserListMembers{},
+		&MsgDeleteUserList{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateCommunity{},
+		&MsgJoinCommunity{},
+		&MsgApproveCommunityMember{},
+		&MsgKickCommunityMember{},
+		&MsgChangeCommunityRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAppFeeGrantTemplate{},
+		&MsgDeleteAppFeeGrantTemplate{},
+		&MsgGrantAppFeeAllowances{},
+		&MsgRenewAppFeeAllowances{},
+		&MsgRevokeAppFeeAllowances{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAuthorizeApp{},
+		&MsgRevokeAppAuthorization{},
+		&MsgExecAppAuthorized{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AppVerificationProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
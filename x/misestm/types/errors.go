@@ -8,6 +8,7 @@ import (
 
 // x/misestm module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample            = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrRateLimitExceeded = sdkerrors.Register(ModuleName, 1101, "rate limit exceeded")
 	// this line is used by starport scaffolding # ibc/errors
 )
@@ -0,0 +1,16 @@
+package types
+
+// misestm module event types
+const (
+	EventTypeRelationFlagExpired = "relation_flag_expired"
+	EventTypeAppVerification     = "app_verification"
+
+	AttributeKeyUidFrom  = "uid_from"
+	AttributeKeyUidTo    = "uid_to"
+	AttributeKeyRelType  = "rel_type"
+	AttributeKeyVersion  = "version"
+	AttributeKeyAppid    = "appid"
+	AttributeKeyVerified = "verified"
+
+	AttributeValueCategory = ModuleName
+)
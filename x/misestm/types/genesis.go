@@ -14,10 +14,20 @@ func DefaultGenesis() *GenesisState {
 		MisesAccountList: []*MisesAccount{},
 		// this line is used by starport scaffolding # ibc/genesistype/default
 		// this line is used by starport scaffolding # genesis/types/default
-		UserInfoList:     []*UserInfo{},
-		UserRelationList: []*UserRelation{},
-		AppInfoList:      []*AppInfo{},
-		DidRegistryList:  []*DidRegistry{},
+		UserInfoList:            []*UserInfo{},
+		UserRelationList:        []*UserRelation{},
+		AppInfoList:             []*AppInfo{},
+		DidRegistryList:         []*DidRegistry{},
+		UserListList:            []*UserList{},
+		CommunityList:           []*Community{},
+		CommunityMemberList:     []*CommunityMember{},
+		AppFeeGrantTemplateList: []*AppFeeGrantTemplate{},
+		AppFeeGranteeList:       []*AppFeeGrantee{},
+		AppAuthorizationList:    []*AppAuthorization{},
+		AppDenomList:            []*AppDenom{},
+		NFTClassInfoList:        []*NFTClassInfo{},
+		AppSponsorStatList:      []*AppSponsorStat{},
+		Params:                  DefaultParams(),
 	}
 }
 
@@ -25,16 +35,22 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # ibc/genesistype/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 
 	// this line is used by starport scaffolding # genesis/types/validate
 	// Check for duplicated ID in UserInfo
-	UserInfoIdMap := make(map[uint64]bool)
+	UserInfoIdMap := make(map[uint64]*UserInfo)
 
 	for _, elem := range gs.UserInfoList {
 		if _, ok := UserInfoIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for UserInfo")
 		}
-		UserInfoIdMap[elem.Id] = true
+		if elem.Id >= gs.UserInfoCount {
+			return fmt.Errorf("UserInfo id %d should be lower than the count %d", elem.Id, gs.UserInfoCount)
+		}
+		UserInfoIdMap[elem.Id] = elem
 	}
 	// Check for duplicated ID in UserRelation
 	UserRelationIdMap := make(map[uint64]bool)
@@ -43,26 +59,188 @@ func (gs GenesisState) Validate() error {
 		if _, ok := UserRelationIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for UserRelation")
 		}
+		if elem.Id >= gs.UserRelationCount {
+			return fmt.Errorf("UserRelation id %d should be lower than the count %d", elem.Id, gs.UserRelationCount)
+		}
 		UserRelationIdMap[elem.Id] = true
 	}
 	// Check for duplicated ID in AppInfo
-	AppInfoIdMap := make(map[uint64]bool)
+	AppInfoIdMap := make(map[uint64]*AppInfo)
 
 	for _, elem := range gs.AppInfoList {
 		if _, ok := AppInfoIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for AppInfo")
 		}
-		AppInfoIdMap[elem.Id] = true
+		if elem.Id >= gs.AppInfoCount {
+			return fmt.Errorf("AppInfo id %d should be lower than the count %d", elem.Id, gs.AppInfoCount)
+		}
+		AppInfoIdMap[elem.Id] = elem
+	}
+	// Check for domains claimed by several apps
+	AppDomainMap := make(map[string]string)
+
+	for _, elem := range gs.AppInfoList {
+		if elem.PubInfo == nil {
+			continue
+		}
+		for _, domain := range elem.PubInfo.Domains {
+			domain = NormalizeAppDomain(domain)
+			if appid, ok := AppDomainMap[domain]; ok && appid != elem.Appid {
+				return fmt.Errorf("domain %s claimed by %s and %s", domain, appid, elem.Appid)
+			}
+			AppDomainMap[domain] = elem.Appid
+		}
 	}
 	// Check for duplicated ID in DidRegistry
-	DidRegistryIdMap := make(map[uint64]bool)
+	DidRegistryIdMap := make(map[uint64]*DidRegistry)
 
 	for _, elem := range gs.DidRegistryList {
 		if _, ok := DidRegistryIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for DidRegistry")
 		}
-		DidRegistryIdMap[elem.Id] = true
+		if elem.Id >= gs.DidRegistryCount {
+			return fmt.Errorf("DidRegistry id %d should be lower than the count %d", elem.Id, gs.DidRegistryCount)
+		}
+		DidRegistryIdMap[elem.Id] = elem
+	}
+	// Check for duplicated MisesAccount and references to missing or mismatched records
+	MisesAccountMap := make(map[string]bool)
+	SponsoredCountMap := make(map[string]uint64)
+
+	for _, elem := range gs.MisesAccountList {
+		if _, ok := MisesAccountMap[elem.MisesID]; ok {
+			return fmt.Errorf("duplicated mises account %s", elem.MisesID)
+		}
+		if _, didType, err := AddrFromDid(elem.MisesID); err != nil {
+			return fmt.Errorf("invalid mises id %s: %w", elem.MisesID, err)
+		} else if didType != elem.DidType {
+			return fmt.Errorf("mises id %s does not match did type %d", elem.MisesID, elem.DidType)
+		}
+		if reg, ok := DidRegistryIdMap[elem.DidRegistryID]; !ok || reg.Did != elem.MisesID {
+			return fmt.Errorf("mises account %s points to a missing or mismatched DidRegistry %d", elem.MisesID, elem.DidRegistryID)
+		}
+		switch elem.DidType {
+		case DIDTypeUser:
+			if info, ok := UserInfoIdMap[elem.InfoID]; !ok || info.Uid != elem.MisesID {
+				return fmt.Errorf("mises account %s points to a missing or mismatched UserInfo %d", elem.MisesID, elem.InfoID)
+			}
+		case DIDTypeApp:
+			if info, ok := AppInfoIdMap[elem.InfoID]; !ok || info.Appid != elem.MisesID {
+				return fmt.Errorf("mises account %s points to a missing or mismatched AppInfo %d", elem.MisesID, elem.InfoID)
+			}
+		}
+		MisesAccountMap[elem.MisesID] = true
+		if elem.Sponsor != "" {
+			SponsoredCountMap[elem.Sponsor]++
+		}
 	}
+	// Check for duplicated ID in UserList
+	UserListIdMap := make(map[uint64]bool)
 
+	for _, elem := range gs.UserListList {
+		if _, ok := UserListIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for UserList")
+		}
+		if elem.Id >= gs.UserListCount {
+			return fmt.Errorf("UserList id %d should be lower than the count %d", elem.Id, gs.UserListCount)
+		}
+		UserListIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in Community
+	CommunityIdMap := make(map[uint64]bool)
+
+	for _, elem := range gs.CommunityList {
+		if _, ok := CommunityIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for Community")
+		}
+		if elem.Id >= gs.CommunityCount {
+			return fmt.Errorf("Community id %d should be lower than the count %d", elem.Id, gs.CommunityCount)
+		}
+		CommunityIdMap[elem.Id] = true
+	}
+	// Check for duplicated CommunityMember and unknown community
+	CommunityMemberMap := make(map[string]bool)
+
+	for _, elem := range gs.CommunityMemberList {
+		key := fmt.Sprintf("%d/%s", elem.CommunityId, elem.Member)
+		if _, ok := CommunityMemberMap[key]; ok {
+			return fmt.Errorf("duplicated member %s for Community %d", elem.Member, elem.CommunityId)
+		}
+		if _, ok := CommunityIdMap[elem.CommunityId]; !ok {
+			return fmt.Errorf("member %s of unknown Community %d", elem.Member, elem.CommunityId)
+		}
+		CommunityMemberMap[key] = true
+	}
+	// Check for duplicated AppFeeGrantTemplate
+	AppFeeGrantTemplateMap := make(map[string]bool)
+
+	for _, elem := range gs.AppFeeGrantTemplateList {
+		key := elem.Appid + "/" + elem.Name
+		if _, ok := AppFeeGrantTemplateMap[key]; ok {
+			return fmt.Errorf("duplicated template %s for app %s", elem.Name, elem.Appid)
+		}
+		AppFeeGrantTemplateMap[key] = true
+	}
+	// Check for duplicated AppFeeGrantee
+	AppFeeGranteeMap := make(map[string]bool)
+
+	for _, elem := range gs.AppFeeGranteeList {
+		key := elem.Appid + "/" + elem.Grantee
+		if _, ok := AppFeeGranteeMap[key]; ok {
+			return fmt.Errorf("duplicated grantee %s for app %s", elem.Grantee, elem.Appid)
+		}
+		AppFeeGranteeMap[key] = true
+	}
+	// Check for duplicated AppAuthorization
+	AppAuthorizationMap := make(map[string]bool)
+
+	for _, elem := range gs.AppAuthorizationList {
+		key := elem.Uid + "/" + elem.Appid
+		if _, ok := AppAuthorizationMap[key]; ok {
+			return fmt.Errorf("duplicated authorization of app %s by %s", elem.Appid, elem.Uid)
+		}
+		AppAuthorizationMap[key] = true
+	}
+	// Check for duplicated AppDenom and denoms outside of the app namespace
+	AppDenomMap := make(map[string]bool)
+
+	for _, elem := range gs.AppDenomList {
+		if _, ok := AppDenomMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated denom %s", elem.Denom)
+		}
+		denom, err := AppDenomOf(elem.Appid, elem.Id)
+		if err != nil {
+			return err
+		}
+		if denom != elem.Denom {
+			return fmt.Errorf("denom %s is not issued by %s", elem.Denom, elem.Appid)
+		}
+		AppDenomMap[elem.Denom] = true
+	}
+	// Check for duplicated NFTClassInfo and invalid royalties
+	NFTClassInfoMap := make(map[string]bool)
+
+	for _, elem := range gs.NFTClassInfoList {
+		if _, ok := NFTClassInfoMap[elem.ClassId]; ok {
+			return fmt.Errorf("duplicated info for nft class %s", elem.ClassId)
+		}
+		if err := ValidateRoyalty(elem.RoyaltyRecipient, elem.RoyaltyBasisPoints); err != nil {
+			return err
+		}
+		NFTClassInfoMap[elem.ClassId] = true
+	}
+
+	// Check for duplicated AppSponsorStat and counts not matching the sponsored accounts
+	AppSponsorStatMap := make(map[string]bool)
+
+	for _, elem := range gs.AppSponsorStatList {
+		if _, ok := AppSponsorStatMap[elem.Appid]; ok {
+			return fmt.Errorf("duplicated sponsor stat for app %s", elem.Appid)
+		}
+		if elem.SponsoredCount != SponsoredCountMap[elem.Appid] {
+			return fmt.Errorf("sponsor stat of app %s counts %d accounts, %d are sponsored", elem.Appid, elem.SponsoredCount, SponsoredCountMap[elem.Appid])
+		}
+		AppSponsorStatMap[elem.Appid] = true
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -24,16 +25,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the misestm module's genesis state.
 type GenesisState struct {
+	Params           Params          `protobuf:"bytes,10,opt,name=params,proto3" json:"params"`
 	MisesAccountList []*MisesAccount `protobuf:"bytes,9,rep,name=MisesAccountList,proto3" json:"MisesAccountList,omitempty"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	UserInfoList      []*UserInfo     `protobuf:"bytes,7,rep,name=UserInfoList,proto3" json:"UserInfoList,omitempty"`
-	UserInfoCount     uint64          `protobuf:"varint,8,opt,name=UserInfoCount,proto3" json:"UserInfoCount,omitempty"`
-	UserRelationList  []*UserRelation `protobuf:"bytes,5,rep,name=UserRelationList,proto3" json:"UserRelationList,omitempty"`
-	UserRelationCount uint64          `protobuf:"varint,6,opt,name=UserRelationCount,proto3" json:"UserRelationCount,omitempty"`
-	AppInfoList       []*AppInfo      `protobuf:"bytes,3,rep,name=AppInfoList,proto3" json:"AppInfoList,omitempty"`
-	AppInfoCount      uint64          `protobuf:"varint,4,opt,name=AppInfoCount,proto3" json:"AppInfoCount,omitempty"`
-	DidRegistryList   []*DidRegistry  `protobuf:"bytes,1,rep,name=DidRegistryList,proto3" json:"DidRegistryList,omitempty"`
-	DidRegistryCount  uint64          `protobuf:"varint,2,opt,name=DidRegistryCount,proto3" json:"DidRegistryCount,omitempty"`
+	UserInfoList            []*UserInfo            `protobuf:"bytes,7,rep,name=UserInfoList,proto3" json:"UserInfoList,omitempty"`
+	UserInfoCount           uint64                 `protobuf:"varint,8,opt,name=UserInfoCount,proto3" json:"UserInfoCount,omitempty"`
+	UserRelationList        []*UserRelation        `protobuf:"bytes,5,rep,name=UserRelationList,proto3" json:"UserRelationList,omitempty"`
+	UserRelationCount       uint64                 `protobuf:"varint,6,opt,name=UserRelationCount,proto3" json:"UserRelationCount,omitempty"`
+	AppInfoList             []*AppInfo             `protobuf:"bytes,3,rep,name=AppInfoList,proto3" json:"AppInfoList,omitempty"`
+	AppInfoCount            uint64                 `protobuf:"varint,4,opt,name=AppInfoCount,proto3" json:"AppInfoCount,omitempty"`
+	DidRegistryList         []*DidRegistry         `protobuf:"bytes,1,rep,name=DidRegistryList,proto3" json:"DidRegistryList,omitempty"`
+	DidRegistryCount        uint64                 `protobuf:"varint,2,opt,name=DidRegistryCount,proto3" json:"DidRegistryCount,omitempty"`
+	UserListList            []*UserList            `protobuf:"bytes,11,rep,name=UserListList,proto3" json:"UserListList,omitempty"`
+	UserListCount           uint64                 `protobuf:"varint,12,opt,name=UserListCount,proto3" json:"UserListCount,omitempty"`
+	CommunityList           []*Community           `protobuf:"bytes,13,rep,name=CommunityList,proto3" json:"CommunityList,omitempty"`
+	CommunityCount          uint64                 `protobuf:"varint,14,opt,name=CommunityCount,proto3" json:"CommunityCount,omitempty"`
+	CommunityMemberList     []*CommunityMember     `protobuf:"bytes,15,rep,name=CommunityMemberList,proto3" json:"CommunityMemberList,omitempty"`
+	AppFeeGrantTemplateList []*AppFeeGrantTemplate `protobuf:"bytes,16,rep,name=AppFeeGrantTemplateList,proto3" json:"AppFeeGrantTemplateList,omitempty"`
+	AppFeeGranteeList       []*AppFeeGrantee       `protobuf:"bytes,17,rep,name=AppFeeGranteeList,proto3" json:"AppFeeGranteeList,omitempty"`
+	AppAuthorizationList    []*AppAuthorization    `protobuf:"bytes,18,rep,name=AppAuthorizationList,proto3" json:"AppAuthorizationList,omitempty"`
+	AppDenomList            []*AppDenom            `protobuf:"bytes,19,rep,name=AppDenomList,proto3" json:"AppDenomList,omitempty"`
+	NFTClassInfoList        []*NFTClassInfo        `protobuf:"bytes,20,rep,name=NFTClassInfoList,proto3" json:"NFTClassInfoList,omitempty"`
+	AppSponsorStatList      []*AppSponsorStat      `protobuf:"bytes,21,rep,name=AppSponsorStatList,proto3" json:"AppSponsorStatList,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +82,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func (m *GenesisState) GetMisesAccountList() []*MisesAccount {
 	if m != nil {
 		return m.MisesAccountList
@@ -132,6 +152,83 @@ func (m *GenesisState) GetDidRegistryCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetUserListList() []*UserList {
+	if m != nil {
+		return m.UserListList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUserListCount() uint64 {
+	if m != nil {
+		return m.UserListCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommunityList() []*Community {
+	if m != nil {
+		return m.CommunityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityCount() uint64 {
+	if m != nil {
+		return m.CommunityCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommunityMemberList() []*CommunityMember {
+	if m != nil {
+		return m.CommunityMemberList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppFeeGrantTemplateList() []*AppFeeGrantTemplate {
+	if m != nil {
+		return m.AppFeeGrantTemplateList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppFeeGranteeList() []*AppFeeGrantee {
+	if m != nil {
+		return m.AppFeeGranteeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppAuthorizationList() []*AppAuthorization {
+	if m != nil {
+		return m.AppAuthorizationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppDenomList() []*AppDenom {
+	if m != nil {
+		return m.AppDenomList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNFTClassInfoList() []*NFTClassInfo {
+	if m != nil {
+		return m.NFTClassInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppSponsorStatList() []*AppSponsorStat {
+	if m != nil {
+		return m.AppSponsorStatList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "misesid.misestm.v1beta1.GenesisState")
 }
@@ -139,30 +236,49 @@ func init() {
 func init() { proto.RegisterFile("misestm/v1beta1/genesis.proto", fileDescriptor_26f6a90bdd027bdd) }
 
 var fileDescriptor_26f6a90bdd027bdd = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcb, 0x4e, 0x3a, 0x31,
-	0x14, 0xc6, 0x99, 0x3f, 0xfc, 0x51, 0x0b, 0x46, 0xec, 0x46, 0x42, 0x62, 0x03, 0x04, 0x13, 0x62,
-	0x70, 0x26, 0xe8, 0x13, 0x80, 0xb7, 0x98, 0xa8, 0x89, 0x35, 0x6e, 0xdc, 0x71, 0xa9, 0xd8, 0xc4,
-	0xb9, 0x84, 0x1e, 0x8c, 0xbc, 0x85, 0xef, 0xe2, 0x4b, 0xb8, 0x64, 0xe9, 0xd2, 0xc0, 0x8b, 0x18,
-	0x4e, 0x3b, 0x49, 0x61, 0x9c, 0xb8, 0x9b, 0x73, 0xce, 0xf7, 0x7d, 0xbf, 0x9e, 0x69, 0xc9, 0xbe,
-	0x2f, 0x95, 0x50, 0xe0, 0x7b, 0xaf, 0xed, 0xbe, 0x80, 0x5e, 0xdb, 0x1b, 0x89, 0x40, 0x28, 0xa9,
-	0xdc, 0x68, 0x1c, 0x42, 0x48, 0xf7, 0x70, 0x2c, 0x87, 0xae, 0x91, 0xb9, 0x46, 0x56, 0x61, 0xeb,
-	0xbe, 0x07, 0x25, 0xc6, 0x57, 0xc1, 0x53, 0xa8, 0x8d, 0x95, 0xfa, 0x6f, 0x73, 0x2e, 0x5e, 0x7a,
-	0x20, 0xc3, 0xc0, 0x68, 0x12, 0xec, 0x4e, 0x14, 0x59, 0x11, 0xb5, 0xf5, 0xf1, 0x99, 0x1c, 0x72,
-	0x31, 0x92, 0x0a, 0xc6, 0xd3, 0x34, 0xca, 0xcd, 0xb2, 0xee, 0x0c, 0x06, 0xe1, 0x24, 0x00, 0xad,
-	0xa9, 0x7f, 0xe4, 0x48, 0xf1, 0x52, 0x2f, 0x75, 0x0f, 0x3d, 0x10, 0xf4, 0x8e, 0x94, 0x6c, 0xd9,
-	0xb5, 0x54, 0x50, 0xde, 0xaa, 0x66, 0x9b, 0x85, 0xe3, 0x03, 0x37, 0x65, 0x5d, 0xd7, 0x36, 0xf0,
-	0x84, 0x9d, 0x9e, 0x93, 0x62, 0xbc, 0x3f, 0xc6, 0x6d, 0x60, 0x5c, 0x2d, 0x35, 0x2e, 0x16, 0xf3,
-	0x15, 0x1b, 0x6d, 0x90, 0xed, 0xb8, 0x3e, 0x5d, 0x66, 0x97, 0x37, 0xab, 0x4e, 0x33, 0xc7, 0x57,
-	0x9b, 0xcb, 0xf3, 0xdb, 0x3f, 0x13, 0x81, 0xff, 0xff, 0x38, 0xbf, 0x6d, 0xe0, 0x09, 0x3b, 0x6d,
-	0x91, 0x5d, 0xbb, 0xa7, 0xe1, 0x79, 0x84, 0x27, 0x07, 0xb4, 0x4b, 0x0a, 0xe6, 0xa6, 0x90, 0x9d,
-	0x45, 0x76, 0x35, 0x95, 0x6d, 0xb4, 0xdc, 0x36, 0xd1, 0x3a, 0x29, 0x9a, 0x52, 0xc3, 0x72, 0x08,
-	0x5b, 0xe9, 0xd1, 0x5b, 0xb2, 0x63, 0x5d, 0x39, 0xb2, 0x1c, 0x64, 0x35, 0x52, 0x59, 0x96, 0x9e,
-	0xaf, 0x9b, 0xe9, 0x21, 0x29, 0x59, 0x2d, 0xcd, 0xfd, 0x87, 0xdc, 0x44, 0xbf, 0x7b, 0xf1, 0x39,
-	0x67, 0xce, 0x6c, 0xce, 0x9c, 0xef, 0x39, 0x73, 0xde, 0x17, 0x2c, 0x33, 0x5b, 0xb0, 0xcc, 0xd7,
-	0x82, 0x65, 0x1e, 0x5b, 0x23, 0x09, 0xcf, 0x93, 0xbe, 0x3b, 0x08, 0x7d, 0x0f, 0xf1, 0x47, 0x72,
-	0x68, 0x3e, 0xc0, 0xf7, 0xde, 0xbc, 0xf8, 0x49, 0xc2, 0x34, 0x12, 0xaa, 0x9f, 0xc7, 0x47, 0x78,
-	0xf2, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x4a, 0x88, 0xac, 0x68, 0x03, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0xfb, 0x2f, 0x30, 0x49, 0xbf, 0xa6, 0x45, 0x8d, 0x2a, 0x70, 0xd3, 0xa8,
+	0x94, 0x14, 0x15, 0x5b, 0x2d, 0x6b, 0x16, 0x49, 0x4a, 0x0a, 0x12, 0xad, 0x60, 0x1a, 0x84, 0x54,
+	0x89, 0x85, 0x93, 0x4c, 0x53, 0x4b, 0xb1, 0xc7, 0xb2, 0x27, 0x88, 0xf2, 0x14, 0xbc, 0x10, 0xfb,
+	0x2e, 0xbb, 0x64, 0x85, 0x50, 0xf2, 0x22, 0xc8, 0x77, 0x3c, 0x66, 0xfc, 0x95, 0x74, 0x37, 0xbe,
+	0xf7, 0x9c, 0xf3, 0x9b, 0x64, 0xc6, 0xd7, 0xe8, 0xa9, 0x63, 0x07, 0x34, 0xe0, 0x8e, 0xf9, 0xf5,
+	0xa8, 0x47, 0xb9, 0x75, 0x64, 0x0e, 0xa9, 0x4b, 0x03, 0x3b, 0x30, 0x3c, 0x9f, 0x71, 0x86, 0xb7,
+	0xa0, 0x6d, 0x0f, 0x8c, 0x48, 0x66, 0x44, 0xb2, 0x6d, 0x3d, 0xed, 0xfb, 0x14, 0x50, 0xff, 0x9d,
+	0x7b, 0xc5, 0x84, 0x71, 0xbb, 0x9e, 0xd7, 0x27, 0x74, 0x64, 0x71, 0x9b, 0xb9, 0x91, 0x26, 0xc3,
+	0x6e, 0x7a, 0x9e, 0x12, 0xb1, 0x9b, 0x6e, 0x9f, 0xd8, 0x03, 0x42, 0x87, 0x76, 0xc0, 0xfd, 0x9b,
+	0x22, 0xca, 0x59, 0xf8, 0xdc, 0xec, 0xf7, 0xd9, 0xd8, 0xe5, 0x91, 0x26, 0x77, 0xa7, 0xef, 0xed,
+	0x40, 0xf6, 0x77, 0xd2, 0xfd, 0x36, 0x73, 0x9c, 0xb1, 0x6b, 0x73, 0x09, 0x39, 0xc8, 0xd9, 0x66,
+	0x87, 0xd2, 0x53, 0xdf, 0x72, 0x79, 0x97, 0x3a, 0xde, 0xc8, 0xe2, 0x34, 0x92, 0xee, 0xe7, 0x48,
+	0x9b, 0x63, 0x7e, 0xcd, 0x7c, 0xfb, 0xbb, 0xfa, 0xcb, 0xf5, 0x1c, 0xdd, 0x09, 0x75, 0x99, 0x53,
+	0xd4, 0x3f, 0xef, 0x74, 0xdb, 0x23, 0x2b, 0x88, 0x8e, 0x65, 0xfb, 0x49, 0xba, 0xef, 0x59, 0xbe,
+	0xe5, 0xc8, 0xee, 0xe6, 0x90, 0x0d, 0x19, 0x2c, 0xcd, 0x70, 0x25, 0xaa, 0xf5, 0x9f, 0x65, 0x54,
+	0x39, 0x15, 0x87, 0x7b, 0xc1, 0x2d, 0x4e, 0xf1, 0x6b, 0xb4, 0x24, 0x6c, 0x55, 0x54, 0xd3, 0x1a,
+	0xe5, 0xe3, 0x1d, 0xa3, 0xe0, 0xb0, 0x8d, 0x0f, 0x20, 0x6b, 0x2d, 0xde, 0xfe, 0xde, 0x29, 0x91,
+	0xc8, 0x84, 0x3f, 0xa2, 0x35, 0xf5, 0xdf, 0x0e, 0xff, 0xd1, 0xea, 0xa3, 0xda, 0x42, 0xa3, 0x7c,
+	0xfc, 0xac, 0x30, 0x48, 0x35, 0x90, 0x8c, 0x1d, 0xbf, 0x41, 0x15, 0x79, 0x8d, 0x20, 0xee, 0x01,
+	0xc4, 0xed, 0x16, 0xc6, 0x49, 0x31, 0x49, 0xd8, 0xf0, 0x1e, 0x5a, 0x96, 0xcf, 0xed, 0x30, 0xbb,
+	0xfa, 0xb0, 0xa6, 0x35, 0x16, 0x49, 0xb2, 0x18, 0xee, 0x5f, 0xbd, 0x93, 0x00, 0xfc, 0x7f, 0xce,
+	0xfe, 0x55, 0x03, 0xc9, 0xd8, 0xf1, 0x21, 0x5a, 0x57, 0x6b, 0x02, 0xbe, 0x04, 0xf0, 0x6c, 0x03,
+	0xb7, 0x50, 0x39, 0xba, 0xf0, 0xc0, 0x5e, 0x00, 0x76, 0xad, 0x90, 0x1d, 0x69, 0x89, 0x6a, 0xc2,
+	0x75, 0x54, 0x89, 0x1e, 0x05, 0x6c, 0x11, 0x60, 0x89, 0x1a, 0x3e, 0x47, 0xab, 0xca, 0x9b, 0x03,
+	0x2c, 0x0d, 0x58, 0x7b, 0x85, 0x2c, 0x45, 0x4f, 0xd2, 0x66, 0xfc, 0x02, 0xad, 0x29, 0x25, 0xc1,
+	0xfd, 0x0f, 0xb8, 0x99, 0xba, 0x3c, 0xd1, 0xd0, 0x07, 0xe0, 0xf2, 0x3d, 0x4e, 0x34, 0x14, 0x92,
+	0x84, 0x4d, 0x9e, 0x68, 0xb8, 0x16, 0xbc, 0xca, 0xbf, 0x13, 0x8d, 0x8b, 0xf8, 0x2d, 0x5a, 0x8e,
+	0xdf, 0x5d, 0xa0, 0x2d, 0x03, 0xad, 0x5e, 0x48, 0x8b, 0xd5, 0x24, 0x69, 0xc4, 0xfb, 0x68, 0x25,
+	0x2e, 0x08, 0xe0, 0x0a, 0x00, 0x53, 0x55, 0x7c, 0x89, 0x36, 0xe2, 0xca, 0x19, 0x75, 0x7a, 0x62,
+	0x37, 0xd5, 0x55, 0xe0, 0x36, 0xe6, 0x73, 0x85, 0x87, 0xe4, 0x85, 0xe0, 0x2b, 0xb4, 0x95, 0x33,
+	0x68, 0x20, 0x7f, 0x0d, 0xf2, 0x0f, 0x67, 0x5d, 0x95, 0xb4, 0x8f, 0x14, 0x85, 0xe1, 0x2e, 0x5a,
+	0x57, 0x5a, 0x54, 0x10, 0xd6, 0x81, 0xb0, 0x7f, 0x1f, 0x02, 0xa5, 0x24, 0x1b, 0x80, 0xbf, 0xa0,
+	0xcd, 0xf4, 0xec, 0x83, 0x60, 0x0c, 0xc1, 0x07, 0xb3, 0x82, 0x13, 0x26, 0x92, 0x1b, 0x13, 0xde,
+	0x2b, 0x39, 0x32, 0x21, 0x76, 0x63, 0xce, 0xbd, 0x92, 0x62, 0x92, 0xb0, 0x85, 0x33, 0x40, 0x4e,
+	0xd6, 0xf8, 0x3d, 0xdc, 0x9c, 0x33, 0x03, 0x54, 0x03, 0xc9, 0xd8, 0xf1, 0x67, 0x84, 0x9b, 0x9e,
+	0x77, 0xe1, 0x31, 0x37, 0x60, 0x7e, 0x38, 0x68, 0x21, 0xf4, 0x31, 0x84, 0x3e, 0x9f, 0xb5, 0x3f,
+	0xc5, 0x42, 0x72, 0x22, 0x5a, 0x9d, 0xdb, 0x89, 0xae, 0xdd, 0x4d, 0x74, 0xed, 0xcf, 0x44, 0xd7,
+	0x7e, 0x4c, 0xf5, 0xd2, 0xdd, 0x54, 0x2f, 0xfd, 0x9a, 0xea, 0xa5, 0xcb, 0xc3, 0xa1, 0xcd, 0xaf,
+	0xc7, 0x3d, 0xa3, 0xcf, 0x1c, 0x13, 0x82, 0x5f, 0xda, 0x83, 0x68, 0xc1, 0x1d, 0xf3, 0x9b, 0x29,
+	0x3f, 0x16, 0xfc, 0xc6, 0xa3, 0x41, 0x6f, 0x09, 0x3e, 0x07, 0xaf, 0xfe, 0x0e, 0x00, 0x83, 0x51,
+	0xa2, 0x11, 0xfa, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +301,164 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AppSponsorStatList) > 0 {
+		for iNdEx := len(m.AppSponsorStatList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppSponsorStatList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.NFTClassInfoList) > 0 {
+		for iNdEx := len(m.NFTClassInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTClassInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.AppDenomList) > 0 {
+		for iNdEx := len(m.AppDenomList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppDenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AppAuthorizationList) > 0 {
+		for iNdEx := len(m.AppAuthorizationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppAuthorizationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AppFeeGranteeList) > 0 {
+		for iNdEx := len(m.AppFeeGranteeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppFeeGranteeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.AppFeeGrantTemplateList) > 0 {
+		for iNdEx := len(m.AppFeeGrantTemplateList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppFeeGrantTemplateList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CommunityMemberList) > 0 {
+		for iNdEx := len(m.CommunityMemberList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityMemberList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.CommunityCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommunityCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.CommunityList) > 0 {
+		for iNdEx := len(m.CommunityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.UserListCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UserListCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.UserListList) > 0 {
+		for iNdEx := len(m.UserListList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserListList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if len(m.MisesAccountList) > 0 {
 		for iNdEx := len(m.MisesAccountList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,6 +611,68 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UserListList) > 0 {
+		for _, e := range m.UserListList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UserListCount != 0 {
+		n += 1 + sovGenesis(uint64(m.UserListCount))
+	}
+	if len(m.CommunityList) > 0 {
+		for _, e := range m.CommunityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommunityCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CommunityCount))
+	}
+	if len(m.CommunityMemberList) > 0 {
+		for _, e := range m.CommunityMemberList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppFeeGrantTemplateList) > 0 {
+		for _, e := range m.AppFeeGrantTemplateList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppFeeGranteeList) > 0 {
+		for _, e := range m.AppFeeGranteeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppAuthorizationList) > 0 {
+		for _, e := range m.AppAuthorizationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppDenomList) > 0 {
+		for _, e := range m.AppDenomList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NFTClassInfoList) > 0 {
+		for _, e := range m.NFTClassInfoList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppSponsorStatList) > 0 {
+		for _, e := range m.AppSponsorStatList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -621,6 +957,383 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserListList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserListList = append(m.UserListList, &UserList{})
+			if err := m.UserListList[len(m.UserListList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserListCount", wireType)
+			}
+			m.UserListCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserListCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityList = append(m.CommunityList, &Community{})
+			if err := m.CommunityList[len(m.CommunityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityCount", wireType)
+			}
+			m.CommunityCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityMemberList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityMemberList = append(m.CommunityMemberList, &CommunityMember{})
+			if err := m.CommunityMemberList[len(m.CommunityMemberList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppFeeGrantTemplateList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppFeeGrantTemplateList = append(m.AppFeeGrantTemplateList, &AppFeeGrantTemplate{})
+			if err := m.AppFeeGrantTemplateList[len(m.AppFeeGrantTemplateList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppFeeGranteeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppFeeGranteeList = append(m.AppFeeGranteeList, &AppFeeGrantee{})
+			if err := m.AppFeeGranteeList[len(m.AppFeeGranteeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAuthorizationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAuthorizationList = append(m.AppAuthorizationList, &AppAuthorization{})
+			if err := m.AppAuthorizationList[len(m.AppAuthorizationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppDenomList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppDenomList = append(m.AppDenomList, &AppDenom{})
+			if err := m.AppDenomList[len(m.AppDenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTClassInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTClassInfoList = append(m.NFTClassInfoList, &NFTClassInfo{})
+			if err := m.NFTClassInfoList[len(m.NFTClassInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSponsorStatList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSponsorStatList = append(m.AppSponsorStatList, &AppSponsorStat{})
+			if err := m.AppSponsorStatList[len(m.AppSponsorStatList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
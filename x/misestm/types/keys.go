@@ -45,3 +45,61 @@ const (
 	UserInfoKey      = "UserInfo-value-"
 	UserInfoCountKey = "UserInfo-count-"
 )
+
+const (
+	RateLimitKey = "RateLimit-value-"
+)
+
+// rate limited actions, used as part of the rate limit counter key
+const (
+	RateLimitActionUpdateUserRelation = "UpdateUserRelation"
+	RateLimitActionUpdateUserInfo     = "UpdateUserInfo"
+	RateLimitActionCreateDidRegistry  = "CreateDidRegistry"
+)
+
+const (
+	UserRelationExpiryHeightKey = "UserRelation-expiry-height-"
+	UserRelationExpiryTimeKey   = "UserRelation-expiry-time-"
+)
+
+const (
+	UserListKey       = "UserList-value-"
+	UserListCountKey  = "UserList-count-"
+	UserListOwnerKey  = "UserList-owner-"
+	UserListMemberKey = "UserList-member-"
+)
+
+const (
+	CommunityKey            = "Community-value-"
+	CommunityCountKey       = "Community-count-"
+	CommunityMemberKey      = "CommunityMember-value-"
+	CommunityMemberByDidKey = "CommunityMember-did-"
+)
+
+const (
+	AppFeeGrantTemplateKey = "AppFeeGrantTemplate-value-"
+	AppFeeGranteeKey       = "AppFeeGrantee-value-"
+)
+
+const (
+	AppAuthorizationKey      = "AppAuthorization-value-"
+	AppAuthorizationByAppKey = "AppAuthorization-app-"
+)
+
+const (
+	AppDomainKey = "AppDomain-value-"
+)
+
+const (
+	AppSponsorStatKey      = "AppSponsorStat-value-"
+	AppSponsoredAccountKey = "AppSponsor-account-"
+)
+
+const (
+	AppDenomKey      = "AppDenom-value-"
+	AppDenomByAppKey = "AppDenom-app-"
+)
+
+const (
+	NFTClassInfoKey = "NFTClassInfo-value-"
+)
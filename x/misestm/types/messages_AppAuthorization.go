@@ -0,0 +1,210 @@
+package types
+
+import (
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAuthorizeApp{}
+
+func NewMsgAuthorizeApp(creator string, uid string, appid string, scopes []string, expiration *time.Time) *MsgAuthorizeApp {
+	return &MsgAuthorizeApp{
+		Creator:    creator,
+		Uid:        uid,
+		Appid:      appid,
+		Scopes:     scopes,
+		Expiration: expiration,
+	}
+}
+
+func (msg *MsgAuthorizeApp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAuthorizeApp) Type() string {
+	return "AuthorizeApp"
+}
+
+func (msg *MsgAuthorizeApp) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAuthorizeApp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAuthorizeApp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppAuthorizationDids(msg.Uid, msg.Appid); err != nil {
+		return err
+	}
+	if len(msg.Scopes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty scopes")
+	}
+	seen := make(map[string]bool)
+	for _, scope := range msg.Scopes {
+		if !IsValidAppAuthScope(scope) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown scope %s", scope)
+		}
+		if seen[scope] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated scope %s", scope)
+		}
+		seen[scope] = true
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeAppAuthorization{}
+
+func NewMsgRevokeAppAuthorization(creator string, uid string, appid string) *MsgRevokeAppAuthorization {
+	return &MsgRevokeAppAuthorization{
+		Creator: creator,
+		Uid:     uid,
+		Appid:   appid,
+	}
+}
+
+func (msg *MsgRevokeAppAuthorization) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeAppAuthorization) Type() string {
+	return "RevokeAppAuthorization"
+}
+
+func (msg *MsgRevokeAppAuthorization) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeAppAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeAppAuthorization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppAuthorizationDids(msg.Uid, msg.Appid); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ sdk.Msg                          = &MsgExecAppAuthorized{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgExecAppAuthorized{}
+)
+
+func NewMsgExecAppAuthorized(creator string, appid string, msgs []sdk.Msg) (*MsgExecAppAuthorized, error) {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, m := range msgs {
+		any, err := cdctypes.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		msgsAny[i] = any
+	}
+	return &MsgExecAppAuthorized{
+		Creator: creator,
+		Appid:   appid,
+		Msgs:    msgsAny,
+	}, nil
+}
+
+func (msg *MsgExecAppAuthorized) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExecAppAuthorized) Type() string {
+	return "ExecAppAuthorized"
+}
+
+func (msg *MsgExecAppAuthorized) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgExecAppAuthorized) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExecAppAuthorized) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, ok := CheckDid(msg.Appid, DIDTypeApp); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid app id %s", msg.Appid)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty msgs")
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if _, ok := AppAuthScopeOfMsg(m); !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can't be executed by apps", sdk.MsgTypeURL(m))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMessages returns the cached messages to execute
+func (msg *MsgExecAppAuthorized) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgExecAppAuthorized) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(x, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAppAuthorizationDids(uid string, appid string) error {
+	if _, ok := CheckDid(uid, DIDTypeUser); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid %s", uid)
+	}
+	if _, ok := CheckDid(appid, DIDTypeApp); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid app id %s", appid)
+	}
+	return nil
+}
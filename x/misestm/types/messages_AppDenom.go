@@ -0,0 +1,119 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgMintAppDenom{}
+
+func NewMsgMintAppDenom(creator string, appid string, id string, amount sdk.Int, recipient string) *MsgMintAppDenom {
+	return &MsgMintAppDenom{
+		Creator:   creator,
+		Appid:     appid,
+		Id:        id,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgMintAppDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMintAppDenom) Type() string {
+	return "MintAppDenom"
+}
+
+func (msg *MsgMintAppDenom) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMintAppDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMintAppDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := ValidateAppDenomID(msg.Id); err != nil {
+		return err
+	}
+	if err := validateAppDenomAmount(msg.Amount, msg.Recipient, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgBurnAppDenom{}
+
+func NewMsgBurnAppDenom(creator string, appid string, id string, amount sdk.Int) *MsgBurnAppDenom {
+	return &MsgBurnAppDenom{
+		Creator: creator,
+		Appid:   appid,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgBurnAppDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurnAppDenom) Type() string {
+	return "BurnAppDenom"
+}
+
+func (msg *MsgBurnAppDenom) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBurnAppDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurnAppDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := ValidateAppDenomID(msg.Id); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	return nil
+}
+
+// validateAppDenomAmount checks the amount to mint and its recipient, the initial supply of a denom can be zero
+func validateAppDenomAmount(amount sdk.Int, recipient string, allowZero bool) error {
+	if amount.IsNil() || amount.IsNegative() || (amount.IsZero() && !allowZero) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	return nil
+}
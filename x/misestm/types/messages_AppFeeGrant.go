@@ -0,0 +1,282 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetAppFeeGrantTemplate{}
+
+func NewMsgSetAppFeeGrantTemplate(creator string, appid string, name string, period time.Duration, spendLimit sdk.Coins, periodSpendLimit sdk.Coins, expiry time.Duration) *MsgSetAppFeeGrantTemplate {
+	return &MsgSetAppFeeGrantTemplate{
+		Creator:          creator,
+		Appid:            appid,
+		Name:             name,
+		Period:           period,
+		SpendLimit:       spendLimit,
+		PeriodSpendLimit: periodSpendLimit,
+		Expiry:           expiry,
+	}
+}
+
+func (msg *MsgSetAppFeeGrantTemplate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAppFeeGrantTemplate) Type() string {
+	return "SetAppFeeGrantTemplate"
+}
+
+func (msg *MsgSetAppFeeGrantTemplate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetAppFeeGrantTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAppFeeGrantTemplate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := validateAppFeeGrantTemplateName(msg.Name); err != nil {
+		return err
+	}
+	if msg.Expiry < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "negative expiry")
+	}
+	template := AppFeeGrantTemplate{
+		Period:           msg.Period,
+		SpendLimit:       msg.SpendLimit,
+		PeriodSpendLimit: msg.PeriodSpendLimit,
+	}
+	if err := template.Allowance(time.Time{}).ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid template (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteAppFeeGrantTemplate{}
+
+func NewMsgDeleteAppFeeGrantTemplate(creator string, appid string, name string) *MsgDeleteAppFeeGrantTemplate {
+	return &MsgDeleteAppFeeGrantTemplate{
+		Creator: creator,
+		Appid:   appid,
+		Name:    name,
+	}
+}
+
+func (msg *MsgDeleteAppFeeGrantTemplate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteAppFeeGrantTemplate) Type() string {
+	return "DeleteAppFeeGrantTemplate"
+}
+
+func (msg *MsgDeleteAppFeeGrantTemplate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteAppFeeGrantTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteAppFeeGrantTemplate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := validateAppFeeGrantTemplateName(msg.Name); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgGrantAppFeeAllowances{}
+
+func NewMsgGrantAppFeeAllowances(creator string, appid string, template string, grantees []string) *MsgGrantAppFeeAllowances {
+	return &MsgGrantAppFeeAllowances{
+		Creator:  creator,
+		Appid:    appid,
+		Template: template,
+		Grantees: grantees,
+	}
+}
+
+func (msg *MsgGrantAppFeeAllowances) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgGrantAppFeeAllowances) Type() string {
+	return "GrantAppFeeAllowances"
+}
+
+func (msg *MsgGrantAppFeeAllowances) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgGrantAppFeeAllowances) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGrantAppFeeAllowances) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := validateAppFeeGrantTemplateName(msg.Template); err != nil {
+		return err
+	}
+	if err := validateAppFeeGrantees(msg.Grantees); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRenewAppFeeAllowances{}
+
+func NewMsgRenewAppFeeAllowances(creator string, appid string, template string, grantees []string) *MsgRenewAppFeeAllowances {
+	return &MsgRenewAppFeeAllowances{
+		Creator:  creator,
+		Appid:    appid,
+		Template: template,
+		Grantees: grantees,
+	}
+}
+
+func (msg *MsgRenewAppFeeAllowances) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRenewAppFeeAllowances) Type() string {
+	return "RenewAppFeeAllowances"
+}
+
+func (msg *MsgRenewAppFeeAllowances) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRenewAppFeeAllowances) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRenewAppFeeAllowances) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if msg.Template != "" {
+		if err := validateAppFeeGrantTemplateName(msg.Template); err != nil {
+			return err
+		}
+	}
+	if err := validateAppFeeGrantees(msg.Grantees); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeAppFeeAllowances{}
+
+func NewMsgRevokeAppFeeAllowances(creator string, appid string, grantees []string) *MsgRevokeAppFeeAllowances {
+	return &MsgRevokeAppFeeAllowances{
+		Creator:  creator,
+		Appid:    appid,
+		Grantees: grantees,
+	}
+}
+
+func (msg *MsgRevokeAppFeeAllowances) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeAppFeeAllowances) Type() string {
+	return "RevokeAppFeeAllowances"
+}
+
+func (msg *MsgRevokeAppFeeAllowances) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeAppFeeAllowances) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeAppFeeAllowances) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := validateAppFeeGrantees(msg.Grantees); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateAppFeeGrantTemplateName(name string) error {
+	if name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty template name")
+	}
+	return nil
+}
+
+func validateAppFeeGrantees(grantees []string) error {
+	if len(grantees) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty grantees")
+	}
+	seen := make(map[string]bool)
+	for _, grantee := range grantees {
+		if _, ok := CheckDid(grantee, DIDTypeUser); !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid grantee %s", grantee)
+		}
+		if seen[grantee] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated grantee %s", grantee)
+		}
+		seen[grantee] = true
+	}
+	return nil
+}
@@ -48,3 +48,188 @@ func (msg *MsgUpdateAppInfo) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgAddAppAdmin{}
+
+func NewMsgAddAppAdmin(creator string, appid string, admin string) *MsgAddAppAdmin {
+	return &MsgAddAppAdmin{
+		Creator: creator,
+		Appid:   appid,
+		Admin:   admin,
+	}
+}
+
+func (msg *MsgAddAppAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddAppAdmin) Type() string {
+	return "AddAppAdmin"
+}
+
+func (msg *MsgAddAppAdmin) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddAppAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddAppAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveAppAdmin{}
+
+func NewMsgRemoveAppAdmin(creator string, appid string, admin string) *MsgRemoveAppAdmin {
+	return &MsgRemoveAppAdmin{
+		Creator: creator,
+		Appid:   appid,
+		Admin:   admin,
+	}
+}
+
+func (msg *MsgRemoveAppAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveAppAdmin) Type() string {
+	return "RemoveAppAdmin"
+}
+
+func (msg *MsgRemoveAppAdmin) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveAppAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveAppAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgTransferAppOwnership{}
+
+func NewMsgTransferAppOwnership(creator string, appid string, newOwner string) *MsgTransferAppOwnership {
+	return &MsgTransferAppOwnership{
+		Creator:  creator,
+		Appid:    appid,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg *MsgTransferAppOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferAppOwnership) Type() string {
+	return "TransferAppOwnership"
+}
+
+func (msg *MsgTransferAppOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferAppOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferAppOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if msg.NewOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+		}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptAppOwnership{}
+
+func NewMsgAcceptAppOwnership(creator string, appid string) *MsgAcceptAppOwnership {
+	return &MsgAcceptAppOwnership{
+		Creator: creator,
+		Appid:   appid,
+	}
+}
+
+func (msg *MsgAcceptAppOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptAppOwnership) Type() string {
+	return "AcceptAppOwnership"
+}
+
+func (msg *MsgAcceptAppOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptAppOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptAppOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateAppID(appid string) error {
+	if _, ok := CheckDid(appid, DIDTypeApp); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid app id %s", appid)
+	}
+	return nil
+}
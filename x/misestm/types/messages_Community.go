@@ -0,0 +1,249 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateCommunity{}
+
+func NewMsgCreateCommunity(creator string, owner string, name string, description string, joinPolicy CommunityJoinPolicy) *MsgCreateCommunity {
+	return &MsgCreateCommunity{
+		Creator:     creator,
+		Owner:       owner,
+		Name:        name,
+		Description: description,
+		JoinPolicy:  joinPolicy,
+	}
+}
+
+func (msg *MsgCreateCommunity) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateCommunity) Type() string {
+	return "CreateCommunity"
+}
+
+func (msg *MsgCreateCommunity) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateCommunity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateCommunity) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateCommunityDid(msg.Owner); err != nil {
+		return err
+	}
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty name")
+	}
+	if _, ok := CommunityJoinPolicy_name[int32(msg.JoinPolicy)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid join policy %d", msg.JoinPolicy)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgJoinCommunity{}
+
+func NewMsgJoinCommunity(creator string, communityId uint64, member string) *MsgJoinCommunity {
+	return &MsgJoinCommunity{
+		Creator:     creator,
+		CommunityId: communityId,
+		Member:      member,
+	}
+}
+
+func (msg *MsgJoinCommunity) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgJoinCommunity) Type() string {
+	return "JoinCommunity"
+}
+
+func (msg *MsgJoinCommunity) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgJoinCommunity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgJoinCommunity) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateCommunityDid(msg.Member); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgApproveCommunityMember{}
+
+func NewMsgApproveCommunityMember(creator string, communityId uint64, operator string, member string) *MsgApproveCommunityMember {
+	return &MsgApproveCommunityMember{
+		Creator:     creator,
+		CommunityId: communityId,
+		Operator:    operator,
+		Member:      member,
+	}
+}
+
+func (msg *MsgApproveCommunityMember) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveCommunityMember) Type() string {
+	return "ApproveCommunityMember"
+}
+
+func (msg *MsgApproveCommunityMember) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveCommunityMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveCommunityMember) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateCommunityDid(msg.Operator); err != nil {
+		return err
+	}
+	if err := validateCommunityDid(msg.Member); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgKickCommunityMember{}
+
+func NewMsgKickCommunityMember(creator string, communityId uint64, operator string, member string) *MsgKickCommunityMember {
+	return &MsgKickCommunityMember{
+		Creator:     creator,
+		CommunityId: communityId,
+		Operator:    operator,
+		Member:      member,
+	}
+}
+
+func (msg *MsgKickCommunityMember) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgKickCommunityMember) Type() string {
+	return "KickCommunityMember"
+}
+
+func (msg *MsgKickCommunityMember) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgKickCommunityMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgKickCommunityMember) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateCommunityDid(msg.Operator); err != nil {
+		return err
+	}
+	if err := validateCommunityDid(msg.Member); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgChangeCommunityRole{}
+
+func NewMsgChangeCommunityRole(creator string, communityId uint64, operator string, member string, role CommunityRole) *MsgChangeCommunityRole {
+	return &MsgChangeCommunityRole{
+		Creator:     creator,
+		CommunityId: communityId,
+		Operator:    operator,
+		Member:      member,
+		Role:        role,
+	}
+}
+
+func (msg *MsgChangeCommunityRole) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgChangeCommunityRole) Type() string {
+	return "ChangeCommunityRole"
+}
+
+func (msg *MsgChangeCommunityRole) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgChangeCommunityRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgChangeCommunityRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateCommunityDid(msg.Operator); err != nil {
+		return err
+	}
+	if err := validateCommunityDid(msg.Member); err != nil {
+		return err
+	}
+	if _, ok := CommunityRole_name[int32(msg.Role)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid role %d", msg.Role)
+	}
+	return nil
+}
+
+func validateCommunityDid(did string) error {
+	_, isUser := CheckDid(did, DIDTypeUser)
+	_, isApp := CheckDid(did, DIDTypeApp)
+	if !isUser && !isApp {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mises id %s", did)
+	}
+	return nil
+}
@@ -44,5 +44,20 @@ func (msg *MsgCreateDidRegistry) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.Sponsor == "" {
+		if len(msg.Signature) != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "signature without sponsor")
+		}
+		return nil
+	}
+	if err := validateAppID(msg.Sponsor); err != nil {
+		return err
+	}
+	if _, ok := CheckDid(msg.Did, DIDTypeUser); !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only user dids can be sponsored")
+	}
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing user signature")
+	}
 	return nil
 }
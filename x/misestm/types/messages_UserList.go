@@ -0,0 +1,252 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateUserList{}
+
+func NewMsgCreateUserList(creator string, owner string, name string, description string, visibility UserListVisibility, members []string) *MsgCreateUserList {
+	return &MsgCreateUserList{
+		Creator:     creator,
+		Owner:       owner,
+		Name:        name,
+		Description: description,
+		Visibility:  visibility,
+		Members:     members,
+	}
+}
+
+func (msg *MsgCreateUserList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateUserList) Type() string {
+	return "CreateUserList"
+}
+
+func (msg *MsgCreateUserList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateUserList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateUserList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateUserListName(msg.Name); err != nil {
+		return err
+	}
+	if _, ok := UserListVisibility_name[int32(msg.Visibility)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid visibility %d", msg.Visibility)
+	}
+	if err := validateUserListMembers(msg.Members); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRenameUserList{}
+
+func NewMsgRenameUserList(creator string, id uint64, name string, version uint64) *MsgRenameUserList {
+	return &MsgRenameUserList{
+		Creator: creator,
+		Id:      id,
+		Name:    name,
+		Version: version,
+	}
+}
+
+func (msg *MsgRenameUserList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRenameUserList) Type() string {
+	return "RenameUserList"
+}
+
+func (msg *MsgRenameUserList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRenameUserList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRenameUserList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateUserListName(msg.Name); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAddUserListMembers{}
+
+func NewMsgAddUserListMembers(creator string, id uint64, members []string, version uint64) *MsgAddUserListMembers {
+	return &MsgAddUserListMembers{
+		Creator: creator,
+		Id:      id,
+		Members: members,
+		Version: version,
+	}
+}
+
+func (msg *MsgAddUserListMembers) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddUserListMembers) Type() string {
+	return "AddUserListMembers"
+}
+
+func (msg *MsgAddUserListMembers) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddUserListMembers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddUserListMembers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Members) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no members")
+	}
+	if err := validateUserListMembers(msg.Members); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveUserListMembers{}
+
+func NewMsgRemoveUserListMembers(creator string, id uint64, members []string, version uint64) *MsgRemoveUserListMembers {
+	return &MsgRemoveUserListMembers{
+		Creator: creator,
+		Id:      id,
+		Members: members,
+		Version: version,
+	}
+}
+
+func (msg *MsgRemoveUserListMembers) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveUserListMembers) Type() string {
+	return "RemoveUserListMembers"
+}
+
+func (msg *MsgRemoveUserListMembers) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveUserListMembers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveUserListMembers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Members) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no members")
+	}
+	if err := validateUserListMembers(msg.Members); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteUserList{}
+
+func NewMsgDeleteUserList(creator string, id uint64, version uint64) *MsgDeleteUserList {
+	return &MsgDeleteUserList{
+		Creator: creator,
+		Id:      id,
+		Version: version,
+	}
+}
+
+func (msg *MsgDeleteUserList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteUserList) Type() string {
+	return "DeleteUserList"
+}
+
+func (msg *MsgDeleteUserList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteUserList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteUserList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func validateUserListName(name string) error {
+	if name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty name")
+	}
+	return nil
+}
+
+func validateUserListMembers(members []string) error {
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if _, ok := CheckDid(member, DIDTypeUser); !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid member %s", member)
+		}
+		if seen[member] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated member %s", member)
+		}
+		seen[member] = true
+	}
+	return nil
+}
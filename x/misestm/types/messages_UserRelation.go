@@ -15,6 +15,7 @@ func NewMsgUpdateUserRelation(creator string, uidFrom string, uidTo string, relT
 		IsFollowing:  relType&RelTypeBitFollow != 0,
 		IsBlocking:   relType&RelTypeBitBlock != 0,
 		IsReferredBy: relType&RelTypeBitReferredBy != 0,
+		RelType:      relType,
 		Version:      version,
 	}
 }
@@ -45,5 +46,18 @@ func (msg *MsgUpdateUserRelation) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.ExpiringRelType&^msg.GetRelTypeMask() != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiring relation types must be set")
+	}
+	if msg.ExpiringRelType&RelTypeBitReferredBy != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "referred by relation can not expire")
+	}
+	if msg.ExpireHeight < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid expire height")
+	}
+	hasExpiry := msg.ExpireHeight != 0 || msg.ExpireTime != nil
+	if (msg.ExpiringRelType != 0) != hasExpiry {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiring relation types and expiry must be set together")
+	}
 	return nil
 }
@@ -5,17 +5,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
 var _ sdk.Msg = &MsgNewDenom{}
 
-func NewMsgNewDenom(id string, amount sdk.Int, denomMeta *banktypes.Metadata, sender string, recipient string) *MsgNewDenom {
+func NewMsgNewDenom(id string, amount sdk.Int, denomMeta *banktypes.Metadata, sender string, recipient string, appid string) *MsgNewDenom {
 	return &MsgNewDenom{
 		Id:        id,
 		Amount:    amount,
 		DenomMeta: denomMeta,
 		Sender:    sender,
 		Recipient: recipient,
+		Appid:     appid,
 	}
 }
 
@@ -45,20 +47,32 @@ func (msg *MsgNewDenom) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
-	return nil
+	if err := validateAppID(msg.Appid); err != nil {
+		return err
+	}
+	if err := ValidateAppDenomID(msg.Id); err != nil {
+		return err
+	}
+	if _, err := AppDenomMetadata(msg.Appid, msg.Id, msg.DenomMeta); err != nil {
+		return err
+	}
+	return validateAppDenomAmount(msg.Amount, msg.Recipient, true)
 }
 
 var _ sdk.Msg = &MsgNewNFTClass{}
 
-func NewMsgNewNFTClass(id string, name string, uri string, schema string, symbol string, data *types1.Any, sender string) *MsgNewNFTClass {
+func NewMsgNewNFTClass(id string, name string, uri string, schema string, symbol string, data *types1.Any, sender string, royaltyRecipient string, royaltyBasisPoints uint32, soulbound bool) *MsgNewNFTClass {
 	return &MsgNewNFTClass{
-		Id:     id,
-		Name:   name,
-		Uri:    uri,
-		Schema: schema,
-		Symbol: symbol,
-		Data:   data,
-		Sender: sender,
+		Id:                 id,
+		Name:               name,
+		Uri:                uri,
+		Schema:             schema,
+		Symbol:             symbol,
+		Data:               data,
+		Sender:             sender,
+		RoyaltyRecipient:   royaltyRecipient,
+		RoyaltyBasisPoints: royaltyBasisPoints,
+		Soulbound:          soulbound,
 	}
 }
 
@@ -88,7 +102,7 @@ func (msg *MsgNewNFTClass) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
-	return nil
+	return ValidateRoyalty(msg.RoyaltyRecipient, msg.RoyaltyBasisPoints)
 }
 
 var _ sdk.Msg = &MsgUpdateNFTClass{}
@@ -130,6 +144,12 @@ func (msg *MsgUpdateNFTClass) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if msg.Id != "" && msg.Id != msg.ClassId {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id and class id mismatch")
+	}
 	return nil
 }
 
@@ -214,6 +234,12 @@ func (msg *MsgUpdateNFT) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -253,5 +279,180 @@ func (msg *MsgBurnNFT) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgTransferNFT{}
+
+func NewMsgTransferNFT(id string, classId string, sender string, recipient string, price sdk.Coins) *MsgTransferNFT {
+	return &MsgTransferNFT{
+		Id:        id,
+		ClassId:   classId,
+		Sender:    sender,
+		Recipient: recipient,
+		Price:     price,
+	}
+}
+
+func (msg *MsgTransferNFT) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferNFT) Type() string {
+	return "TransferNFT"
+}
+
+// GetSigners returns the sender, and the recipient paying the price of a sale
+func (msg *MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	if msg.Price.Empty() {
+		return []sdk.AccAddress{sender}
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender, recipient}
+}
+
+func (msg *MsgTransferNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient are the same")
+	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	if !msg.Price.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", msg.Price)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeNFT{}
+
+func NewMsgRevokeNFT(id string, classId string, sender string) *MsgRevokeNFT {
+	return &MsgRevokeNFT{
+		Id:      id,
+		ClassId: classId,
+		Sender:  sender,
+	}
+}
+
+func (msg *MsgRevokeNFT) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeNFT) Type() string {
+	return "RevokeNFT"
+}
+
+func (msg *MsgRevokeNFT) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgRevokeNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgTransferDidNFT{}
+
+func NewMsgTransferDidNFT(id string, classId string, sender string, recipient string, price sdk.Coins) *MsgTransferDidNFT {
+	return &MsgTransferDidNFT{
+		Id:        id,
+		ClassId:   classId,
+		Sender:    sender,
+		Recipient: recipient,
+		Price:     price,
+	}
+}
+
+func (msg *MsgTransferDidNFT) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferDidNFT) Type() string {
+	return "TransferDidNFT"
+}
+
+// GetSigners returns the sender, and the recipient paying the price of a sale
+func (msg *MsgTransferDidNFT) GetSigners() []sdk.AccAddress {
+	sender, _, err := AddrFromDid(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	if msg.Price.Empty() {
+		return []sdk.AccAddress{sender}
+	}
+	recipient, _, err := AddrFromDid(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender, recipient}
+}
+
+func (msg *MsgTransferDidNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferDidNFT) ValidateBasic() error {
+	if _, _, err := AddrFromDid(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender mises id (%s)", err)
+	}
+	if _, _, err := AddrFromDid(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient mises id (%s)", err)
+	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	if !msg.Price.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", msg.Price)
+	}
 	return nil
 }
@@ -0,0 +1,394 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// NFTDataGasPerByte is the gas charged per byte of NFT data validated against a schema
+	NFTDataGasPerByte uint64 = 3
+	// NFTDataGasPerNode is the gas charged per value of NFT data and per enum value it is compared to
+	NFTDataGasPerNode uint64 = 20
+)
+
+// NFTSchema is the subset of JSON schema NFT data is validated against. It supports the keywords
+// type, properties, required, additionalProperties, items, enum, minLength, maxLength, minimum,
+// maximum, minItems and maxItems, any other keyword is rejected so that every node validates
+// the same way. Numbers are bounded literals compared exactly and objects are walked in key
+// order, the outcome and the error of a validation only depend on the schema and the data.
+type NFTSchema struct {
+	root   *nftSchemaNode
+	limits NFTSchemaLimits
+}
+
+// NFTSchemaLimits bound the size and nesting of a schema, and the number literals of schemas
+// and data so that comparing them exactly stays cheap, see Params.NFTSchemaLimits
+type NFTSchemaLimits struct {
+	MaxLength         uint64
+	MaxDepth          uint64
+	MaxNumberLength   uint64
+	MaxNumberExponent uint64
+}
+
+type nftSchemaNode struct {
+	Type                 string
+	Properties           map[string]*nftSchemaNode
+	Required             []string
+	AdditionalProperties *bool
+	Items                *nftSchemaNode
+	Enum                 []interface{}
+	MinLength            *uint64
+	MaxLength            *uint64
+	Minimum              *big.Rat
+	Maximum              *big.Rat
+	MinItems             *uint64
+	MaxItems             *uint64
+}
+
+var nftSchemaTypes = map[string]bool{
+	"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+}
+
+// annotations are accepted and ignored
+var nftSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "title": true, "description": true,
+}
+
+// ParseNFTSchema parses the schema of a NFT class
+func ParseNFTSchema(schema string, limits NFTSchemaLimits) (*NFTSchema, error) {
+	if uint64(len(schema)) > limits.MaxLength {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "schema is longer than %d bytes", limits.MaxLength)
+	}
+	value, err := decodeNFTJSON([]byte(schema))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schema: %s", err)
+	}
+	root, err := limits.parseNode(value, "#", 0)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schema: %s", err)
+	}
+	return &NFTSchema{root: root, limits: limits}, nil
+}
+
+// Validate checks the JSON data conforms to the schema, consuming gas per byte and per validated value
+func (s *NFTSchema) Validate(data []byte, gasMeter sdk.GasMeter) error {
+	gasMeter.ConsumeGas(NFTDataGasPerByte*uint64(len(data)), "nft data validation")
+	value, err := decodeNFTJSON(data)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nft data: %s", err)
+	}
+	if err := s.root.validate(value, "#", s.limits, gasMeter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nft data does not match the class schema: %s", err)
+	}
+	return nil
+}
+
+// decodeNFTJSON decodes a single JSON value keeping the numbers as literals
+func decodeNFTJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return value, nil
+}
+
+func (l NFTSchemaLimits) parseNode(value interface{}, path string, depth uint64) (*nftSchemaNode, error) {
+	if depth > l.MaxDepth {
+		return nil, fmt.Errorf("%s: schema is nested deeper than %d", path, l.MaxDepth)
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be an object", path)
+	}
+
+	node := &nftSchemaNode{}
+	var err error
+	for _, key := range sortedKeys(obj) {
+		v := obj[key]
+		switch key {
+		case "type":
+			t, ok := v.(string)
+			if !ok || !nftSchemaTypes[t] {
+				return nil, fmt.Errorf("%s: unsupported type %v", path, v)
+			}
+			node.Type = t
+		case "properties":
+			props, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: properties must be an object", path)
+			}
+			node.Properties = make(map[string]*nftSchemaNode, len(props))
+			for _, name := range sortedKeys(props) {
+				if node.Properties[name], err = l.parseNode(props[name], path+"/properties/"+name, depth+1); err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			list, ok := v.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: required must be an array", path)
+			}
+			for _, item := range list {
+				name, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: required must list property names", path)
+				}
+				node.Required = append(node.Required, name)
+			}
+		case "additionalProperties":
+			allowed, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s: additionalProperties must be a boolean", path)
+			}
+			node.AdditionalProperties = &allowed
+		case "items":
+			if node.Items, err = l.parseNode(v, path+"/items", depth+1); err != nil {
+				return nil, err
+			}
+		case "enum":
+			list, ok := v.([]interface{})
+			if !ok || len(list) == 0 {
+				return nil, fmt.Errorf("%s: enum must be a non empty array", path)
+			}
+			node.Enum = list
+		case "minLength":
+			node.MinLength, err = parseNFTSchemaCount(v, path, key)
+		case "maxLength":
+			node.MaxLength, err = parseNFTSchemaCount(v, path, key)
+		case "minItems":
+			node.MinItems, err = parseNFTSchemaCount(v, path, key)
+		case "maxItems":
+			node.MaxItems, err = parseNFTSchemaCount(v, path, key)
+		case "minimum":
+			node.Minimum, err = l.parseSchemaNumber(v, path, key)
+		case "maximum":
+			node.Maximum, err = l.parseSchemaNumber(v, path, key)
+		default:
+			if !nftSchemaAnnotations[key] {
+				return nil, fmt.Errorf("%s: unsupported keyword %s", path, key)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func parseNFTSchemaCount(v interface{}, path string, key string) (*uint64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a number", path, key)
+	}
+	count, ok := new(big.Int).SetString(n.String(), 10)
+	if !ok || count.Sign() < 0 || !count.IsUint64() {
+		return nil, fmt.Errorf("%s: %s must be a non negative integer", path, key)
+	}
+	c := count.Uint64()
+	return &c, nil
+}
+
+func (l NFTSchemaLimits) parseSchemaNumber(v interface{}, path string, key string) (*big.Rat, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a number", path, key)
+	}
+	r, err := l.parseNumber(n)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s: %s", path, key, err)
+	}
+	return r, nil
+}
+
+// parseNumber parses a number literal exactly after checking its length and exponent bounds
+func (l NFTSchemaLimits) parseNumber(n json.Number) (*big.Rat, error) {
+	literal := n.String()
+	if uint64(len(literal)) > l.MaxNumberLength {
+		return nil, fmt.Errorf("number is longer than %d characters", l.MaxNumberLength)
+	}
+	if i := strings.IndexAny(literal, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(literal[i+1:], 10, 64)
+		abs := uint64(exp)
+		if exp < 0 {
+			abs = uint64(-exp)
+		}
+		if err != nil || abs > l.MaxNumberExponent {
+			return nil, fmt.Errorf("number %s has an exponent out of [-%d, %d]", literal, l.MaxNumberExponent, l.MaxNumberExponent)
+		}
+	}
+	r, ok := new(big.Rat).SetString(literal)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", literal)
+	}
+	return r, nil
+}
+
+func (node *nftSchemaNode) validate(value interface{}, path string, limits NFTSchemaLimits, gasMeter sdk.GasMeter) error {
+	gasMeter.ConsumeGas(NFTDataGasPerNode, "nft data validation")
+	if n, ok := value.(json.Number); ok {
+		if _, err := limits.parseNumber(n); err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	if node.Type != "" && !limits.typeMatches(node.Type, value) {
+		return fmt.Errorf("%s: expected %s", path, node.Type)
+	}
+	if len(node.Enum) > 0 {
+		found := false
+		for _, allowed := range node.Enum {
+			gasMeter.ConsumeGas(NFTDataGasPerNode, "nft data validation")
+			if limits.jsonEqual(allowed, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the enum values", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+		if node.MinLength != nil && length < *node.MinLength {
+			return fmt.Errorf("%s: shorter than %d", path, *node.MinLength)
+		}
+		if node.MaxLength != nil && length > *node.MaxLength {
+			return fmt.Errorf("%s: longer than %d", path, *node.MaxLength)
+		}
+	case json.Number:
+		r, err := limits.parseNumber(v)
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		if node.Minimum != nil && r.Cmp(node.Minimum) < 0 {
+			return fmt.Errorf("%s: less than %s", path, node.Minimum.RatString())
+		}
+		if node.Maximum != nil && r.Cmp(node.Maximum) > 0 {
+			return fmt.Errorf("%s: greater than %s", path, node.Maximum.RatString())
+		}
+	case []interface{}:
+		count := uint64(len(v))
+		if node.MinItems != nil && count < *node.MinItems {
+			return fmt.Errorf("%s: fewer than %d items", path, *node.MinItems)
+		}
+		if node.MaxItems != nil && count > *node.MaxItems {
+			return fmt.Errorf("%s: more than %d items", path, *node.MaxItems)
+		}
+		if node.Items != nil {
+			for i, item := range v {
+				if err := node.Items.validate(item, fmt.Sprintf("%s/%d", path, i), limits, gasMeter); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range node.Required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s: missing property %s", path, name)
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			prop, ok := node.Properties[name]
+			if !ok {
+				if node.AdditionalProperties != nil && !*node.AdditionalProperties {
+					return fmt.Errorf("%s: unexpected property %s", path, name)
+				}
+				continue
+			}
+			if err := prop.validate(v[name], path+"/"+name, limits, gasMeter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l NFTSchemaLimits) typeMatches(t string, value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return t == "object"
+	case []interface{}:
+		return t == "array"
+	case string:
+		return t == "string"
+	case bool:
+		return t == "boolean"
+	case nil:
+		return t == "null"
+	case json.Number:
+		if t == "number" {
+			return true
+		}
+		if t != "integer" {
+			return false
+		}
+		r, err := l.parseNumber(v)
+		return err == nil && r.IsInt()
+	}
+	return false
+}
+
+// jsonEqual compares decoded JSON values, numbers by value
+func (l NFTSchemaLimits) jsonEqual(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		bn, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ar, aerr := l.parseNumber(a)
+		br, berr := l.parseNumber(bn)
+		return aerr == nil && berr == nil && ar.Cmp(br) == 0
+	case []interface{}:
+		bl, ok := b.([]interface{})
+		if !ok || len(a) != len(bl) {
+			return false
+		}
+		for i := range a {
+			if !l.jsonEqual(a[i], bl[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bm, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(bm) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := bm[k]
+			if !ok || !l.jsonEqual(av, bv) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
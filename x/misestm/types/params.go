@@ -0,0 +1,349 @@
+package types
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Parameter store keys
+var (
+	KeyRateLimitWindow    = []byte("RateLimitWindow")
+	KeyMaxRelationUpdates = []byte("MaxRelationUpdates")
+	KeyMaxUserInfoUpdates = []byte("MaxUserInfoUpdates")
+	KeyMaxDidCreations    = []byte("MaxDidCreations")
+	KeyRelationTypes      = []byte("RelationTypes")
+	KeyMaxAppDenomSupply  = []byte("MaxAppDenomSupply")
+	KeyMaxAppDenoms       = []byte("MaxAppDenoms")
+	KeyMaxRelationBatch   = []byte("MaxRelationBatch")
+
+	KeyMaxProfileFieldLength = []byte("MaxProfileFieldLength")
+	KeyMaxProfileListItems   = []byte("MaxProfileListItems")
+	KeyMaxPrivateInfoLength  = []byte("MaxPrivateInfoLength")
+	KeyDidCreationFee        = []byte("DidCreationFee")
+
+	KeyMaxUserListMembers               = []byte("MaxUserListMembers")
+	KeyMaxAppFeeGrantBatch              = []byte("MaxAppFeeGrantBatch")
+	KeyMaxAppAdmins                     = []byte("MaxAppAdmins")
+	KeyMaxAppExecMsgs                   = []byte("MaxAppExecMsgs")
+	KeyMaxAppFeeGrantTemplateNameLength = []byte("MaxAppFeeGrantTemplateNameLength")
+	KeyMaxUserListNameLength            = []byte("MaxUserListNameLength")
+	KeyMaxUserListDescriptionLength     = []byte("MaxUserListDescriptionLength")
+	KeyMaxCommunityNameLength           = []byte("MaxCommunityNameLength")
+	KeyMaxCommunityDescriptionLength    = []byte("MaxCommunityDescriptionLength")
+	KeyMaxNFTSchemaLength               = []byte("MaxNFTSchemaLength")
+	KeyMaxNFTSchemaDepth                = []byte("MaxNFTSchemaDepth")
+	KeyMaxNFTNumberLength               = []byte("MaxNFTNumberLength")
+	KeyMaxNFTNumberExponent             = []byte("MaxNFTNumberExponent")
+	KeyMaxAppVerificationReasonLength   = []byte("MaxAppVerificationReasonLength")
+)
+
+const (
+	// about one hour with 6 second blocks
+	DefaultRateLimitWindow    = uint64(600)
+	DefaultMaxRelationUpdates = uint64(300)
+	DefaultMaxUserInfoUpdates = uint64(30)
+	DefaultMaxDidCreations    = uint64(1000)
+	DefaultMaxAppDenoms       = uint64(10)
+	DefaultMaxRelationBatch   = uint64(100)
+
+	DefaultMaxProfileFieldLength = uint64(1024)
+	DefaultMaxProfileListItems   = uint64(16)
+	DefaultMaxPrivateInfoLength  = uint64(8192)
+
+	DefaultMaxUserListMembers               = uint64(1000)
+	DefaultMaxAppFeeGrantBatch              = uint64(1000)
+	DefaultMaxAppAdmins                     = uint64(20)
+	DefaultMaxAppExecMsgs                   = uint64(100)
+	DefaultMaxAppFeeGrantTemplateNameLength = uint64(64)
+	DefaultMaxUserListNameLength            = uint64(64)
+	DefaultMaxUserListDescriptionLength     = uint64(512)
+	DefaultMaxCommunityNameLength           = uint64(64)
+	DefaultMaxCommunityDescriptionLength    = uint64(512)
+	DefaultMaxNFTSchemaLength               = uint64(16 * 1024)
+	DefaultMaxNFTSchemaDepth                = uint64(16)
+	DefaultMaxNFTNumberLength               = uint64(64)
+	DefaultMaxNFTNumberExponent             = uint64(64)
+	DefaultMaxAppVerificationReasonLength   = uint64(1024)
+)
+
+// DefaultMaxAppDenomSupply caps the supply of a denom issued by an app
+var DefaultMaxAppDenomSupply = sdk.NewIntWithDecimal(1, 18)
+
+// DefaultDidCreationFee charges nothing for registering a did
+var DefaultDidCreationFee sdk.Coins
+
+// DefaultRelationTypes is the initial relation type registry, the first three
+// types are built in and can not be removed by governance
+var DefaultRelationTypes = []RelationType{
+	{Bit: RelTypeBitFollow, Name: "following"},
+	{Bit: RelTypeBitBlock, Name: "blocking"},
+	{Bit: RelTypeBitReferredBy, Name: "refer_by"},
+	{Bit: RelTypeBitMute, Name: "muting"},
+	{Bit: RelTypeBitCloseFriend, Name: "close_friend"},
+	{Bit: RelTypeBitSubscribe, Name: "subscribing"},
+}
+
+// ParamKeyTable the param key table for the misestm module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		RateLimitWindow:                  DefaultRateLimitWindow,
+		MaxRelationUpdates:               DefaultMaxRelationUpdates,
+		MaxUserInfoUpdates:               DefaultMaxUserInfoUpdates,
+		MaxDidCreations:                  DefaultMaxDidCreations,
+		RelationTypes:                    append([]RelationType{}, DefaultRelationTypes...),
+		MaxAppDenomSupply:                DefaultMaxAppDenomSupply,
+		MaxAppDenoms:                     DefaultMaxAppDenoms,
+		MaxRelationBatch:                 DefaultMaxRelationBatch,
+		MaxProfileFieldLength:            DefaultMaxProfileFieldLength,
+		MaxProfileListItems:              DefaultMaxProfileListItems,
+		MaxPrivateInfoLength:             DefaultMaxPrivateInfoLength,
+		DidCreationFee:                   DefaultDidCreationFee,
+		MaxUserListMembers:               DefaultMaxUserListMembers,
+		MaxAppFeeGrantBatch:              DefaultMaxAppFeeGrantBatch,
+		MaxAppAdmins:                     DefaultMaxAppAdmins,
+		MaxAppExecMsgs:                   DefaultMaxAppExecMsgs,
+		MaxAppFeeGrantTemplateNameLength: DefaultMaxAppFeeGrantTemplateNameLength,
+		MaxUserListNameLength:            DefaultMaxUserListNameLength,
+		MaxUserListDescriptionLength:     DefaultMaxUserListDescriptionLength,
+		MaxCommunityNameLength:           DefaultMaxCommunityNameLength,
+		MaxCommunityDescriptionLength:    DefaultMaxCommunityDescriptionLength,
+		MaxNftSchemaLength:               DefaultMaxNFTSchemaLength,
+		MaxNftSchemaDepth:                DefaultMaxNFTSchemaDepth,
+		MaxNftNumberLength:               DefaultMaxNFTNumberLength,
+		MaxNftNumberExponent:             DefaultMaxNFTNumberExponent,
+		MaxAppVerificationReasonLength:   DefaultMaxAppVerificationReasonLength,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimitWindow, &p.RateLimitWindow, validateRateLimitWindow),
+		paramtypes.NewParamSetPair(KeyMaxRelationUpdates, &p.MaxRelationUpdates, validateQuota),
+		paramtypes.NewParamSetPair(KeyMaxUserInfoUpdates, &p.MaxUserInfoUpdates, validateQuota),
+		paramtypes.NewParamSetPair(KeyMaxDidCreations, &p.MaxDidCreations, validateQuota),
+		paramtypes.NewParamSetPair(KeyRelationTypes, &p.RelationTypes, validateRelationTypes),
+		paramtypes.NewParamSetPair(KeyMaxAppDenomSupply, &p.MaxAppDenomSupply, validateMaxAppDenomSupply),
+		paramtypes.NewParamSetPair(KeyMaxAppDenoms, &p.MaxAppDenoms, validateQuota),
+		paramtypes.NewParamSetPair(KeyMaxRelationBatch, &p.MaxRelationBatch, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxProfileFieldLength, &p.MaxProfileFieldLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxProfileListItems, &p.MaxProfileListItems, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxPrivateInfoLength, &p.MaxPrivateInfoLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyDidCreationFee, &p.DidCreationFee, validateFee),
+		paramtypes.NewParamSetPair(KeyMaxUserListMembers, &p.MaxUserListMembers, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxAppFeeGrantBatch, &p.MaxAppFeeGrantBatch, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxAppAdmins, &p.MaxAppAdmins, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxAppExecMsgs, &p.MaxAppExecMsgs, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxAppFeeGrantTemplateNameLength, &p.MaxAppFeeGrantTemplateNameLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxUserListNameLength, &p.MaxUserListNameLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxUserListDescriptionLength, &p.MaxUserListDescriptionLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxCommunityNameLength, &p.MaxCommunityNameLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxCommunityDescriptionLength, &p.MaxCommunityDescriptionLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxNFTSchemaLength, &p.MaxNftSchemaLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxNFTSchemaDepth, &p.MaxNftSchemaDepth, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxNFTNumberLength, &p.MaxNftNumberLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxNFTNumberExponent, &p.MaxNftNumberExponent, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxAppVerificationReasonLength, &p.MaxAppVerificationReasonLength, validateLimit),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateRateLimitWindow(p.RateLimitWindow); err != nil {
+		return err
+	}
+	if err := validateQuota(p.MaxRelationUpdates); err != nil {
+		return err
+	}
+	if err := validateQuota(p.MaxUserInfoUpdates); err != nil {
+		return err
+	}
+	if err := validateQuota(p.MaxDidCreations); err != nil {
+		return err
+	}
+	if err := validateMaxAppDenomSupply(p.MaxAppDenomSupply); err != nil {
+		return err
+	}
+	if err := validateQuota(p.MaxAppDenoms); err != nil {
+		return err
+	}
+	for _, limit := range []uint64{
+		p.MaxRelationBatch, p.MaxProfileFieldLength, p.MaxProfileListItems, p.MaxPrivateInfoLength,
+		p.MaxUserListMembers, p.MaxAppFeeGrantBatch, p.MaxAppAdmins, p.MaxAppExecMsgs, p.MaxAppFeeGrantTemplateNameLength,
+		p.MaxUserListNameLength, p.MaxUserListDescriptionLength, p.MaxCommunityNameLength, p.MaxCommunityDescriptionLength,
+		p.MaxNftSchemaLength, p.MaxNftSchemaDepth, p.MaxNftNumberLength, p.MaxNftNumberExponent, p.MaxAppVerificationReasonLength,
+	} {
+		if err := validateLimit(limit); err != nil {
+			return err
+		}
+	}
+	if err := validateFee(p.DidCreationFee); err != nil {
+		return err
+	}
+	return validateRelationTypes(p.RelationTypes)
+}
+
+// NFTSchemaLimits returns the limits nft class schemas and data are parsed with
+func (p Params) NFTSchemaLimits() NFTSchemaLimits {
+	return NFTSchemaLimits{
+		MaxLength:         p.MaxNftSchemaLength,
+		MaxDepth:          p.MaxNftSchemaDepth,
+		MaxNumberLength:   p.MaxNftNumberLength,
+		MaxNumberExponent: p.MaxNftNumberExponent,
+	}
+}
+
+// RelTypeMask returns the mask of all registered relation types
+func (p Params) RelTypeMask() uint64 {
+	mask := uint64(0)
+	for _, t := range p.RelationTypes {
+		mask |= t.Bit
+	}
+	return mask
+}
+
+// RelTypeFromNames parses a comma separated list of relation type names into a bitmask
+func (p Params) RelTypeFromNames(names string) (uint64, error) {
+	relType := uint64(0)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, t := range p.RelationTypes {
+			if t.Name == name {
+				relType |= t.Bit
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown relation type %s", name)
+		}
+	}
+	return relType, nil
+}
+
+// RelTypeNames returns the names of the relation types set in the bitmask
+func (p Params) RelTypeNames(relType uint64) []string {
+	names := []string{}
+	for _, t := range p.RelationTypes {
+		if relType&t.Bit != 0 {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// CheckUserInfo checks the sizes of a profile against the params
+func (p Params) CheckUserInfo(pubInfo *PublicUserInfo, priInfo *PrivateUserInfo) error {
+	if pubInfo != nil {
+		fields := []string{pubInfo.Name, pubInfo.Gender, pubInfo.AvatarUrl, pubInfo.HomePageUrl, pubInfo.Intro}
+		for _, list := range [][]string{pubInfo.Emails, pubInfo.Telephones} {
+			if uint64(len(list)) > p.MaxProfileListItems {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "more than %d emails or telephones", p.MaxProfileListItems)
+			}
+			fields = append(fields, list...)
+		}
+		for _, field := range fields {
+			if uint64(len(field)) > p.MaxProfileFieldLength {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "profile field longer than %d", p.MaxProfileFieldLength)
+			}
+		}
+	}
+	if priInfo != nil && uint64(len(priInfo.EncData)+len(priInfo.Iv)) > p.MaxPrivateInfoLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "private info longer than %d", p.MaxPrivateInfoLength)
+	}
+	return nil
+}
+
+func validateRateLimitWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("rate limit window must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateQuota(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("limit must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateMaxAppDenomSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max app denom supply must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateRelationTypes(i interface{}) error {
+	v, ok := i.([]RelationType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	mask := uint64(0)
+	names := make(map[string]bool)
+	for _, t := range v {
+		if bits.OnesCount64(t.Bit) != 1 {
+			return fmt.Errorf("relation type %s must use exactly one bit: %d", t.Name, t.Bit)
+		}
+		if mask&t.Bit != 0 {
+			return fmt.Errorf("duplicated relation type bit %d", t.Bit)
+		}
+		if t.Name == "" || strings.ContainsAny(t.Name, ", ") {
+			return fmt.Errorf("invalid relation type name %q", t.Name)
+		}
+		if names[t.Name] {
+			return fmt.Errorf("duplicated relation type name %s", t.Name)
+		}
+		mask |= t.Bit
+		names[t.Name] = true
+	}
+	builtin := RelTypeBitFollow | RelTypeBitBlock | RelTypeBitReferredBy
+	if mask&builtin != builtin {
+		return fmt.Errorf("built-in relation types must be registered")
+	}
+	return nil
+}
@@ -0,0 +1,1556 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: misestm/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the misestm module.
+type Params struct {
+	// length of a rate limit window, in blocks
+	RateLimitWindow uint64 `protobuf:"varint,1,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty" yaml:"rate_limit_window"`
+	// max UpdateUserRelation messages per uidFrom in a window, 0 means unlimited
+	MaxRelationUpdates uint64 `protobuf:"varint,2,opt,name=max_relation_updates,json=maxRelationUpdates,proto3" json:"max_relation_updates,omitempty" yaml:"max_relation_updates"`
+	// max UpdateUserInfo messages per uid in a window, 0 means unlimited
+	MaxUserInfoUpdates uint64 `protobuf:"varint,3,opt,name=max_user_info_updates,json=maxUserInfoUpdates,proto3" json:"max_user_info_updates,omitempty" yaml:"max_user_info_updates"`
+	// max CreateDidRegistry messages per creator in a window, 0 means unlimited
+	MaxDidCreations uint64 `protobuf:"varint,4,opt,name=max_did_creations,json=maxDidCreations,proto3" json:"max_did_creations,omitempty" yaml:"max_did_creations"`
+	// registry of the relation types a UserRelation can carry
+	RelationTypes []RelationType `protobuf:"bytes,5,rep,name=relation_types,json=relationTypes,proto3" json:"relation_types" yaml:"relation_types"`
+	// max supply of a denom issued by an app
+	MaxAppDenomSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_app_denom_supply,json=maxAppDenomSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_app_denom_supply" yaml:"max_app_denom_supply"`
+	// max denoms an app can issue, 0 means unlimited
+	MaxAppDenoms uint64 `protobuf:"varint,7,opt,name=max_app_denoms,json=maxAppDenoms,proto3" json:"max_app_denoms,omitempty" yaml:"max_app_denoms"`
+	// max user relations read in one batch, it caps the page size of relation queries
+	MaxRelationBatch uint64 `protobuf:"varint,8,opt,name=max_relation_batch,json=maxRelationBatch,proto3" json:"max_relation_batch,omitempty" yaml:"max_relation_batch"`
+	// max length of a public profile field, each email and telephone counts as a field
+	MaxProfileFieldLength uint64 `protobuf:"varint,9,opt,name=max_profile_field_length,json=maxProfileFieldLength,proto3" json:"max_profile_field_length,omitempty" yaml:"max_profile_field_length"`
+	// max emails or telephones of a public profile
+	MaxProfileListItems uint64 `protobuf:"varint,10,opt,name=max_profile_list_items,json=maxProfileListItems,proto3" json:"max_profile_list_items,omitempty" yaml:"max_profile_list_items"`
+	// max length of the encrypted private profile
+	MaxPrivateInfoLength uint64 `protobuf:"varint,11,opt,name=max_private_info_length,json=maxPrivateInfoLength,proto3" json:"max_private_info_length,omitempty" yaml:"max_private_info_length"`
+	// fee paid to the fee collector by the creator of a did, empty means free
+	DidCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=did_creation_fee,json=didCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"did_creation_fee" yaml:"did_creation_fee"`
+	// max members of a user list
+	MaxUserListMembers uint64 `protobuf:"varint,13,opt,name=max_user_list_members,json=maxUserListMembers,proto3" json:"max_user_list_members,omitempty" yaml:"max_user_list_members"`
+	// max grantees of one app fee grant message
+	MaxAppFeeGrantBatch uint64 `protobuf:"varint,14,opt,name=max_app_fee_grant_batch,json=maxAppFeeGrantBatch,proto3" json:"max_app_fee_grant_batch,omitempty" yaml:"max_app_fee_grant_batch"`
+	// max admins of an app besides its owner
+	MaxAppAdmins uint64 `protobuf:"varint,15,opt,name=max_app_admins,json=maxAppAdmins,proto3" json:"max_app_admins,omitempty" yaml:"max_app_admins"`
+	// max msgs an app executes on behalf of a user in one message
+	MaxAppExecMsgs uint64 `protobuf:"varint,16,opt,name=max_app_exec_msgs,json=maxAppExecMsgs,proto3" json:"max_app_exec_msgs,omitempty" yaml:"max_app_exec_msgs"`
+	// max length of the name of an app fee grant template
+	MaxAppFeeGrantTemplateNameLength uint64 `protobuf:"varint,17,opt,name=max_app_fee_grant_template_name_length,json=maxAppFeeGrantTemplateNameLength,proto3" json:"max_app_fee_grant_template_name_length,omitempty" yaml:"max_app_fee_grant_template_name_length"`
+	// max length of the name of a user list
+	MaxUserListNameLength uint64 `protobuf:"varint,18,opt,name=max_user_list_name_length,json=maxUserListNameLength,proto3" json:"max_user_list_name_length,omitempty" yaml:"max_user_list_name_length"`
+	// max length of the description of a user list
+	MaxUserListDescriptionLength uint64 `protobuf:"varint,19,opt,name=max_user_list_description_length,json=maxUserListDescriptionLength,proto3" json:"max_user_list_description_length,omitempty" yaml:"max_user_list_description_length"`
+	// max length of the name of a community
+	MaxCommunityNameLength uint64 `protobuf:"varint,20,opt,name=max_community_name_length,json=maxCommunityNameLength,proto3" json:"max_community_name_length,omitempty" yaml:"max_community_name_length"`
+	// max length of the description of a community
+	MaxCommunityDescriptionLength uint64 `protobuf:"varint,21,opt,name=max_community_description_length,json=maxCommunityDescriptionLength,proto3" json:"max_community_description_length,omitempty" yaml:"max_community_description_length"`
+	// max length in bytes of the schema of a nft class, schemas are parsed again for every nft data
+	// so lowering the nft schema limits makes the nfts of classes exceeding them fail validation
+	MaxNftSchemaLength uint64 `protobuf:"varint,22,opt,name=max_nft_schema_length,json=maxNftSchemaLength,proto3" json:"max_nft_schema_length,omitempty" yaml:"max_nft_schema_length"`
+	// max nesting of a nft class schema
+	MaxNftSchemaDepth uint64 `protobuf:"varint,23,opt,name=max_nft_schema_depth,json=maxNftSchemaDepth,proto3" json:"max_nft_schema_depth,omitempty" yaml:"max_nft_schema_depth"`
+	// max length of the number literals of nft schemas and data
+	MaxNftNumberLength uint64 `protobuf:"varint,24,opt,name=max_nft_number_length,json=maxNftNumberLength,proto3" json:"max_nft_number_length,omitempty" yaml:"max_nft_number_length"`
+	// max absolute exponent of the number literals of nft schemas and data
+	MaxNftNumberExponent uint64 `protobuf:"varint,25,opt,name=max_nft_number_exponent,json=maxNftNumberExponent,proto3" json:"max_nft_number_exponent,omitempty" yaml:"max_nft_number_exponent"`
+	// max length of the reason of an app verification proposal
+	MaxAppVerificationReasonLength uint64 `protobuf:"varint,26,opt,name=max_app_verification_reason_length,json=maxAppVerificationReasonLength,proto3" json:"max_app_verification_reason_length,omitempty" yaml:"max_app_verification_reason_length"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10361454fbaa57ef, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRateLimitWindow() uint64 {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRelationUpdates() uint64 {
+	if m != nil {
+		return m.MaxRelationUpdates
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUserInfoUpdates() uint64 {
+	if m != nil {
+		return m.MaxUserInfoUpdates
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDidCreations() uint64 {
+	if m != nil {
+		return m.MaxDidCreations
+	}
+	return 0
+}
+
+func (m *Params) GetRelationTypes() []RelationType {
+	if m != nil {
+		return m.RelationTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxAppDenoms() uint64 {
+	if m != nil {
+		return m.MaxAppDenoms
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRelationBatch() uint64 {
+	if m != nil {
+		return m.MaxRelationBatch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProfileFieldLength() uint64 {
+	if m != nil {
+		return m.MaxProfileFieldLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProfileListItems() uint64 {
+	if m != nil {
+		return m.MaxProfileListItems
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrivateInfoLength() uint64 {
+	if m != nil {
+		return m.MaxPrivateInfoLength
+	}
+	return 0
+}
+
+func (m *Params) GetDidCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DidCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxUserListMembers() uint64 {
+	if m != nil {
+		return m.MaxUserListMembers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppFeeGrantBatch() uint64 {
+	if m != nil {
+		return m.MaxAppFeeGrantBatch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppAdmins() uint64 {
+	if m != nil {
+		return m.MaxAppAdmins
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppExecMsgs() uint64 {
+	if m != nil {
+		return m.MaxAppExecMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppFeeGrantTemplateNameLength() uint64 {
+	if m != nil {
+		return m.MaxAppFeeGrantTemplateNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUserListNameLength() uint64 {
+	if m != nil {
+		return m.MaxUserListNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUserListDescriptionLength() uint64 {
+	if m != nil {
+		return m.MaxUserListDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCommunityNameLength() uint64 {
+	if m != nil {
+		return m.MaxCommunityNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCommunityDescriptionLength() uint64 {
+	if m != nil {
+		return m.MaxCommunityDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNftSchemaLength() uint64 {
+	if m != nil {
+		return m.MaxNftSchemaLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNftSchemaDepth() uint64 {
+	if m != nil {
+		return m.MaxNftSchemaDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNftNumberLength() uint64 {
+	if m != nil {
+		return m.MaxNftNumberLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNftNumberExponent() uint64 {
+	if m != nil {
+		return m.MaxNftNumberExponent
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppVerificationReasonLength() uint64 {
+	if m != nil {
+		return m.MaxAppVerificationReasonLength
+	}
+	return 0
+}
+
+// RelationType names one bit of the UserRelation relType bitmask.
+type RelationType struct {
+	Bit  uint64 `protobuf:"varint,1,opt,name=bit,proto3" json:"bit,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RelationType) Reset()         { *m = RelationType{} }
+func (m *RelationType) String() string { return proto.CompactTextString(m) }
+func (*RelationType) ProtoMessage()    {}
+func (*RelationType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10361454fbaa57ef, []int{1}
+}
+func (m *RelationType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationType.Merge(m, src)
+}
+func (m *RelationType) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationType) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationType proto.InternalMessageInfo
+
+func (m *RelationType) GetBit() uint64 {
+	if m != nil {
+		return m.Bit
+	}
+	return 0
+}
+
+func (m *RelationType) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "misesid.misestm.v1beta1.Params")
+	proto.RegisterType((*RelationType)(nil), "misesid.misestm.v1beta1.RelationType")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/params.proto", fileDescriptor_10361454fbaa57ef) }
+
+var fileDescriptor_10361454fbaa57ef = []byte{
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x72, 0xe3, 0x34,
+	0x14, 0x6e, 0x68, 0x29, 0x54, 0xdb, 0xed, 0x8f, 0x9a, 0x6e, 0x9c, 0xd2, 0xc6, 0x41, 0xc0, 0x52,
+	0x06, 0x9a, 0x4c, 0x81, 0x2b, 0x6e, 0x98, 0xa6, 0xdd, 0xee, 0x76, 0xb6, 0xed, 0x14, 0x75, 0x77,
+	0xf9, 0x19, 0x06, 0x8f, 0x63, 0x2b, 0x89, 0x66, 0x23, 0xdb, 0x63, 0x29, 0xdd, 0xe4, 0x86, 0x1b,
+	0x5e, 0x60, 0x1f, 0x81, 0x6b, 0x9e, 0x64, 0x2f, 0xf7, 0x92, 0xe1, 0xc2, 0x30, 0xed, 0x1b, 0xf8,
+	0x09, 0x18, 0x49, 0x76, 0x62, 0x27, 0x0e, 0xc3, 0x55, 0xdd, 0xef, 0x7c, 0xfa, 0x8e, 0x8e, 0xf4,
+	0x9d, 0x13, 0x81, 0x5d, 0x46, 0x39, 0xe1, 0x82, 0x35, 0x6f, 0x0e, 0xdb, 0x44, 0xd8, 0x87, 0xcd,
+	0xc0, 0x0e, 0x6d, 0xc6, 0x1b, 0x41, 0xe8, 0x0b, 0x1f, 0x56, 0x54, 0x94, 0xba, 0x8d, 0x84, 0xd5,
+	0x48, 0x58, 0x3b, 0xe5, 0xae, 0xdf, 0xf5, 0x15, 0xa7, 0x29, 0xbf, 0x34, 0x7d, 0xa7, 0xe6, 0xf8,
+	0x9c, 0xf9, 0xbc, 0xd9, 0xb6, 0x39, 0x19, 0x0b, 0x3a, 0x3e, 0xf5, 0x74, 0x1c, 0xfd, 0xbe, 0x05,
+	0x96, 0xaf, 0x94, 0x3e, 0x7c, 0x02, 0x36, 0x43, 0x5b, 0x10, 0xab, 0x4f, 0x19, 0x15, 0xd6, 0x2b,
+	0xea, 0xb9, 0xfe, 0x2b, 0xa3, 0x54, 0x2f, 0xed, 0x2f, 0xb5, 0x76, 0xe3, 0xc8, 0x34, 0x46, 0x36,
+	0xeb, 0x7f, 0x83, 0x66, 0x28, 0x08, 0xaf, 0x4b, 0xec, 0x5c, 0x42, 0xdf, 0x2b, 0x04, 0x7e, 0x07,
+	0xca, 0xcc, 0x1e, 0x5a, 0x21, 0xe9, 0xdb, 0x82, 0xfa, 0x9e, 0x35, 0x08, 0x5c, 0x5b, 0x10, 0x6e,
+	0xbc, 0xa3, 0xc4, 0xcc, 0x38, 0x32, 0x3f, 0xd0, 0x62, 0x45, 0x2c, 0x84, 0x21, 0xb3, 0x87, 0x38,
+	0x41, 0x9f, 0x6b, 0x10, 0x5e, 0x83, 0x6d, 0x49, 0x1e, 0x70, 0x12, 0x5a, 0xd4, 0xeb, 0xf8, 0x63,
+	0xcd, 0x45, 0xa5, 0x59, 0x8f, 0x23, 0x73, 0x77, 0xa2, 0x39, 0x43, 0xd3, 0xa2, 0xcf, 0x39, 0x09,
+	0xcf, 0xbc, 0x8e, 0x9f, 0x8a, 0x3e, 0x01, 0x9b, 0x92, 0xed, 0x52, 0xd7, 0x72, 0x42, 0xa2, 0xf2,
+	0x71, 0x63, 0x69, 0xba, 0xe2, 0x19, 0x0a, 0xc2, 0xeb, 0xcc, 0x1e, 0x9e, 0x50, 0xf7, 0x38, 0x45,
+	0xe0, 0x4b, 0xb0, 0x36, 0xae, 0x43, 0x8c, 0x02, 0xc2, 0x8d, 0x77, 0xeb, 0x8b, 0xfb, 0xf7, 0xbe,
+	0xfc, 0xa4, 0x31, 0xe7, 0xba, 0x1a, 0x69, 0x81, 0xcf, 0x46, 0x01, 0x69, 0xed, 0xbd, 0x89, 0xcc,
+	0x85, 0x38, 0x32, 0xb7, 0x93, 0x33, 0xce, 0x49, 0x21, 0x7c, 0x3f, 0xcc, 0x90, 0x39, 0xfc, 0x55,
+	0x1f, 0xaf, 0x1d, 0x04, 0x96, 0x4b, 0x3c, 0x9f, 0x59, 0x7c, 0x10, 0x04, 0xfd, 0x91, 0xb1, 0x5c,
+	0x2f, 0xed, 0xaf, 0xb4, 0x2e, 0xa4, 0xd6, 0x5f, 0x91, 0xf9, 0xb0, 0x4b, 0x45, 0x6f, 0xd0, 0x6e,
+	0x38, 0x3e, 0x6b, 0x26, 0x26, 0xd0, 0x7f, 0x0e, 0xb8, 0xfb, 0xb2, 0xa9, 0x94, 0x1b, 0x67, 0x9e,
+	0xc8, 0x5f, 0xc6, 0xb4, 0x26, 0xc2, 0xf2, 0x84, 0x8e, 0x82, 0xe0, 0x44, 0x82, 0xd7, 0x0a, 0x83,
+	0xdf, 0x82, 0xb5, 0x1c, 0x97, 0x1b, 0xef, 0xa9, 0x33, 0xab, 0x4e, 0x2a, 0xc8, 0xc7, 0x11, 0x5e,
+	0xcd, 0xa8, 0x70, 0xf8, 0x14, 0xc0, 0xdc, 0xcd, 0xb7, 0x6d, 0xe1, 0xf4, 0x8c, 0xf7, 0x95, 0xc8,
+	0x5e, 0x1c, 0x99, 0xd5, 0x02, 0x77, 0x28, 0x0e, 0xc2, 0x1b, 0x19, 0x6f, 0xb4, 0x24, 0x04, 0x7f,
+	0x06, 0x86, 0x24, 0x06, 0xa1, 0xdf, 0xa1, 0x7d, 0x62, 0x75, 0x28, 0xe9, 0xbb, 0x56, 0x9f, 0x78,
+	0x5d, 0xd1, 0x33, 0x56, 0x94, 0xe4, 0x47, 0x71, 0x64, 0x9a, 0x13, 0xc9, 0x22, 0x26, 0xc2, 0xd2,
+	0x5e, 0x57, 0x3a, 0x72, 0x2a, 0x03, 0xe7, 0x0a, 0x87, 0x2f, 0xc0, 0x83, 0xec, 0x9a, 0x3e, 0xe5,
+	0xc2, 0xa2, 0x82, 0x30, 0x6e, 0x00, 0xa5, 0xfd, 0x61, 0x1c, 0x99, 0x7b, 0xb3, 0xda, 0x13, 0x1e,
+	0xc2, 0x5b, 0x13, 0xe5, 0x73, 0xca, 0xc5, 0x99, 0x44, 0xe1, 0x8f, 0xa0, 0xa2, 0xf9, 0xf4, 0x46,
+	0x36, 0x94, 0xf2, 0x6a, 0xb2, 0xe9, 0x7b, 0x4a, 0x18, 0xc5, 0x91, 0x59, 0xcb, 0x0a, 0xcf, 0x10,
+	0x11, 0x2e, 0x2b, 0x65, 0x15, 0x90, 0xb6, 0x4e, 0xb6, 0xfc, 0xba, 0x04, 0x36, 0xb2, 0x7e, 0xb5,
+	0x3a, 0x84, 0x18, 0xab, 0xca, 0x8e, 0xd5, 0x86, 0xb6, 0x40, 0x43, 0x8e, 0x83, 0xb1, 0x15, 0x8f,
+	0x7d, 0xea, 0xb5, 0x9e, 0x26, 0x16, 0xac, 0xe8, 0x9c, 0xd3, 0x02, 0xe8, 0x8f, 0xbf, 0xcd, 0xfd,
+	0xff, 0xe1, 0x28, 0xa9, 0xc5, 0xf1, 0x9a, 0x3b, 0x69, 0x8e, 0x53, 0x42, 0x72, 0xdd, 0xab, 0x8e,
+	0x86, 0x11, 0xd6, 0x26, 0x21, 0x37, 0xee, 0xcf, 0xed, 0xde, 0x2c, 0x6d, 0xd2, 0xbd, 0xf2, 0x00,
+	0x2f, 0x34, 0x08, 0x7f, 0x00, 0x95, 0xd4, 0x66, 0x1d, 0x42, 0xac, 0x6e, 0x68, 0x7b, 0x22, 0xb1,
+	0xd2, 0x5a, 0xd1, 0x11, 0x16, 0x10, 0xf5, 0xe5, 0x1c, 0x05, 0xc1, 0x29, 0x21, 0x8f, 0x25, 0xac,
+	0x2d, 0x95, 0x31, 0xb8, 0xed, 0x32, 0xea, 0x71, 0x63, 0x7d, 0x9e, 0xc1, 0x75, 0x7c, 0x6c, 0xf0,
+	0x23, 0xf5, 0x2f, 0x7c, 0x0c, 0x36, 0x53, 0x02, 0x19, 0x12, 0xc7, 0x62, 0xbc, 0xcb, 0x8d, 0x8d,
+	0xa2, 0xc1, 0x92, 0xa3, 0x20, 0xbc, 0xa6, 0x65, 0x1e, 0x0d, 0x89, 0x73, 0xc1, 0xbb, 0x1c, 0xfe,
+	0x56, 0x02, 0x0f, 0x67, 0xf7, 0x2e, 0x08, 0x0b, 0xfa, 0xd2, 0x0d, 0x9e, 0xcd, 0x48, 0x6a, 0x9b,
+	0x4d, 0x25, 0x7f, 0x18, 0x47, 0xe6, 0xc1, 0xbc, 0x9a, 0x8b, 0xd6, 0x21, 0x5c, 0xcf, 0x1f, 0xc1,
+	0xb3, 0x84, 0x74, 0x69, 0x33, 0x92, 0x38, 0xea, 0x17, 0x50, 0xcd, 0xdf, 0x4b, 0x36, 0x2f, 0x54,
+	0x79, 0x3f, 0x8e, 0x23, 0xb3, 0x5e, 0x74, 0x85, 0xb9, 0x54, 0xdb, 0x99, 0x6b, 0xcc, 0xe8, 0x73,
+	0x50, 0xcf, 0x2f, 0x72, 0x09, 0x77, 0x42, 0x1a, 0x28, 0xf3, 0x25, 0x69, 0xb6, 0x54, 0x9a, 0xcf,
+	0xe3, 0xc8, 0xfc, 0xb4, 0x28, 0xcd, 0xec, 0x0a, 0x84, 0x77, 0x33, 0xd9, 0x4e, 0x26, 0xf1, 0x24,
+	0xa9, 0xa5, 0x8b, 0x72, 0x7c, 0xc6, 0x06, 0x1e, 0x15, 0xa3, 0x5c, 0x51, 0xe5, 0xa2, 0xa2, 0x0a,
+	0xa9, 0x08, 0xcb, 0x01, 0x71, 0x9c, 0x86, 0x32, 0x55, 0x09, 0x50, 0xcf, 0xaf, 0x2a, 0xa8, 0x6a,
+	0xbb, 0xa8, 0xaa, 0xff, 0x5a, 0x81, 0xf0, 0x5e, 0x36, 0xdd, 0x6c, 0x59, 0x49, 0xab, 0x79, 0x1d,
+	0x61, 0x71, 0xa7, 0x47, 0x98, 0x9d, 0xa6, 0x7a, 0x50, 0xd4, 0x6a, 0x33, 0x34, 0xdd, 0x6a, 0x97,
+	0x1d, 0x71, 0xad, 0xd0, 0x44, 0xf4, 0x0a, 0x94, 0xa7, 0xd8, 0x2e, 0x09, 0x44, 0xcf, 0xa8, 0x14,
+	0xfd, 0xa0, 0x4f, 0xb3, 0xf4, 0x6f, 0xc8, 0x58, 0xf2, 0x84, 0x04, 0xf9, 0x6d, 0x7a, 0x03, 0xd9,
+	0xcf, 0xe9, 0x36, 0x8d, 0x79, 0xdb, 0xcc, 0xd1, 0xc6, 0xdb, 0xbc, 0x54, 0x68, 0xb2, 0xcd, 0x64,
+	0xa8, 0x66, 0xd8, 0x64, 0x18, 0xf8, 0x1e, 0xf1, 0x84, 0x51, 0x2d, 0x9a, 0x08, 0x05, 0x44, 0x3d,
+	0x54, 0xc7, 0xc2, 0x8f, 0x12, 0x18, 0x8e, 0xc0, 0xb8, 0x9f, 0x6e, 0x48, 0x48, 0x3b, 0xd4, 0xd1,
+	0xa3, 0x31, 0x24, 0x36, 0x9f, 0x5c, 0xe7, 0x8e, 0xca, 0x72, 0x10, 0x47, 0xe6, 0x67, 0xf9, 0x1e,
+	0x9c, 0xbf, 0x06, 0xe1, 0x9a, 0xee, 0xbf, 0x17, 0x19, 0x0a, 0x56, 0x0c, 0x5d, 0x15, 0xfa, 0x1a,
+	0xac, 0x66, 0x1f, 0x0b, 0x70, 0x03, 0x2c, 0xb6, 0xa9, 0xd0, 0x2f, 0x33, 0x2c, 0x3f, 0x21, 0x04,
+	0x4b, 0xd2, 0x91, 0xea, 0x7d, 0xb5, 0x82, 0xd5, 0x77, 0xeb, 0xf4, 0xcd, 0x6d, 0xad, 0xf4, 0xf6,
+	0xb6, 0x56, 0xfa, 0xe7, 0xb6, 0x56, 0x7a, 0x7d, 0x57, 0x5b, 0x78, 0x7b, 0x57, 0x5b, 0xf8, 0xf3,
+	0xae, 0xb6, 0xf0, 0xd3, 0x17, 0x99, 0x31, 0xae, 0x5e, 0x25, 0x07, 0xd4, 0x4d, 0x3e, 0x04, 0x6b,
+	0x0e, 0x9b, 0xe9, 0xf3, 0x53, 0x0d, 0xf4, 0xf6, 0xb2, 0x7a, 0x27, 0x7e, 0xf5, 0xef, 0x00, 0xac,
+	0x7d, 0x1b, 0xae, 0x96, 0x0a, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAppVerificationReasonLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppVerificationReasonLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxNftNumberExponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNftNumberExponent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MaxNftNumberLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNftNumberLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxNftSchemaDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNftSchemaDepth))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaxNftSchemaLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNftSchemaLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxCommunityDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCommunityDescriptionLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxCommunityNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCommunityNameLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxUserListDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUserListDescriptionLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxUserListNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUserListNameLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxAppFeeGrantTemplateNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppFeeGrantTemplateNameLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxAppExecMsgs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppExecMsgs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxAppAdmins != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppAdmins))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxAppFeeGrantBatch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppFeeGrantBatch))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxUserListMembers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUserListMembers))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DidCreationFee) > 0 {
+		for iNdEx := len(m.DidCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxPrivateInfoLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrivateInfoLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxProfileListItems != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProfileListItems))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxProfileFieldLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProfileFieldLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxRelationBatch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRelationBatch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxAppDenoms != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppDenoms))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxAppDenomSupply.Size()
+		i -= size
+		if _, err := m.MaxAppDenomSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.RelationTypes) > 0 {
+		for iNdEx := len(m.RelationTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelationTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxDidCreations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDidCreations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUserInfoUpdates != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUserInfoUpdates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxRelationUpdates != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRelationUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RateLimitWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RateLimitWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelationType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Bit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Bit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateLimitWindow != 0 {
+		n += 1 + sovParams(uint64(m.RateLimitWindow))
+	}
+	if m.MaxRelationUpdates != 0 {
+		n += 1 + sovParams(uint64(m.MaxRelationUpdates))
+	}
+	if m.MaxUserInfoUpdates != 0 {
+		n += 1 + sovParams(uint64(m.MaxUserInfoUpdates))
+	}
+	if m.MaxDidCreations != 0 {
+		n += 1 + sovParams(uint64(m.MaxDidCreations))
+	}
+	if len(m.RelationTypes) > 0 {
+		for _, e := range m.RelationTypes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxAppDenomSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxAppDenoms != 0 {
+		n += 1 + sovParams(uint64(m.MaxAppDenoms))
+	}
+	if m.MaxRelationBatch != 0 {
+		n += 1 + sovParams(uint64(m.MaxRelationBatch))
+	}
+	if m.MaxProfileFieldLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxProfileFieldLength))
+	}
+	if m.MaxProfileListItems != 0 {
+		n += 1 + sovParams(uint64(m.MaxProfileListItems))
+	}
+	if m.MaxPrivateInfoLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrivateInfoLength))
+	}
+	if len(m.DidCreationFee) > 0 {
+		for _, e := range m.DidCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxUserListMembers != 0 {
+		n += 1 + sovParams(uint64(m.MaxUserListMembers))
+	}
+	if m.MaxAppFeeGrantBatch != 0 {
+		n += 1 + sovParams(uint64(m.MaxAppFeeGrantBatch))
+	}
+	if m.MaxAppAdmins != 0 {
+		n += 1 + sovParams(uint64(m.MaxAppAdmins))
+	}
+	if m.MaxAppExecMsgs != 0 {
+		n += 2 + sovParams(uint64(m.MaxAppExecMsgs))
+	}
+	if m.MaxAppFeeGrantTemplateNameLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxAppFeeGrantTemplateNameLength))
+	}
+	if m.MaxUserListNameLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxUserListNameLength))
+	}
+	if m.MaxUserListDescriptionLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxUserListDescriptionLength))
+	}
+	if m.MaxCommunityNameLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxCommunityNameLength))
+	}
+	if m.MaxCommunityDescriptionLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxCommunityDescriptionLength))
+	}
+	if m.MaxNftSchemaLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxNftSchemaLength))
+	}
+	if m.MaxNftSchemaDepth != 0 {
+		n += 2 + sovParams(uint64(m.MaxNftSchemaDepth))
+	}
+	if m.MaxNftNumberLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxNftNumberLength))
+	}
+	if m.MaxNftNumberExponent != 0 {
+		n += 2 + sovParams(uint64(m.MaxNftNumberExponent))
+	}
+	if m.MaxAppVerificationReasonLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxAppVerificationReasonLength))
+	}
+	return n
+}
+
+func (m *RelationType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bit != 0 {
+		n += 1 + sovParams(uint64(m.Bit))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			m.RateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelationUpdates", wireType)
+			}
+			m.MaxRelationUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelationUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUserInfoUpdates", wireType)
+			}
+			m.MaxUserInfoUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUserInfoUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDidCreations", wireType)
+			}
+			m.MaxDidCreations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDidCreations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationTypes = append(m.RelationTypes, RelationType{})
+			if err := m.RelationTypes[len(m.RelationTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppDenomSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAppDenomSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppDenoms", wireType)
+			}
+			m.MaxAppDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppDenoms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelationBatch", wireType)
+			}
+			m.MaxRelationBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelationBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProfileFieldLength", wireType)
+			}
+			m.MaxProfileFieldLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProfileFieldLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProfileListItems", wireType)
+			}
+			m.MaxProfileListItems = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProfileListItems |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrivateInfoLength", wireType)
+			}
+			m.MaxPrivateInfoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrivateInfoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidCreationFee = append(m.DidCreationFee, types.Coin{})
+			if err := m.DidCreationFee[len(m.DidCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUserListMembers", wireType)
+			}
+			m.MaxUserListMembers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUserListMembers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppFeeGrantBatch", wireType)
+			}
+			m.MaxAppFeeGrantBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppFeeGrantBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppAdmins", wireType)
+			}
+			m.MaxAppAdmins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppAdmins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppExecMsgs", wireType)
+			}
+			m.MaxAppExecMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppExecMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppFeeGrantTemplateNameLength", wireType)
+			}
+			m.MaxAppFeeGrantTemplateNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppFeeGrantTemplateNameLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUserListNameLength", wireType)
+			}
+			m.MaxUserListNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUserListNameLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUserListDescriptionLength", wireType)
+			}
+			m.MaxUserListDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUserListDescriptionLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommunityNameLength", wireType)
+			}
+			m.MaxCommunityNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommunityNameLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommunityDescriptionLength", wireType)
+			}
+			m.MaxCommunityDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommunityDescriptionLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNftSchemaLength", wireType)
+			}
+			m.MaxNftSchemaLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNftSchemaLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNftSchemaDepth", wireType)
+			}
+			m.MaxNftSchemaDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNftSchemaDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNftNumberLength", wireType)
+			}
+			m.MaxNftNumberLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNftNumberLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNftNumberExponent", wireType)
+			}
+			m.MaxNftNumberExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNftNumberExponent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppVerificationReasonLength", wireType)
+			}
+			m.MaxAppVerificationReasonLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppVerificationReasonLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bit", wireType)
+			}
+			m.Bit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
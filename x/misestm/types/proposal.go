@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAppVerification defines the type for a AppVerificationProposal
+	ProposalTypeAppVerification = "AppVerification"
+)
+
+var _ govtypes.Content = &AppVerificationProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAppVerification)
+	govtypes.RegisterProposalTypeCodec(&AppVerificationProposal{}, "misestm/AppVerificationProposal")
+}
+
+// NewAppVerificationProposal creates a new AppVerificationProposal
+func NewAppVerificationProposal(title, description, appid string, verified bool, reason string) *AppVerificationProposal {
+	return &AppVerificationProposal{
+		Title:       title,
+		Description: description,
+		Appid:       appid,
+		Verified:    verified,
+		Reason:      reason,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *AppVerificationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *AppVerificationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *AppVerificationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AppVerificationProposal) ProposalType() string { return ProposalTypeAppVerification }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AppVerificationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, ok := CheckDid(p.Appid, DIDTypeApp); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid app id %s", p.Appid)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p AppVerificationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`App Verification Proposal:
+  Title:       %s
+  Description: %s
+  App:         %s
+  Verified:    %t
+  Reason:      %s
+`, p.Title, p.Description, p.Appid, p.Verified, p.Reason))
+	return b.String()
+}
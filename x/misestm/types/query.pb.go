@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -766,1261 +769,9019 @@ func (m *QueryAllDidRegistryResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetUserInfoRequest)(nil), "misesid.misestm.v1beta1.QueryGetUserInfoRequest")
-	proto.RegisterType((*QueryGetUserInfoResponse)(nil), "misesid.misestm.v1beta1.QueryGetUserInfoResponse")
-	proto.RegisterType((*QueryAllUserInfoRequest)(nil), "misesid.misestm.v1beta1.QueryAllUserInfoRequest")
-	proto.RegisterType((*QueryAllUserInfoResponse)(nil), "misesid.misestm.v1beta1.QueryAllUserInfoResponse")
-	proto.RegisterType((*QueryGetUserRelationRequest)(nil), "misesid.misestm.v1beta1.QueryGetUserRelationRequest")
-	proto.RegisterType((*QueryGetUserRelationResponse)(nil), "misesid.misestm.v1beta1.QueryGetUserRelationResponse")
-	proto.RegisterType((*QueryAllUserRelationRequest)(nil), "misesid.misestm.v1beta1.QueryAllUserRelationRequest")
-	proto.RegisterType((*QueryAllUserRelationResponse)(nil), "misesid.misestm.v1beta1.QueryAllUserRelationResponse")
-	proto.RegisterType((*QueryGetAppInfoRequest)(nil), "misesid.misestm.v1beta1.QueryGetAppInfoRequest")
-	proto.RegisterType((*QueryGetAppInfoResponse)(nil), "misesid.misestm.v1beta1.QueryGetAppInfoResponse")
-	proto.RegisterType((*QueryAllAppInfoRequest)(nil), "misesid.misestm.v1beta1.QueryAllAppInfoRequest")
-	proto.RegisterType((*QueryAllAppInfoResponse)(nil), "misesid.misestm.v1beta1.QueryAllAppInfoResponse")
-	proto.RegisterType((*QueryGetDidRegistryRequest)(nil), "misesid.misestm.v1beta1.QueryGetDidRegistryRequest")
-	proto.RegisterType((*QueryGetDidRegistryResponse)(nil), "misesid.misestm.v1beta1.QueryGetDidRegistryResponse")
-	proto.RegisterType((*QueryAllDidRegistryRequest)(nil), "misesid.misestm.v1beta1.QueryAllDidRegistryRequest")
-	proto.RegisterType((*QueryAllDidRegistryResponse)(nil), "misesid.misestm.v1beta1.QueryAllDidRegistryResponse")
+type QueryGetUserListRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("misestm/v1beta1/query.proto", fileDescriptor_e67823a03eb7be29) }
-
-var fileDescriptor_e67823a03eb7be29 = []byte{
-	// 757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x3b, 0xbb, 0xbf, 0x9f, 0xca, 0x54, 0x2a, 0xcc, 0xc1, 0x96, 0xb4, 0x86, 0x76, 0xec,
-	0x7f, 0xbb, 0x49, 0xdb, 0xad, 0x17, 0xc1, 0xc3, 0x8a, 0xb4, 0xf4, 0xa6, 0x0b, 0x3d, 0xe8, 0xc9,
-	0x6c, 0x33, 0xae, 0x03, 0xd9, 0x4d, 0xda, 0xa4, 0x62, 0x11, 0x2f, 0xbe, 0x02, 0xc1, 0x9b, 0x48,
-	0x11, 0x8f, 0x22, 0x45, 0x7c, 0x13, 0x7a, 0x2c, 0x78, 0xf1, 0x28, 0xad, 0x2f, 0x44, 0x76, 0xf2,
-	0x4c, 0x76, 0x92, 0xdd, 0x6c, 0x92, 0x92, 0xd3, 0xc2, 0x93, 0xe7, 0xfb, 0xcc, 0xe7, 0xf9, 0x3e,
-	0xcc, 0x33, 0x2c, 0x9e, 0xee, 0x70, 0x9f, 0xf9, 0x41, 0xc7, 0x7c, 0xb9, 0xd1, 0x62, 0x81, 0xb5,
-	0x61, 0x1e, 0x1c, 0xb1, 0xc3, 0x63, 0xc3, 0x3b, 0x74, 0x03, 0x97, 0x4c, 0x8a, 0x8f, 0xdc, 0x36,
-	0x20, 0xc9, 0x80, 0x24, 0x6d, 0xa6, 0xed, 0xba, 0x6d, 0x87, 0x99, 0x96, 0xc7, 0x4d, 0xab, 0xdb,
-	0x75, 0x03, 0x2b, 0xe0, 0x6e, 0xd7, 0x0f, 0x65, 0xda, 0xea, 0xbe, 0xeb, 0x77, 0x5c, 0xdf, 0x6c,
-	0x59, 0x3e, 0x0b, 0xeb, 0x45, 0xd5, 0x3d, 0xab, 0xcd, 0xbb, 0x22, 0x19, 0x72, 0xf5, 0xe4, 0xf9,
-	0x7b, 0x3e, 0x3b, 0xdc, 0xed, 0x3e, 0x77, 0xe1, 0x3b, 0x1d, 0xf6, 0xbd, 0xc9, 0x1c, 0xb5, 0xc6,
-	0xad, 0x64, 0x4e, 0xc3, 0xf3, 0x94, 0x12, 0x73, 0xc9, 0xcf, 0x0f, 0xb9, 0xdd, 0x64, 0x6d, 0xee,
-	0x07, 0xb2, 0x51, 0xba, 0x82, 0x27, 0x1f, 0xf7, 0x38, 0x77, 0x58, 0x20, 0xcf, 0x6f, 0xb2, 0x83,
-	0x23, 0xe6, 0x07, 0x64, 0x02, 0x57, 0xb8, 0x3d, 0x85, 0x66, 0xd1, 0xf2, 0x7f, 0xcd, 0x0a, 0xb7,
-	0xe9, 0x13, 0x3c, 0x35, 0x98, 0xea, 0x7b, 0x6e, 0xd7, 0x67, 0xe4, 0x3e, 0xbe, 0x26, 0x63, 0x42,
-	0x31, 0xbe, 0x39, 0x67, 0xa4, 0x58, 0x68, 0x44, 0xe2, 0x48, 0x42, 0x2d, 0xa0, 0x68, 0x38, 0x4e,
-	0x92, 0x62, 0x1b, 0xe3, 0xbe, 0x75, 0x50, 0x7b, 0xd1, 0x08, 0x7d, 0x36, 0x7a, 0x3e, 0x1b, 0xe1,
-	0xdc, 0x64, 0xf5, 0x47, 0x56, 0x9b, 0x81, 0xb6, 0xa9, 0x28, 0xe9, 0x67, 0x04, 0xf8, 0xb1, 0x33,
-	0x86, 0xe2, 0x57, 0x0b, 0xe2, 0x93, 0x9d, 0x18, 0x63, 0x45, 0x30, 0x2e, 0x65, 0x32, 0x86, 0x67,
-	0xc7, 0x20, 0x6b, 0x78, 0x5a, 0xb5, 0x58, 0x4e, 0x3b, 0x6d, 0x22, 0x1c, 0xcf, 0x0c, 0x4f, 0x87,
-	0xb6, 0x76, 0xf1, 0x75, 0x35, 0x0e, 0xee, 0x2d, 0x8c, 0x6c, 0x2d, 0x2a, 0x12, 0x93, 0x52, 0x06,
-	0x64, 0xe0, 0x5e, 0x92, 0xac, 0xac, 0x29, 0x7d, 0x47, 0xd0, 0xd2, 0xc0, 0x39, 0xa9, 0x2d, 0x55,
-	0x2f, 0xd9, 0x52, 0x79, 0x53, 0x5b, 0xc6, 0x37, 0xe5, 0x18, 0xe0, 0xfe, 0xa5, 0x0d, 0x6c, 0xaf,
-	0x7f, 0xdb, 0xa2, 0x4c, 0x68, 0xec, 0x1e, 0xbe, 0x0a, 0x21, 0xb0, 0x6f, 0x36, 0xb5, 0x27, 0x29,
-	0x95, 0x02, 0xfa, 0x0c, 0x00, 0x1a, 0x8e, 0x93, 0x00, 0x28, 0x6b, 0x2e, 0x27, 0xa8, 0x7f, 0x43,
-	0x47, 0x92, 0x57, 0x0b, 0x91, 0x97, 0x37, 0x83, 0x35, 0xac, 0x49, 0x67, 0x95, 0x25, 0x97, 0x36,
-	0x07, 0xd6, 0xbf, 0x67, 0xb1, 0x6c, 0xe8, 0x68, 0x1b, 0x8f, 0x2b, 0x61, 0xb0, 0x6d, 0x3e, 0xb5,
-	0x2b, 0xb5, 0x84, 0x2a, 0xa4, 0x36, 0x40, 0x35, 0x1c, 0x67, 0x08, 0x54, 0x59, 0xb3, 0x39, 0x45,
-	0xfd, 0xbb, 0x99, 0xab, 0x9b, 0xea, 0xa5, 0xba, 0x29, 0x6d, 0x56, 0x9b, 0x3f, 0x30, 0xfe, 0x5f,
-	0x00, 0x93, 0x4f, 0xa8, 0xbf, 0x78, 0xc9, 0x7a, 0x2a, 0x52, 0xca, 0x0b, 0xa5, 0x6d, 0x14, 0x50,
-	0x84, 0x1c, 0xd4, 0x7c, 0xfb, 0xeb, 0xef, 0xfb, 0xca, 0x0a, 0x59, 0x32, 0x85, 0xa4, 0xc6, 0x6d,
-	0x53, 0x3e, 0x92, 0xf2, 0x57, 0x6a, 0xcc, 0xd7, 0xdc, 0x7e, 0x43, 0x4e, 0x10, 0x1e, 0x97, 0x91,
-	0x86, 0xe3, 0x64, 0x51, 0x0e, 0xbe, 0x60, 0x59, 0x94, 0x43, 0xde, 0x23, 0xba, 0x2a, 0x28, 0xe7,
-	0x09, 0xcd, 0xa6, 0x24, 0xdf, 0x50, 0x7c, 0x25, 0x92, 0xad, 0x5c, 0xae, 0x24, 0x36, 0xb8, 0x76,
-	0xb7, 0xa0, 0x0a, 0x48, 0xeb, 0x82, 0xb4, 0x46, 0xee, 0x8c, 0x26, 0x95, 0xba, 0xd0, 0xd3, 0x53,
-	0x84, 0x6f, 0xa8, 0xd1, 0x9e, 0xaf, 0x5b, 0xb9, 0x5c, 0x2a, 0x48, 0x9d, 0xf2, 0x8a, 0x50, 0x43,
-	0x50, 0x2f, 0x93, 0xc5, 0x7c, 0xd4, 0xe4, 0x23, 0x8a, 0x76, 0x1c, 0x31, 0x33, 0x8d, 0x8a, 0xef,
-	0x60, 0x6d, 0x3d, 0xbf, 0x20, 0x3f, 0x1e, 0x48, 0x42, 0x3f, 0x3f, 0x20, 0x8c, 0x21, 0xd0, 0xb3,
-	0xd2, 0xcc, 0x34, 0xa5, 0x18, 0xe1, 0xe0, 0xce, 0xa7, 0x2b, 0x82, 0xf0, 0x36, 0x99, 0xcb, 0x24,
-	0x24, 0x5f, 0x51, 0x6c, 0xff, 0x90, 0x7a, 0xa6, 0x1d, 0x83, 0xbb, 0x52, 0xdb, 0x2a, 0x26, 0x02,
-	0xca, 0x4d, 0x41, 0xb9, 0x46, 0x56, 0xd3, 0x29, 0x15, 0x59, 0xe8, 0xe5, 0x17, 0x84, 0x27, 0x94,
-	0x60, 0xcf, 0xcf, 0x7a, 0xa6, 0x3d, 0xc5, 0x89, 0x87, 0xef, 0x6a, 0x5a, 0x13, 0xc4, 0x4b, 0x64,
-	0x21, 0x17, 0xf1, 0x83, 0xed, 0x9f, 0xe7, 0x3a, 0x3a, 0x3b, 0xd7, 0xd1, 0x9f, 0x73, 0x1d, 0xbd,
-	0xbb, 0xd0, 0xc7, 0xce, 0x2e, 0xf4, 0xb1, 0xdf, 0x17, 0xfa, 0xd8, 0xd3, 0xb5, 0x36, 0x0f, 0x5e,
-	0x1c, 0xb5, 0x8c, 0x7d, 0xb7, 0x93, 0x28, 0x55, 0x0b, 0x3a, 0xe6, 0xab, 0xa8, 0x5a, 0x70, 0xec,
-	0x31, 0xbf, 0x75, 0x45, 0xfc, 0x19, 0xa8, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xa9, 0x34,
-	0x95, 0x14, 0x0d, 0x00, 0x00,
+func (m *QueryGetUserListRequest) Reset()         { *m = QueryGetUserListRequest{} }
+func (m *QueryGetUserListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUserListRequest) ProtoMessage()    {}
+func (*QueryGetUserListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{16}
+}
+func (m *QueryGetUserListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUserListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUserListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetUserListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUserListRequest.Merge(m, src)
+}
+func (m *QueryGetUserListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUserListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUserListRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetUserListRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a UserInfo by id.
-	UserInfo(ctx context.Context, in *QueryGetUserInfoRequest, opts ...grpc.CallOption) (*QueryGetUserInfoResponse, error)
-	// Queries a list of UserInfo items.
-	UserInfoAll(ctx context.Context, in *QueryAllUserInfoRequest, opts ...grpc.CallOption) (*QueryAllUserInfoResponse, error)
-	// Queries a UserRelation by id.
-	UserRelation(ctx context.Context, in *QueryGetUserRelationRequest, opts ...grpc.CallOption) (*QueryGetUserRelationResponse, error)
-	// Queries a list of UserRelation items.
-	UserRelationAll(ctx context.Context, in *QueryAllUserRelationRequest, opts ...grpc.CallOption) (*QueryAllUserRelationResponse, error)
-	// Queries a AppInfo by id.
-	AppInfo(ctx context.Context, in *QueryGetAppInfoRequest, opts ...grpc.CallOption) (*QueryGetAppInfoResponse, error)
-	// Queries a list of AppInfo items.
-	AppInfoAll(ctx context.Context, in *QueryAllAppInfoRequest, opts ...grpc.CallOption) (*QueryAllAppInfoResponse, error)
-	// Queries a DidRegistry by id.
-	DidRegistry(ctx context.Context, in *QueryGetDidRegistryRequest, opts ...grpc.CallOption) (*QueryGetDidRegistryResponse, error)
-	// Queries a list of DidRegistry items.
-	DidRegistryAll(ctx context.Context, in *QueryAllDidRegistryRequest, opts ...grpc.CallOption) (*QueryAllDidRegistryResponse, error)
+func (m *QueryGetUserListRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetUserListResponse struct {
+	UserList *UserList `protobuf:"bytes,1,opt,name=UserList,proto3" json:"UserList,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetUserListResponse) Reset()         { *m = QueryGetUserListResponse{} }
+func (m *QueryGetUserListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUserListResponse) ProtoMessage()    {}
+func (*QueryGetUserListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{17}
 }
-
-func (c *queryClient) UserInfo(ctx context.Context, in *QueryGetUserInfoRequest, opts ...grpc.CallOption) (*QueryGetUserInfoResponse, error) {
-	out := new(QueryGetUserInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetUserListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUserListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUserListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetUserListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUserListResponse.Merge(m, src)
+}
+func (m *QueryGetUserListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUserListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUserListResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserInfoAll(ctx context.Context, in *QueryAllUserInfoRequest, opts ...grpc.CallOption) (*QueryAllUserInfoResponse, error) {
-	out := new(QueryAllUserInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetUserListResponse proto.InternalMessageInfo
+
+func (m *QueryGetUserListResponse) GetUserList() *UserList {
+	if m != nil {
+		return m.UserList
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UserRelation(ctx context.Context, in *QueryGetUserRelationRequest, opts ...grpc.CallOption) (*QueryGetUserRelationResponse, error) {
-	out := new(QueryGetUserRelationResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserRelation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllUserListRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) UserRelationAll(ctx context.Context, in *QueryAllUserRelationRequest, opts ...grpc.CallOption) (*QueryAllUserRelationResponse, error) {
-	out := new(QueryAllUserRelationResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserRelationAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllUserListRequest) Reset()         { *m = QueryAllUserListRequest{} }
+func (m *QueryAllUserListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllUserListRequest) ProtoMessage()    {}
+func (*QueryAllUserListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{18}
+}
+func (m *QueryAllUserListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllUserListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllUserListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllUserListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllUserListRequest.Merge(m, src)
+}
+func (m *QueryAllUserListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllUserListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllUserListRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AppInfo(ctx context.Context, in *QueryGetAppInfoRequest, opts ...grpc.CallOption) (*QueryGetAppInfoResponse, error) {
-	out := new(QueryGetAppInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllUserListRequest proto.InternalMessageInfo
+
+func (m *QueryAllUserListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AppInfoAll(ctx context.Context, in *QueryAllAppInfoRequest, opts ...grpc.CallOption) (*QueryAllAppInfoResponse, error) {
-	out := new(QueryAllAppInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllUserListResponse struct {
+	UserList   []*UserList         `protobuf:"bytes,1,rep,name=UserList,proto3" json:"UserList,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DidRegistry(ctx context.Context, in *QueryGetDidRegistryRequest, opts ...grpc.CallOption) (*QueryGetDidRegistryResponse, error) {
-	out := new(QueryGetDidRegistryResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/DidRegistry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllUserListResponse) Reset()         { *m = QueryAllUserListResponse{} }
+func (m *QueryAllUserListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllUserListResponse) ProtoMessage()    {}
+func (*QueryAllUserListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{19}
+}
+func (m *QueryAllUserListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllUserListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllUserListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllUserListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllUserListResponse.Merge(m, src)
+}
+func (m *QueryAllUserListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllUserListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllUserListResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DidRegistryAll(ctx context.Context, in *QueryAllDidRegistryRequest, opts ...grpc.CallOption) (*QueryAllDidRegistryResponse, error) {
-	out := new(QueryAllDidRegistryResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/DidRegistryAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllUserListResponse proto.InternalMessageInfo
+
+func (m *QueryAllUserListResponse) GetUserList() []*UserList {
+	if m != nil {
+		return m.UserList
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a UserInfo by id.
-	UserInfo(context.Context, *QueryGetUserInfoRequest) (*QueryGetUserInfoResponse, error)
-	// Queries a list of UserInfo items.
-	UserInfoAll(context.Context, *QueryAllUserInfoRequest) (*QueryAllUserInfoResponse, error)
-	// Queries a UserRelation by id.
-	UserRelation(context.Context, *QueryGetUserRelationRequest) (*QueryGetUserRelationResponse, error)
-	// Queries a list of UserRelation items.
-	UserRelationAll(context.Context, *QueryAllUserRelationRequest) (*QueryAllUserRelationResponse, error)
-	// Queries a AppInfo by id.
-	AppInfo(context.Context, *QueryGetAppInfoRequest) (*QueryGetAppInfoResponse, error)
-	// Queries a list of AppInfo items.
-	AppInfoAll(context.Context, *QueryAllAppInfoRequest) (*QueryAllAppInfoResponse, error)
-	// Queries a DidRegistry by id.
-	DidRegistry(context.Context, *QueryGetDidRegistryRequest) (*QueryGetDidRegistryResponse, error)
-	// Queries a list of DidRegistry items.
-	DidRegistryAll(context.Context, *QueryAllDidRegistryRequest) (*QueryAllDidRegistryResponse, error)
+func (m *QueryAllUserListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryUserListsByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) UserInfo(ctx context.Context, req *QueryGetUserInfoRequest) (*QueryGetUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
-}
-func (*UnimplementedQueryServer) UserInfoAll(ctx context.Context, req *QueryAllUserInfoRequest) (*QueryAllUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserInfoAll not implemented")
+func (m *QueryUserListsByOwnerRequest) Reset()         { *m = QueryUserListsByOwnerRequest{} }
+func (m *QueryUserListsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserListsByOwnerRequest) ProtoMessage()    {}
+func (*QueryUserListsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{20}
 }
-func (*UnimplementedQueryServer) UserRelation(ctx context.Context, req *QueryGetUserRelationRequest) (*QueryGetUserRelationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserRelation not implemented")
-}
-func (*UnimplementedQueryServer) UserRelationAll(ctx context.Context, req *QueryAllUserRelationRequest) (*QueryAllUserRelationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserRelationAll not implemented")
+func (m *QueryUserListsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AppInfo(ctx context.Context, req *QueryGetAppInfoRequest) (*QueryGetAppInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppInfo not implemented")
+func (m *QueryUserListsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserListsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AppInfoAll(ctx context.Context, req *QueryAllAppInfoRequest) (*QueryAllAppInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppInfoAll not implemented")
+func (m *QueryUserListsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserListsByOwnerRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DidRegistry(ctx context.Context, req *QueryGetDidRegistryRequest) (*QueryGetDidRegistryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidRegistry not implemented")
+func (m *QueryUserListsByOwnerRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DidRegistryAll(ctx context.Context, req *QueryAllDidRegistryRequest) (*QueryAllDidRegistryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidRegistryAll not implemented")
+func (m *QueryUserListsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserListsByOwnerRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryUserListsByOwnerRequest proto.InternalMessageInfo
 
-func _Query_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetUserInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/UserInfo",
+func (m *QueryUserListsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserInfo(ctx, req.(*QueryGetUserInfoRequest))
+	return ""
+}
+
+func (m *QueryUserListsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UserInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllUserInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserInfoAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/UserInfoAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserInfoAll(ctx, req.(*QueryAllUserInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryUserListsByOwnerResponse struct {
+	UserList   []*UserList         `protobuf:"bytes,1,rep,name=UserList,proto3" json:"UserList,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_UserRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetUserRelationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserRelation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/UserRelation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserRelation(ctx, req.(*QueryGetUserRelationRequest))
+func (m *QueryUserListsByOwnerResponse) Reset()         { *m = QueryUserListsByOwnerResponse{} }
+func (m *QueryUserListsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserListsByOwnerResponse) ProtoMessage()    {}
+func (*QueryUserListsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{21}
+}
+func (m *QueryUserListsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserListsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserListsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUserListsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserListsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryUserListsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserListsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserListsByOwnerResponse.DiscardUnknown(m)
 }
 
-func _Query_UserRelationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllUserRelationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserRelationAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/UserRelationAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserRelationAll(ctx, req.(*QueryAllUserRelationRequest))
+var xxx_messageInfo_QueryUserListsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryUserListsByOwnerResponse) GetUserList() []*UserList {
+	if m != nil {
+		return m.UserList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AppInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAppInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AppInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/AppInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AppInfo(ctx, req.(*QueryGetAppInfoRequest))
+func (m *QueryUserListsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AppInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAppInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AppInfoAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/AppInfoAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AppInfoAll(ctx, req.(*QueryAllAppInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryUserListsByMemberRequest struct {
+	Member     string             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DidRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDidRegistryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidRegistry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/DidRegistry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidRegistry(ctx, req.(*QueryGetDidRegistryRequest))
+func (m *QueryUserListsByMemberRequest) Reset()         { *m = QueryUserListsByMemberRequest{} }
+func (m *QueryUserListsByMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserListsByMemberRequest) ProtoMessage()    {}
+func (*QueryUserListsByMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{22}
+}
+func (m *QueryUserListsByMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserListsByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserListsByMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUserListsByMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserListsByMemberRequest.Merge(m, src)
+}
+func (m *QueryUserListsByMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserListsByMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserListsByMemberRequest.DiscardUnknown(m)
 }
 
-func _Query_DidRegistryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDidRegistryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidRegistryAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Query/DidRegistryAll",
+var xxx_messageInfo_QueryUserListsByMemberRequest proto.InternalMessageInfo
+
+func (m *QueryUserListsByMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidRegistryAll(ctx, req.(*QueryAllDidRegistryRequest))
+	return ""
+}
+
+func (m *QueryUserListsByMemberRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "misesid.misestm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UserInfo",
-			Handler:    _Query_UserInfo_Handler,
-		},
-		{
-			MethodName: "UserInfoAll",
-			Handler:    _Query_UserInfoAll_Handler,
-		},
-		{
-			MethodName: "UserRelation",
-			Handler:    _Query_UserRelation_Handler,
-		},
-		{
-			MethodName: "UserRelationAll",
-			Handler:    _Query_UserRelationAll_Handler,
-		},
-		{
-			MethodName: "AppInfo",
-			Handler:    _Query_AppInfo_Handler,
-		},
-		{
-			MethodName: "AppInfoAll",
-			Handler:    _Query_AppInfoAll_Handler,
-		},
-		{
-			MethodName: "DidRegistry",
-			Handler:    _Query_DidRegistry_Handler,
-		},
-		{
-			MethodName: "DidRegistryAll",
-			Handler:    _Query_DidRegistryAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "misestm/v1beta1/query.proto",
+type QueryUserListsByMemberResponse struct {
+	UserList   []*UserList         `protobuf:"bytes,1,rep,name=UserList,proto3" json:"UserList,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetUserInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserListsByMemberResponse) Reset()         { *m = QueryUserListsByMemberResponse{} }
+func (m *QueryUserListsByMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserListsByMemberResponse) ProtoMessage()    {}
+func (*QueryUserListsByMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{23}
+}
+func (m *QueryUserListsByMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserListsByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserListsByMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserListsByMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserListsByMemberResponse.Merge(m, src)
+}
+func (m *QueryUserListsByMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserListsByMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserListsByMemberResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryUserListsByMemberResponse proto.InternalMessageInfo
+
+func (m *QueryUserListsByMemberResponse) GetUserList() []*UserList {
+	if m != nil {
+		return m.UserList
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetUserInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserListsByMemberResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetCommunityRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserInfo != nil {
-		{
-			size, err := m.UserInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetCommunityRequest) Reset()         { *m = QueryGetCommunityRequest{} }
+func (m *QueryGetCommunityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommunityRequest) ProtoMessage()    {}
+func (*QueryGetCommunityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{24}
+}
+func (m *QueryGetCommunityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCommunityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommunityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCommunityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommunityRequest.Merge(m, src)
+}
+func (m *QueryGetCommunityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCommunityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommunityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllUserInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCommunityRequest proto.InternalMessageInfo
+
+func (m *QueryGetCommunityRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetCommunityResponse struct {
+	Community *Community `protobuf:"bytes,1,opt,name=Community,proto3" json:"Community,omitempty"`
 }
 
-func (m *QueryAllUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetCommunityResponse) Reset()         { *m = QueryGetCommunityResponse{} }
+func (m *QueryGetCommunityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommunityResponse) ProtoMessage()    {}
+func (*QueryGetCommunityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{25}
+}
+func (m *QueryGetCommunityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCommunityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommunityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCommunityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommunityResponse.Merge(m, src)
+}
+func (m *QueryGetCommunityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCommunityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommunityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllUserInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCommunityResponse proto.InternalMessageInfo
+
+func (m *QueryGetCommunityResponse) GetCommunity() *Community {
+	if m != nil {
+		return m.Community
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllCommunityRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UserInfo) > 0 {
-		for iNdEx := len(m.UserInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllCommunityRequest) Reset()         { *m = QueryAllCommunityRequest{} }
+func (m *QueryAllCommunityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCommunityRequest) ProtoMessage()    {}
+func (*QueryAllCommunityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{26}
+}
+func (m *QueryAllCommunityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCommunityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCommunityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllCommunityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCommunityRequest.Merge(m, src)
+}
+func (m *QueryAllCommunityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCommunityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCommunityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserRelationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllCommunityRequest proto.InternalMessageInfo
+
+func (m *QueryAllCommunityRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetUserRelationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllCommunityResponse struct {
+	Community  []*Community        `protobuf:"bytes,1,rep,name=Community,proto3" json:"Community,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetUserRelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAllCommunityResponse) Reset()         { *m = QueryAllCommunityResponse{} }
+func (m *QueryAllCommunityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCommunityResponse) ProtoMessage()    {}
+func (*QueryAllCommunityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{27}
 }
-
-func (m *QueryGetUserRelationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllCommunityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCommunityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCommunityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllCommunityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCommunityResponse.Merge(m, src)
+}
+func (m *QueryAllCommunityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCommunityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCommunityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserRelation != nil {
-		{
-			size, err := m.UserRelation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllCommunityResponse proto.InternalMessageInfo
+
+func (m *QueryAllCommunityResponse) GetCommunity() []*Community {
+	if m != nil {
+		return m.Community
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllUserRelationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllCommunityResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllUserRelationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCommunityMembersRequest struct {
+	CommunityId uint64             `protobuf:"varint,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllUserRelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCommunityMembersRequest) Reset()         { *m = QueryCommunityMembersRequest{} }
+func (m *QueryCommunityMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityMembersRequest) ProtoMessage()    {}
+func (*QueryCommunityMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{28}
+}
+func (m *QueryCommunityMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommunityMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityMembersRequest.Merge(m, src)
+}
+func (m *QueryCommunityMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityMembersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllUserRelationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommunityMembersRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityMembersRequest) GetCommunityId() uint64 {
+	if m != nil {
+		return m.CommunityId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommunityMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UserRelation) > 0 {
-		for iNdEx := len(m.UserRelation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserRelation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryCommunityMembersResponse struct {
+	Members    []*CommunityMember  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAppInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunityMembersResponse) Reset()         { *m = QueryCommunityMembersResponse{} }
+func (m *QueryCommunityMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityMembersResponse) ProtoMessage()    {}
+func (*QueryCommunityMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{29}
+}
+func (m *QueryCommunityMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetAppInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommunityMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityMembersResponse.Merge(m, src)
+}
+func (m *QueryCommunityMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityMembersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAppInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryCommunityMembersResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityMembersResponse) GetMembers() []*CommunityMember {
+	if m != nil {
+		return m.Members
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetAppInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunityMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCommunitiesByMemberRequest struct {
+	Member     string             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AppInfo != nil {
-		{
-			size, err := m.AppInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCommunitiesByMemberRequest) Reset()         { *m = QueryCommunitiesByMemberRequest{} }
+func (m *QueryCommunitiesByMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunitiesByMemberRequest) ProtoMessage()    {}
+func (*QueryCommunitiesByMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{30}
+}
+func (m *QueryCommunitiesByMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunitiesByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunitiesByMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllAppInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCommunitiesByMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunitiesByMemberRequest.Merge(m, src)
 }
-
-func (m *QueryAllAppInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommunitiesByMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunitiesByMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunitiesByMemberRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllAppInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCommunitiesByMemberRequest proto.InternalMessageInfo
+
+func (m *QueryCommunitiesByMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllAppInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunitiesByMemberRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCommunitiesByMemberResponse struct {
+	Members    []*CommunityMember  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCommunitiesByMemberResponse) Reset()         { *m = QueryCommunitiesByMemberResponse{} }
+func (m *QueryCommunitiesByMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunitiesByMemberResponse) ProtoMessage()    {}
+func (*QueryCommunitiesByMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{31}
+}
+func (m *QueryCommunitiesByMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunitiesByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunitiesByMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.AppInfo) > 0 {
-		for iNdEx := len(m.AppInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AppInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryCommunitiesByMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunitiesByMemberResponse.Merge(m, src)
+}
+func (m *QueryCommunitiesByMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunitiesByMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunitiesByMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunitiesByMemberResponse proto.InternalMessageInfo
+
+func (m *QueryCommunitiesByMemberResponse) GetMembers() []*CommunityMember {
+	if m != nil {
+		return m.Members
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetDidRegistryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunitiesByMemberResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDidRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppFeeGrantTemplatesRequest struct {
+	Appid      string             `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDidRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAppFeeGrantTemplatesRequest) Reset()         { *m = QueryAppFeeGrantTemplatesRequest{} }
+func (m *QueryAppFeeGrantTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppFeeGrantTemplatesRequest) ProtoMessage()    {}
+func (*QueryAppFeeGrantTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{32}
+}
+func (m *QueryAppFeeGrantTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppFeeGrantTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppFeeGrantTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAppFeeGrantTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppFeeGrantTemplatesRequest.Merge(m, src)
+}
+func (m *QueryAppFeeGrantTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppFeeGrantTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppFeeGrantTemplatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidRegistryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAppFeeGrantTemplatesRequest proto.InternalMessageInfo
+
+func (m *QueryAppFeeGrantTemplatesRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppFeeGrantTemplatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DidRegistry != nil {
-		{
-			size, err := m.DidRegistry.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryAppFeeGrantTemplatesResponse struct {
+	Templates  []*AppFeeGrantTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllDidRegistryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppFeeGrantTemplatesResponse) Reset()         { *m = QueryAppFeeGrantTemplatesResponse{} }
+func (m *QueryAppFeeGrantTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppFeeGrantTemplatesResponse) ProtoMessage()    {}
+func (*QueryAppFeeGrantTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{33}
+}
+func (m *QueryAppFeeGrantTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppFeeGrantTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppFeeGrantTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllDidRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppFeeGrantTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppFeeGrantTemplatesResponse.Merge(m, src)
+}
+func (m *QueryAppFeeGrantTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppFeeGrantTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppFeeGrantTemplatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAppFeeGrantTemplatesResponse proto.InternalMessageInfo
+
+func (m *QueryAppFeeGrantTemplatesResponse) GetTemplates() []*AppFeeGrantTemplate {
+	if m != nil {
+		return m.Templates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllDidRegistryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppFeeGrantTemplatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppFeeGranteesRequest struct {
+	Appid      string             `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DidRegistry) > 0 {
-		for iNdEx := len(m.DidRegistry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidRegistry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAppFeeGranteesRequest) Reset()         { *m = QueryAppFeeGranteesRequest{} }
+func (m *QueryAppFeeGranteesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppFeeGranteesRequest) ProtoMessage()    {}
+func (*QueryAppFeeGranteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{34}
+}
+func (m *QueryAppFeeGranteesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppFeeGranteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppFeeGranteesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAppFeeGranteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppFeeGranteesRequest.Merge(m, src)
+}
+func (m *QueryAppFeeGranteesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppFeeGranteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppFeeGranteesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAppFeeGranteesRequest proto.InternalMessageInfo
+
+func (m *QueryAppFeeGranteesRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryGetUserInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+
+func (m *QueryAppFeeGranteesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetUserInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UserInfo != nil {
-		l = m.UserInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAppFeeGranteesResponse struct {
+	Grantees   []*AppFeeGrantee    `protobuf:"bytes,1,rep,name=grantees,proto3" json:"grantees,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllUserInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAppFeeGranteesResponse) Reset()         { *m = QueryAppFeeGranteesResponse{} }
+func (m *QueryAppFeeGranteesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppFeeGranteesResponse) ProtoMessage()    {}
+func (*QueryAppFeeGranteesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{35}
+}
+func (m *QueryAppFeeGranteesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppFeeGranteesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppFeeGranteesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppFeeGranteesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppFeeGranteesResponse.Merge(m, src)
+}
+func (m *QueryAppFeeGranteesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppFeeGranteesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppFeeGranteesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppFeeGranteesResponse proto.InternalMessageInfo
+
+func (m *QueryAppFeeGranteesResponse) GetGrantees() []*AppFeeGrantee {
+	if m != nil {
+		return m.Grantees
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllUserInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAppFeeGranteesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.UserInfo) > 0 {
-		for _, e := range m.UserInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryAppAuthorizationsByUserRequest struct {
+	Uid        string             `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppAuthorizationsByUserRequest) Reset()         { *m = QueryAppAuthorizationsByUserRequest{} }
+func (m *QueryAppAuthorizationsByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppAuthorizationsByUserRequest) ProtoMessage()    {}
+func (*QueryAppAuthorizationsByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{36}
+}
+func (m *QueryAppAuthorizationsByUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppAuthorizationsByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppAuthorizationsByUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryAppAuthorizationsByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppAuthorizationsByUserRequest.Merge(m, src)
+}
+func (m *QueryAppAuthorizationsByUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppAuthorizationsByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppAuthorizationsByUserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserRelationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryAppAuthorizationsByUserRequest proto.InternalMessageInfo
+
+func (m *QueryAppAuthorizationsByUserRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetUserRelationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UserRelation != nil {
-		l = m.UserRelation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAppAuthorizationsByUserRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllUserRelationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAppAuthorizationsByUserResponse struct {
+	Authorizations []*AppAuthorization `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllUserRelationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAppAuthorizationsByUserResponse) Reset()         { *m = QueryAppAuthorizationsByUserResponse{} }
+func (m *QueryAppAuthorizationsByUserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppAuthorizationsByUserResponse) ProtoMessage()    {}
+func (*QueryAppAuthorizationsByUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{37}
+}
+func (m *QueryAppAuthorizationsByUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppAuthorizationsByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppAuthorizationsByUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.UserRelation) > 0 {
-		for _, e := range m.UserRelation {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppAuthorizationsByUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppAuthorizationsByUserResponse.Merge(m, src)
+}
+func (m *QueryAppAuthorizationsByUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppAuthorizationsByUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppAuthorizationsByUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppAuthorizationsByUserResponse proto.InternalMessageInfo
+
+func (m *QueryAppAuthorizationsByUserResponse) GetAuthorizations() []*AppAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func (m *QueryAppAuthorizationsByUserResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAppAuthorizationsByAppRequest struct {
+	Appid      string             `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppAuthorizationsByAppRequest) Reset()         { *m = QueryAppAuthorizationsByAppRequest{} }
+func (m *QueryAppAuthorizationsByAppRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppAuthorizationsByAppRequest) ProtoMessage()    {}
+func (*QueryAppAuthorizationsByAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{38}
+}
+func (m *QueryAppAuthorizationsByAppRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppAuthorizationsByAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppAuthorizationsByAppRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppAuthorizationsByAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppAuthorizationsByAppRequest.Merge(m, src)
+}
+func (m *QueryAppAuthorizationsByAppRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppAuthorizationsByAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppAuthorizationsByAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppAuthorizationsByAppRequest proto.InternalMessageInfo
+
+func (m *QueryAppAuthorizationsByAppRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetAppInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAppAuthorizationsByAppRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return nil
+}
+
+type QueryAppAuthorizationsByAppResponse struct {
+	Authorizations []*AppAuthorization `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) Reset()         { *m = QueryAppAuthorizationsByAppResponse{} }
+func (m *QueryAppAuthorizationsByAppResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppAuthorizationsByAppResponse) ProtoMessage()    {}
+func (*QueryAppAuthorizationsByAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{39}
+}
+func (m *QueryAppAuthorizationsByAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppAuthorizationsByAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppAuthorizationsByAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAppAuthorizationsByAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppAuthorizationsByAppResponse.Merge(m, src)
+}
+func (m *QueryAppAuthorizationsByAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppAuthorizationsByAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppAuthorizationsByAppResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAppInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAppAuthorizationsByAppResponse proto.InternalMessageInfo
+
+func (m *QueryAppAuthorizationsByAppResponse) GetAuthorizations() []*AppAuthorization {
+	if m != nil {
+		return m.Authorizations
 	}
-	var l int
-	_ = l
-	if m.AppInfo != nil {
-		l = m.AppInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllAppInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAppSponsorStatRequest struct {
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+}
+
+func (m *QueryAppSponsorStatRequest) Reset()         { *m = QueryAppSponsorStatRequest{} }
+func (m *QueryAppSponsorStatRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppSponsorStatRequest) ProtoMessage()    {}
+func (*QueryAppSponsorStatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{40}
+}
+func (m *QueryAppSponsorStatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppSponsorStatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppSponsorStatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppSponsorStatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppSponsorStatRequest.Merge(m, src)
+}
+func (m *QueryAppSponsorStatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppSponsorStatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppSponsorStatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppSponsorStatRequest proto.InternalMessageInfo
+
+func (m *QueryAppSponsorStatRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllAppInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAppSponsorStatResponse struct {
+	Stat *AppSponsorStat `protobuf:"bytes,1,opt,name=stat,proto3" json:"stat,omitempty"`
+}
+
+func (m *QueryAppSponsorStatResponse) Reset()         { *m = QueryAppSponsorStatResponse{} }
+func (m *QueryAppSponsorStatResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppSponsorStatResponse) ProtoMessage()    {}
+func (*QueryAppSponsorStatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{41}
+}
+func (m *QueryAppSponsorStatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppSponsorStatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppSponsorStatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.AppInfo) > 0 {
-		for _, e := range m.AppInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppSponsorStatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppSponsorStatResponse.Merge(m, src)
+}
+func (m *QueryAppSponsorStatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppSponsorStatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppSponsorStatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppSponsorStatResponse proto.InternalMessageInfo
+
+func (m *QueryAppSponsorStatResponse) GetStat() *AppSponsorStat {
+	if m != nil {
+		return m.Stat
+	}
+	return nil
+}
+
+type QueryAppSponsoredAccountsRequest struct {
+	Appid      string             `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppSponsoredAccountsRequest) Reset()         { *m = QueryAppSponsoredAccountsRequest{} }
+func (m *QueryAppSponsoredAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppSponsoredAccountsRequest) ProtoMessage()    {}
+func (*QueryAppSponsoredAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{42}
+}
+func (m *QueryAppSponsoredAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppSponsoredAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppSponsoredAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAppSponsoredAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppSponsoredAccountsRequest.Merge(m, src)
+}
+func (m *QueryAppSponsoredAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppSponsoredAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppSponsoredAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppSponsoredAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryAppSponsoredAccountsRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetDidRegistryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAppSponsoredAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return nil
+}
+
+type QueryAppSponsoredAccountsResponse struct {
+	Accounts   []*MisesAccount     `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppSponsoredAccountsResponse) Reset()         { *m = QueryAppSponsoredAccountsResponse{} }
+func (m *QueryAppSponsoredAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppSponsoredAccountsResponse) ProtoMessage()    {}
+func (*QueryAppSponsoredAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{43}
+}
+func (m *QueryAppSponsoredAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppSponsoredAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppSponsoredAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAppSponsoredAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppSponsoredAccountsResponse.Merge(m, src)
+}
+func (m *QueryAppSponsoredAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppSponsoredAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppSponsoredAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidRegistryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAppSponsoredAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAppSponsoredAccountsResponse) GetAccounts() []*MisesAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	var l int
-	_ = l
-	if m.DidRegistry != nil {
-		l = m.DidRegistry.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryAppSponsoredAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAppDenomsRequest struct {
+	Appid      string             `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppDenomsRequest) Reset()         { *m = QueryAppDenomsRequest{} }
+func (m *QueryAppDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppDenomsRequest) ProtoMessage()    {}
+func (*QueryAppDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{44}
+}
+func (m *QueryAppDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAppDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppDenomsRequest.Merge(m, src)
+}
+func (m *QueryAppDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryAppDenomsRequest) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *QueryAppDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAppDenomsResponse struct {
+	Denoms     []*AppDenom         `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAppDenomsResponse) Reset()         { *m = QueryAppDenomsResponse{} }
+func (m *QueryAppDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppDenomsResponse) ProtoMessage()    {}
+func (*QueryAppDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{45}
+}
+func (m *QueryAppDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAppDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppDenomsResponse.Merge(m, src)
+}
+func (m *QueryAppDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAppDenomsResponse) GetDenoms() []*AppDenom {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryAppDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNFTClassRoyaltyRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// optional sale price the royalty is computed for
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *QueryNFTClassRoyaltyRequest) Reset()         { *m = QueryNFTClassRoyaltyRequest{} }
+func (m *QueryNFTClassRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTClassRoyaltyRequest) ProtoMessage()    {}
+func (*QueryNFTClassRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{46}
+}
+func (m *QueryNFTClassRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTClassRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTClassRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTClassRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTClassRoyaltyRequest.Merge(m, src)
+}
+func (m *QueryNFTClassRoyaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTClassRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTClassRoyaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTClassRoyaltyRequest proto.InternalMessageInfo
+
+func (m *QueryNFTClassRoyaltyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryNFTClassRoyaltyRequest) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+type QueryNFTClassRoyaltyResponse struct {
+	RoyaltyRecipient   string                                   `protobuf:"bytes,1,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	RoyaltyBasisPoints uint32                                   `protobuf:"varint,2,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
+	Royalty            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=royalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"royalty"`
+}
+
+func (m *QueryNFTClassRoyaltyResponse) Reset()         { *m = QueryNFTClassRoyaltyResponse{} }
+func (m *QueryNFTClassRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTClassRoyaltyResponse) ProtoMessage()    {}
+func (*QueryNFTClassRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{47}
+}
+func (m *QueryNFTClassRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTClassRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTClassRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTClassRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTClassRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryNFTClassRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTClassRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTClassRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTClassRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryNFTClassRoyaltyResponse) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (m *QueryNFTClassRoyaltyResponse) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *QueryNFTClassRoyaltyResponse) GetRoyalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{48}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67823a03eb7be29, []int{49}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryGetUserInfoRequest)(nil), "misesid.misestm.v1beta1.QueryGetUserInfoRequest")
+	proto.RegisterType((*QueryGetUserInfoResponse)(nil), "misesid.misestm.v1beta1.QueryGetUserInfoResponse")
+	proto.RegisterType((*QueryAllUserInfoRequest)(nil), "misesid.misestm.v1beta1.QueryAllUserInfoRequest")
+	proto.RegisterType((*QueryAllUserInfoResponse)(nil), "misesid.misestm.v1beta1.QueryAllUserInfoResponse")
+	proto.RegisterType((*QueryGetUserRelationRequest)(nil), "misesid.misestm.v1beta1.QueryGetUserRelationRequest")
+	proto.RegisterType((*QueryGetUserRelationResponse)(nil), "misesid.misestm.v1beta1.QueryGetUserRelationResponse")
+	proto.RegisterType((*QueryAllUserRelationRequest)(nil), "misesid.misestm.v1beta1.QueryAllUserRelationRequest")
+	proto.RegisterType((*QueryAllUserRelationResponse)(nil), "misesid.misestm.v1beta1.QueryAllUserRelationResponse")
+	proto.RegisterType((*QueryGetAppInfoRequest)(nil), "misesid.misestm.v1beta1.QueryGetAppInfoRequest")
+	proto.RegisterType((*QueryGetAppInfoResponse)(nil), "misesid.misestm.v1beta1.QueryGetAppInfoResponse")
+	proto.RegisterType((*QueryAllAppInfoRequest)(nil), "misesid.misestm.v1beta1.QueryAllAppInfoRequest")
+	proto.RegisterType((*QueryAllAppInfoResponse)(nil), "misesid.misestm.v1beta1.QueryAllAppInfoResponse")
+	proto.RegisterType((*QueryGetDidRegistryRequest)(nil), "misesid.misestm.v1beta1.QueryGetDidRegistryRequest")
+	proto.RegisterType((*QueryGetDidRegistryResponse)(nil), "misesid.misestm.v1beta1.QueryGetDidRegistryResponse")
+	proto.RegisterType((*QueryAllDidRegistryRequest)(nil), "misesid.misestm.v1beta1.QueryAllDidRegistryRequest")
+	proto.RegisterType((*QueryAllDidRegistryResponse)(nil), "misesid.misestm.v1beta1.QueryAllDidRegistryResponse")
+	proto.RegisterType((*QueryGetUserListRequest)(nil), "misesid.misestm.v1beta1.QueryGetUserListRequest")
+	proto.RegisterType((*QueryGetUserListResponse)(nil), "misesid.misestm.v1beta1.QueryGetUserListResponse")
+	proto.RegisterType((*QueryAllUserListRequest)(nil), "misesid.misestm.v1beta1.QueryAllUserListRequest")
+	proto.RegisterType((*QueryAllUserListResponse)(nil), "misesid.misestm.v1beta1.QueryAllUserListResponse")
+	proto.RegisterType((*QueryUserListsByOwnerRequest)(nil), "misesid.misestm.v1beta1.QueryUserListsByOwnerRequest")
+	proto.RegisterType((*QueryUserListsByOwnerResponse)(nil), "misesid.misestm.v1beta1.QueryUserListsByOwnerResponse")
+	proto.RegisterType((*QueryUserListsByMemberRequest)(nil), "misesid.misestm.v1beta1.QueryUserListsByMemberRequest")
+	proto.RegisterType((*QueryUserListsByMemberResponse)(nil), "misesid.misestm.v1beta1.QueryUserListsByMemberResponse")
+	proto.RegisterType((*QueryGetCommunityRequest)(nil), "misesid.misestm.v1beta1.QueryGetCommunityRequest")
+	proto.RegisterType((*QueryGetCommunityResponse)(nil), "misesid.misestm.v1beta1.QueryGetCommunityResponse")
+	proto.RegisterType((*QueryAllCommunityRequest)(nil), "misesid.misestm.v1beta1.QueryAllCommunityRequest")
+	proto.RegisterType((*QueryAllCommunityResponse)(nil), "misesid.misestm.v1beta1.QueryAllCommunityResponse")
+	proto.RegisterType((*QueryCommunityMembersRequest)(nil), "misesid.misestm.v1beta1.QueryCommunityMembersRequest")
+	proto.RegisterType((*QueryCommunityMembersResponse)(nil), "misesid.misestm.v1beta1.QueryCommunityMembersResponse")
+	proto.RegisterType((*QueryCommunitiesByMemberRequest)(nil), "misesid.misestm.v1beta1.QueryCommunitiesByMemberRequest")
+	proto.RegisterType((*QueryCommunitiesByMemberResponse)(nil), "misesid.misestm.v1beta1.QueryCommunitiesByMemberResponse")
+	proto.RegisterType((*QueryAppFeeGrantTemplatesRequest)(nil), "misesid.misestm.v1beta1.QueryAppFeeGrantTemplatesRequest")
+	proto.RegisterType((*QueryAppFeeGrantTemplatesResponse)(nil), "misesid.misestm.v1beta1.QueryAppFeeGrantTemplatesResponse")
+	proto.RegisterType((*QueryAppFeeGranteesRequest)(nil), "misesid.misestm.v1beta1.QueryAppFeeGranteesRequest")
+	proto.RegisterType((*QueryAppFeeGranteesResponse)(nil), "misesid.misestm.v1beta1.QueryAppFeeGranteesResponse")
+	proto.RegisterType((*QueryAppAuthorizationsByUserRequest)(nil), "misesid.misestm.v1beta1.QueryAppAuthorizationsByUserRequest")
+	proto.RegisterType((*QueryAppAuthorizationsByUserResponse)(nil), "misesid.misestm.v1beta1.QueryAppAuthorizationsByUserResponse")
+	proto.RegisterType((*QueryAppAuthorizationsByAppRequest)(nil), "misesid.misestm.v1beta1.QueryAppAuthorizationsByAppRequest")
+	proto.RegisterType((*QueryAppAuthorizationsByAppResponse)(nil), "misesid.misestm.v1beta1.QueryAppAuthorizationsByAppResponse")
+	proto.RegisterType((*QueryAppSponsorStatRequest)(nil), "misesid.misestm.v1beta1.QueryAppSponsorStatRequest")
+	proto.RegisterType((*QueryAppSponsorStatResponse)(nil), "misesid.misestm.v1beta1.QueryAppSponsorStatResponse")
+	proto.RegisterType((*QueryAppSponsoredAccountsRequest)(nil), "misesid.misestm.v1beta1.QueryAppSponsoredAccountsRequest")
+	proto.RegisterType((*QueryAppSponsoredAccountsResponse)(nil), "misesid.misestm.v1beta1.QueryAppSponsoredAccountsResponse")
+	proto.RegisterType((*QueryAppDenomsRequest)(nil), "misesid.misestm.v1beta1.QueryAppDenomsRequest")
+	proto.RegisterType((*QueryAppDenomsResponse)(nil), "misesid.misestm.v1beta1.QueryAppDenomsResponse")
+	proto.RegisterType((*QueryNFTClassRoyaltyRequest)(nil), "misesid.misestm.v1beta1.QueryNFTClassRoyaltyRequest")
+	proto.RegisterType((*QueryNFTClassRoyaltyResponse)(nil), "misesid.misestm.v1beta1.QueryNFTClassRoyaltyResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "misesid.misestm.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "misesid.misestm.v1beta1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/query.proto", fileDescriptor_e67823a03eb7be29) }
+
+var fileDescriptor_e67823a03eb7be29 = []byte{
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdb, 0x6f, 0x1c, 0x57,
+	0x19, 0xcf, 0xc9, 0xa6, 0x4e, 0xfc, 0x25, 0xa4, 0xe9, 0xa9, 0x89, 0x93, 0x71, 0xba, 0x8e, 0xc7,
+	0x49, 0x7c, 0xdf, 0xf1, 0x35, 0x17, 0x27, 0x01, 0xd6, 0xad, 0x1c, 0x19, 0xd1, 0x92, 0x4e, 0xd3,
+	0x07, 0x2a, 0x21, 0x33, 0xde, 0x3d, 0x6c, 0x47, 0xec, 0xee, 0x4c, 0x77, 0x66, 0x01, 0xd7, 0x58,
+	0x40, 0xdf, 0x78, 0x40, 0x42, 0xe2, 0x0d, 0x95, 0xaa, 0xaa, 0x10, 0xa0, 0xa8, 0x6a, 0x81, 0x27,
+	0xa4, 0x0a, 0x09, 0x90, 0x10, 0x7d, 0x40, 0xa8, 0x52, 0x85, 0xc4, 0x13, 0xa0, 0xa4, 0x7f, 0x08,
+	0x9a, 0x33, 0xdf, 0x99, 0x9d, 0xfb, 0x65, 0x35, 0x58, 0xe1, 0x69, 0x33, 0xdf, 0x39, 0xbf, 0xef,
+	0xfc, 0xbe, 0xdf, 0xb9, 0xcd, 0xfc, 0x1c, 0x98, 0xe8, 0xe8, 0x16, 0xb3, 0xec, 0x8e, 0xf2, 0xed,
+	0x95, 0x3d, 0x66, 0x6b, 0x2b, 0xca, 0x1b, 0x7d, 0xd6, 0xdb, 0xaf, 0x99, 0x3d, 0xc3, 0x36, 0xe8,
+	0x38, 0x6f, 0xd4, 0x9b, 0x35, 0xec, 0x54, 0xc3, 0x4e, 0xd2, 0xa5, 0x96, 0x61, 0xb4, 0xda, 0x4c,
+	0xd1, 0x4c, 0x5d, 0xd1, 0xba, 0x5d, 0xc3, 0xd6, 0x6c, 0xdd, 0xe8, 0x5a, 0x2e, 0x4c, 0x9a, 0x6f,
+	0x18, 0x56, 0xc7, 0xb0, 0x94, 0x3d, 0xcd, 0x62, 0x6e, 0x3e, 0x2f, 0xbb, 0xa9, 0xb5, 0xf4, 0x2e,
+	0xef, 0x8c, 0x7d, 0xab, 0xe1, 0xf1, 0x5f, 0xb5, 0x58, 0x6f, 0xa7, 0xfb, 0x4d, 0x03, 0xdb, 0xe5,
+	0xb8, 0x76, 0x95, 0xb5, 0xfd, 0x39, 0x9e, 0x0b, 0xf7, 0xa9, 0x9b, 0xa6, 0x2f, 0xc5, 0x54, 0xb8,
+	0xf9, 0x05, 0xbd, 0xa9, 0xb2, 0x96, 0x6e, 0xd9, 0xbd, 0xfd, 0x34, 0x16, 0x5f, 0xd1, 0x2d, 0x1b,
+	0xdb, 0x27, 0xc3, 0xed, 0xcf, 0x1b, 0x9d, 0x4e, 0xbf, 0xab, 0xdb, 0x22, 0xc1, 0x5c, 0x0c, 0x85,
+	0x6d, 0xc6, 0xee, 0xf5, 0xb4, 0xae, 0xfd, 0x80, 0x75, 0xcc, 0xb6, 0x66, 0x33, 0xec, 0x7a, 0x2d,
+	0xa6, 0x6b, 0xbd, 0x6f, 0xbf, 0x6e, 0xf4, 0xf4, 0x37, 0xfd, 0x55, 0x45, 0x2a, 0x7f, 0xd1, 0x79,
+	0xae, 0x37, 0x1a, 0x46, 0xbf, 0x6b, 0x27, 0xf1, 0xae, 0x9b, 0xe6, 0x0b, 0xac, 0x6b, 0x74, 0xb0,
+	0xfd, 0x52, 0xb8, 0xdd, 0xd4, 0x7a, 0x5a, 0x47, 0xcc, 0x53, 0xd5, 0x3f, 0x4f, 0xa2, 0x47, 0xc3,
+	0xd0, 0x05, 0x83, 0xb1, 0x96, 0xd1, 0x32, 0xf8, 0x3f, 0x15, 0xe7, 0x5f, 0x6e, 0x54, 0x9e, 0x83,
+	0xf1, 0x97, 0x9d, 0x39, 0xbd, 0xc7, 0x6c, 0x31, 0x57, 0x2a, 0x7b, 0xa3, 0xcf, 0x2c, 0x9b, 0x9e,
+	0x85, 0xe3, 0x7a, 0xf3, 0x02, 0xb9, 0x4c, 0x66, 0x4f, 0xa8, 0xc7, 0xf5, 0xa6, 0xfc, 0x35, 0xb8,
+	0x10, 0xed, 0x6a, 0x99, 0x46, 0xd7, 0x62, 0xf4, 0x2e, 0x9c, 0x12, 0x31, 0x8e, 0x38, 0xbd, 0x3a,
+	0x55, 0x4b, 0x58, 0x6e, 0x35, 0x0f, 0xec, 0x41, 0x64, 0x0d, 0x59, 0xd4, 0xdb, 0xed, 0x30, 0x8b,
+	0x6d, 0x80, 0xc1, 0x32, 0xc3, 0xdc, 0xd7, 0x6a, 0x6e, 0xad, 0x35, 0xa7, 0xd6, 0x9a, 0xbb, 0xc6,
+	0x45, 0xf6, 0xfb, 0x5a, 0x8b, 0x21, 0x56, 0xf5, 0x21, 0xe5, 0xf7, 0x08, 0x5c, 0x88, 0x8e, 0x11,
+	0x4b, 0xbf, 0x52, 0x90, 0x3e, 0xbd, 0x17, 0xe0, 0x78, 0x9c, 0x73, 0x9c, 0xc9, 0xe4, 0xe8, 0x8e,
+	0x1d, 0x20, 0xb9, 0x04, 0x13, 0x7e, 0x89, 0xc5, 0xce, 0x48, 0x9a, 0x11, 0x1d, 0x2e, 0xc5, 0x77,
+	0xc7, 0xb2, 0x76, 0xe0, 0x8c, 0x3f, 0x8e, 0xea, 0x5d, 0x4d, 0x2d, 0xcd, 0x4b, 0x12, 0x80, 0xca,
+	0x0c, 0x26, 0xfc, 0xea, 0x85, 0x99, 0x95, 0x35, 0x4b, 0xbf, 0x23, 0x70, 0x29, 0x7e, 0x9c, 0xc4,
+	0x92, 0x2a, 0x43, 0x96, 0x54, 0xde, 0xac, 0xcd, 0xc2, 0x79, 0x31, 0x0d, 0x78, 0x56, 0x25, 0x4d,
+	0xd8, 0xab, 0x30, 0x1e, 0xe9, 0x89, 0x85, 0x6d, 0xc2, 0x49, 0x0c, 0xa1, 0x7c, 0x97, 0x13, 0x6b,
+	0x12, 0x50, 0x01, 0x90, 0xbf, 0x81, 0x04, 0xea, 0xed, 0x76, 0x88, 0x40, 0x59, 0xf3, 0xf2, 0x0e,
+	0x81, 0xf1, 0xc8, 0x10, 0x71, 0xcc, 0x2b, 0x85, 0x98, 0x97, 0x37, 0x07, 0x8b, 0x20, 0x09, 0x65,
+	0x7d, 0x17, 0x42, 0xd2, 0x3c, 0x30, 0x98, 0x88, 0xed, 0x8d, 0x15, 0x6d, 0xc3, 0x69, 0x5f, 0x18,
+	0x65, 0xbb, 0x92, 0x58, 0x95, 0x3f, 0x85, 0x1f, 0x28, 0x37, 0x91, 0x54, 0xbd, 0xdd, 0x8e, 0x21,
+	0x55, 0xd6, 0xdc, 0x7c, 0x40, 0x60, 0x22, 0x76, 0x98, 0xa4, 0x6a, 0x2a, 0x43, 0x55, 0x53, 0xde,
+	0x5c, 0x85, 0xee, 0x1c, 0xe7, 0x66, 0xce, 0x79, 0xe7, 0xb8, 0x5d, 0x83, 0x87, 0xb6, 0x13, 0xcb,
+	0x75, 0xe7, 0x70, 0xb0, 0x07, 0x09, 0xdf, 0x39, 0x7e, 0x16, 0xff, 0xab, 0x3b, 0x27, 0x85, 0x7e,
+	0xa5, 0x20, 0xfd, 0xf2, 0x66, 0xe3, 0x7b, 0x78, 0xe2, 0x8a, 0xcc, 0xd6, 0xd6, 0xfe, 0x57, 0xbf,
+	0xd3, 0x65, 0x3d, 0x2c, 0x88, 0x8e, 0xc1, 0x53, 0x86, 0xf3, 0xcc, 0x75, 0x18, 0x55, 0xdd, 0x07,
+	0xba, 0x1d, 0x33, 0xfc, 0x30, 0x12, 0xfd, 0x8a, 0xc0, 0x73, 0x09, 0xc3, 0x3f, 0x61, 0x3a, 0x7d,
+	0x3f, 0x4a, 0xf4, 0x45, 0xd6, 0xd9, 0x1b, 0x08, 0x75, 0x1e, 0x46, 0x3a, 0x3c, 0x80, 0x4a, 0xe1,
+	0x53, 0x69, 0x52, 0xfd, 0x9a, 0x40, 0x35, 0x89, 0xc1, 0x13, 0xa6, 0xd5, 0xfc, 0x60, 0xdb, 0x7a,
+	0xef, 0xd6, 0x49, 0x5b, 0xfc, 0xeb, 0x70, 0x31, 0xa6, 0x2f, 0x16, 0xf4, 0x25, 0x18, 0xf5, 0x82,
+	0xb8, 0x11, 0xe5, 0xc4, 0x8a, 0x06, 0xf0, 0x01, 0x48, 0xde, 0x1b, 0x6c, 0xc1, 0x08, 0x95, 0xb2,
+	0xf6, 0xf9, 0x2f, 0x09, 0x5c, 0x8c, 0x19, 0x24, 0xbe, 0x86, 0x4a, 0xe1, 0x1a, 0xca, 0x9b, 0x97,
+	0x1f, 0x89, 0xd7, 0x2b, 0x2f, 0xb7, 0xbb, 0x80, 0x2c, 0xa1, 0xc8, 0x14, 0x9c, 0x69, 0x88, 0xa6,
+	0x5d, 0x6f, 0x9a, 0x4e, 0x7b, 0xb1, 0x9d, 0x66, 0x69, 0xcb, 0xf9, 0x7d, 0xb1, 0xf3, 0xa3, 0x5c,
+	0x50, 0xb8, 0x2d, 0x38, 0xe9, 0x6e, 0x21, 0x0b, 0x65, 0x9b, 0xcd, 0x96, 0x0d, 0x37, 0x84, 0x00,
+	0x96, 0x27, 0xdd, 0x0f, 0x09, 0x4c, 0x06, 0xe8, 0xea, 0xec, 0xc8, 0x4f, 0x80, 0x0f, 0x09, 0x5c,
+	0x4e, 0xe6, 0xf0, 0x24, 0xaa, 0xf6, 0x03, 0xc1, 0x38, 0xe6, 0x0b, 0xda, 0xf2, 0xdd, 0x30, 0x9a,
+	0x69, 0xe2, 0x6a, 0x1b, 0x55, 0xdd, 0x87, 0xd2, 0x44, 0xfb, 0x3d, 0x81, 0xa9, 0x14, 0x0a, 0xa8,
+	0xda, 0x97, 0x61, 0xd4, 0x16, 0x41, 0xd4, 0x6d, 0x31, 0xed, 0x35, 0x36, 0x9c, 0x49, 0x1d, 0xc0,
+	0xcb, 0x53, 0xef, 0x4d, 0xf1, 0xfe, 0x38, 0x18, 0x8f, 0x1d, 0x95, 0x6c, 0x0f, 0xbd, 0xb7, 0xca,
+	0xd0, 0xe0, 0xde, 0x32, 0x3b, 0xd5, 0xc2, 0x18, 0xea, 0x75, 0x2d, 0x8f, 0x5e, 0x8c, 0xa9, 0x1e,
+	0xae, 0xcc, 0xbb, 0x79, 0x5a, 0x70, 0x0d, 0x98, 0x2f, 0xd6, 0xd6, 0xbe, 0xfb, 0xc9, 0xe7, 0x2a,
+	0x76, 0x0e, 0x2a, 0x7d, 0x4f, 0xaf, 0x4a, 0xbf, 0x44, 0xb5, 0xfe, 0x4c, 0xe0, 0x4a, 0x3a, 0x03,
+	0x94, 0xed, 0x65, 0x38, 0xab, 0x05, 0xda, 0x51, 0xbc, 0xb9, 0x34, 0xf1, 0x02, 0x19, 0xd5, 0x50,
+	0x82, 0xf2, 0x54, 0x7c, 0x8b, 0x80, 0x9c, 0x54, 0x44, 0xdd, 0x34, 0x8f, 0x66, 0xdd, 0xfd, 0x89,
+	0xc0, 0x74, 0x2a, 0x89, 0xff, 0x03, 0x21, 0x57, 0x07, 0xfb, 0xf6, 0x15, 0xa7, 0xd5, 0xe8, 0xbd,
+	0x62, 0x6b, 0x76, 0xaa, 0x7e, 0xf2, 0x6b, 0x30, 0x11, 0x8b, 0xc1, 0x72, 0x6f, 0xc3, 0x09, 0xcb,
+	0xd6, 0xc4, 0x87, 0xce, 0x4c, 0x5a, 0x91, 0x7e, 0x38, 0x07, 0x05, 0x4e, 0x61, 0x6c, 0x65, 0x4d,
+	0xf4, 0x1e, 0x8f, 0xe8, 0x38, 0xf9, 0xd0, 0x77, 0x0a, 0xc7, 0x50, 0xc0, 0x2a, 0xeb, 0x70, 0x4a,
+	0xc3, 0x58, 0xa6, 0xb3, 0xe3, 0x37, 0x50, 0x55, 0x0f, 0x56, 0xde, 0x24, 0xf6, 0xe1, 0xf3, 0x82,
+	0x30, 0x37, 0x61, 0x8f, 0x48, 0xa8, 0xb7, 0x09, 0x9c, 0x0f, 0x8f, 0x8b, 0xea, 0xdc, 0x82, 0x91,
+	0x26, 0x8f, 0x64, 0xbe, 0xdb, 0x0b, 0xac, 0x8a, 0x80, 0xf2, 0x54, 0x79, 0x09, 0x97, 0xe9, 0x4b,
+	0xdb, 0x0f, 0x9e, 0x6f, 0x6b, 0x96, 0xa5, 0x1a, 0xfb, 0x5a, 0x7b, 0xf0, 0x46, 0x7d, 0x11, 0x4e,
+	0x35, 0x9c, 0xf0, 0xae, 0x27, 0xcf, 0x49, 0xfe, 0xbc, 0xd3, 0x74, 0x64, 0x33, 0x7b, 0x7a, 0x83,
+	0xf1, 0xd1, 0x47, 0x55, 0xf7, 0x41, 0xfe, 0x4c, 0xbc, 0x91, 0x46, 0x12, 0x62, 0xd1, 0x0b, 0xf0,
+	0x4c, 0xcf, 0x0d, 0xed, 0xf6, 0x58, 0x43, 0x37, 0x75, 0xd6, 0xb5, 0x31, 0xf5, 0xb9, 0x9e, 0xe8,
+	0x8b, 0x71, 0xba, 0x0c, 0x63, 0xa2, 0xf3, 0x9e, 0x66, 0xe9, 0xd6, 0xae, 0x69, 0xe8, 0xce, 0x5a,
+	0x72, 0x86, 0xfc, 0x9c, 0x4a, 0xb1, 0x6d, 0xcb, 0x69, 0xba, 0xcf, 0x5b, 0x28, 0x83, 0x93, 0x18,
+	0xbd, 0x50, 0xe1, 0xa2, 0x5e, 0x0c, 0xa8, 0x32, 0x78, 0x53, 0xd2, 0xbb, 0x5b, 0xcb, 0x1f, 0xff,
+	0x6b, 0xf2, 0xd8, 0xc3, 0x7f, 0x4f, 0xce, 0xb6, 0x74, 0xfb, 0xf5, 0xfe, 0x5e, 0xad, 0x61, 0x74,
+	0x14, 0xb7, 0x33, 0xfe, 0x2c, 0x59, 0xcd, 0x6f, 0x29, 0xf6, 0xbe, 0xc9, 0x2c, 0x0e, 0xb0, 0x54,
+	0x91, 0x5b, 0x1e, 0x03, 0xca, 0xab, 0xbc, 0xcf, 0x1d, 0x7b, 0x54, 0x4b, 0x7e, 0x00, 0xcf, 0x06,
+	0xa2, 0xde, 0x57, 0xdc, 0x88, 0xeb, 0xec, 0xe3, 0x6e, 0x9f, 0x4c, 0x9c, 0x67, 0x17, 0xb8, 0x75,
+	0xc2, 0x21, 0xa6, 0x22, 0x68, 0xf5, 0xe7, 0xd3, 0xf0, 0x14, 0x4f, 0x4b, 0x7f, 0x4c, 0x60, 0xc4,
+	0xed, 0x42, 0x17, 0x12, 0x73, 0x44, 0x79, 0x49, 0x8b, 0xf9, 0x3a, 0xbb, 0x74, 0xe5, 0xd9, 0xb7,
+	0x3e, 0xfd, 0xec, 0xa7, 0xc7, 0x65, 0x7a, 0x59, 0xe1, 0xbd, 0x97, 0xf4, 0xa6, 0x82, 0x30, 0xef,
+	0xd7, 0x65, 0x46, 0xdf, 0x25, 0x03, 0x9f, 0x9d, 0x2e, 0xa7, 0x0f, 0x12, 0xfd, 0x83, 0x84, 0xb4,
+	0x52, 0x00, 0x81, 0xdc, 0x14, 0xce, 0x6d, 0x8e, 0xce, 0x24, 0x73, 0x13, 0x18, 0xe5, 0x40, 0x6f,
+	0x1e, 0xd2, 0x77, 0x08, 0x9c, 0x16, 0x91, 0x7a, 0xbb, 0x9d, 0xc5, 0x32, 0xfa, 0x07, 0x0b, 0x69,
+	0xa5, 0x00, 0x02, 0x59, 0xce, 0x73, 0x96, 0x57, 0xa8, 0x9c, 0xcd, 0x92, 0xfe, 0x86, 0x04, 0x1d,
+	0x70, 0xba, 0x9e, 0x4b, 0x95, 0x90, 0x61, 0x2f, 0x6d, 0x14, 0x44, 0x21, 0xd3, 0x35, 0xce, 0x74,
+	0x89, 0x2e, 0xa4, 0x33, 0x15, 0x38, 0x57, 0xd3, 0x0f, 0x08, 0x3c, 0xed, 0x8f, 0x3a, 0xba, 0xae,
+	0xe7, 0x52, 0xa9, 0x20, 0xeb, 0x84, 0x3f, 0x1a, 0xc8, 0x35, 0xce, 0x7a, 0x96, 0x5e, 0xcb, 0xc7,
+	0x9a, 0xbe, 0x4d, 0x3c, 0x4b, 0x9b, 0x2a, 0x99, 0x42, 0x05, 0x2d, 0x77, 0x69, 0x39, 0x3f, 0x20,
+	0x3f, 0x3d, 0x84, 0xb8, 0x7a, 0xfe, 0x8c, 0x00, 0x60, 0xc0, 0x91, 0x52, 0xc9, 0x14, 0xa5, 0x18,
+	0xc3, 0xa8, 0xc5, 0x2f, 0xcf, 0x71, 0x86, 0xd3, 0x74, 0x2a, 0x93, 0x21, 0x7d, 0x9f, 0x04, 0xec,
+	0x66, 0xba, 0x96, 0x29, 0x47, 0xd4, 0x1a, 0x97, 0xd6, 0x8b, 0x81, 0x90, 0xe5, 0x2a, 0x67, 0xb9,
+	0x48, 0xe7, 0x93, 0x59, 0xfa, 0x60, 0xae, 0x96, 0x0f, 0x09, 0x9c, 0xf5, 0x05, 0x1d, 0x3d, 0xd7,
+	0x32, 0xe5, 0x29, 0xce, 0x38, 0xde, 0x9a, 0x97, 0x97, 0x38, 0xe3, 0x19, 0x7a, 0x35, 0x17, 0x63,
+	0xef, 0xfc, 0xe4, 0x66, 0x5d, 0xbe, 0xf3, 0xd3, 0x67, 0x6b, 0x4b, 0x2b, 0x05, 0x10, 0xc5, 0xce,
+	0x4f, 0x07, 0x13, 0x3c, 0x3f, 0x9d, 0x48, 0xfe, 0xf3, 0xb3, 0x00, 0xcb, 0x18, 0x2b, 0x3d, 0xef,
+	0xf9, 0xc9, 0x65, 0xfb, 0x88, 0xc0, 0xb9, 0xb0, 0xd7, 0x4c, 0x33, 0xce, 0x95, 0x04, 0x6b, 0x5c,
+	0xba, 0x5e, 0x14, 0x86, 0x7c, 0x6f, 0x72, 0xbe, 0xab, 0x74, 0x39, 0x87, 0xaa, 0xdc, 0x6e, 0x57,
+	0x0e, 0xf8, 0xcf, 0x21, 0xfd, 0x23, 0x81, 0x67, 0x22, 0xf6, 0x2f, 0xcd, 0xcf, 0x23, 0xe0, 0x57,
+	0x49, 0x37, 0x0a, 0xe3, 0xb0, 0x80, 0x4d, 0x5e, 0xc0, 0x3a, 0x5d, 0xcd, 0x51, 0x80, 0xeb, 0x29,
+	0x29, 0x07, 0xee, 0xef, 0x21, 0xfd, 0x05, 0xf1, 0xf9, 0xa1, 0x34, 0x7b, 0x4d, 0x86, 0x5d, 0x5b,
+	0x69, 0xb5, 0x08, 0x04, 0x09, 0x2f, 0x73, 0xc2, 0xf3, 0x74, 0x36, 0x99, 0xb0, 0x07, 0x72, 0x17,
+	0xf2, 0x7b, 0x04, 0xce, 0x78, 0x21, 0x67, 0x25, 0x67, 0xaf, 0xcb, 0xa2, 0x4c, 0xe3, 0xdc, 0x62,
+	0x79, 0x81, 0x33, 0xbd, 0x4a, 0xa7, 0x73, 0x30, 0xa5, 0x7f, 0x21, 0x70, 0x2e, 0x6c, 0x9f, 0x66,
+	0x2d, 0xe6, 0x04, 0xeb, 0x57, 0xba, 0x5e, 0x14, 0x86, 0x84, 0xb7, 0x38, 0xe1, 0x3b, 0x74, 0x33,
+	0x97, 0xb4, 0x7e, 0x77, 0xf9, 0x50, 0x11, 0x7e, 0xe3, 0x5f, 0x09, 0x3c, 0x1b, 0xe3, 0x69, 0xd2,
+	0x9b, 0xf9, 0x38, 0x45, 0xad, 0x58, 0xe9, 0xd6, 0x10, 0x48, 0x2c, 0xe8, 0x36, 0x2f, 0x68, 0x83,
+	0xae, 0xe5, 0x29, 0x28, 0xbc, 0xba, 0xff, 0x4e, 0x60, 0x2c, 0xce, 0x68, 0xa4, 0x19, 0x84, 0x52,
+	0xfc, 0x51, 0x69, 0x73, 0x18, 0x28, 0x16, 0xf3, 0x45, 0x5e, 0xcc, 0x2d, 0x7a, 0x23, 0xf5, 0xe6,
+	0x16, 0x78, 0xe5, 0x80, 0x7f, 0xcc, 0x1e, 0x2a, 0x03, 0x33, 0xf3, 0x23, 0x02, 0x67, 0x83, 0x16,
+	0x60, 0xe6, 0x05, 0x19, 0xe7, 0x56, 0x4a, 0xeb, 0xc5, 0x40, 0x48, 0xff, 0x0b, 0x9c, 0xfe, 0x4d,
+	0x7a, 0xbd, 0x18, 0x7d, 0xcf, 0x61, 0xfc, 0x07, 0x81, 0xf1, 0x04, 0x4b, 0x8e, 0xde, 0xc9, 0x64,
+	0x94, 0xe2, 0x25, 0x4a, 0x77, 0x87, 0x44, 0x63, 0x61, 0x77, 0x79, 0x61, 0x37, 0xe8, 0x46, 0x6a,
+	0x61, 0x81, 0x14, 0x4a, 0xdf, 0x72, 0x56, 0x5a, 0xdf, 0x39, 0x9d, 0x3e, 0x25, 0x70, 0x3e, 0xde,
+	0x20, 0xa3, 0xb7, 0x0b, 0x13, 0x1b, 0x78, 0x7b, 0xd2, 0x9d, 0xe1, 0xc0, 0x85, 0x66, 0x2b, 0x58,
+	0x94, 0x66, 0x9a, 0x62, 0xda, 0xe8, 0x6f, 0xdd, 0xb5, 0xe6, 0x33, 0xb0, 0x72, 0xac, 0xb5, 0xa8,
+	0xc3, 0x26, 0xad, 0x17, 0x03, 0x21, 0xfb, 0x75, 0xce, 0xbe, 0x46, 0x17, 0x53, 0xd9, 0x23, 0xd2,
+	0xe3, 0xfc, 0x37, 0x77, 0xc3, 0x47, 0x3c, 0xad, 0x1c, 0x1b, 0x3e, 0xc9, 0x8a, 0x93, 0x36, 0x87,
+	0x81, 0x16, 0x5a, 0x58, 0xa1, 0x2a, 0x14, 0xcf, 0x3e, 0x7b, 0x97, 0xc0, 0xa8, 0xe7, 0x3c, 0xd1,
+	0x5a, 0x26, 0x91, 0x80, 0x35, 0x26, 0x29, 0xb9, 0xfb, 0xe7, 0x7f, 0x65, 0x17, 0x20, 0x4f, 0xf1,
+	0x3f, 0x10, 0x78, 0x3a, 0xe4, 0x16, 0x65, 0x7d, 0x4e, 0xc6, 0xbb, 0x55, 0xd2, 0x46, 0x41, 0x54,
+	0x7e, 0x89, 0x05, 0x54, 0x39, 0x10, 0x76, 0xd8, 0xa1, 0x22, 0xdc, 0xa7, 0xed, 0x8f, 0x1f, 0x55,
+	0xc9, 0x27, 0x8f, 0xaa, 0xe4, 0x3f, 0x8f, 0xaa, 0xe4, 0x27, 0x8f, 0xab, 0xc7, 0x3e, 0x79, 0x5c,
+	0x3d, 0xf6, 0xcf, 0xc7, 0xd5, 0x63, 0xaf, 0x2d, 0xfa, 0x8c, 0xa5, 0x60, 0xea, 0x25, 0xbb, 0xa3,
+	0x7c, 0xd7, 0xcb, 0xce, 0x2d, 0xa6, 0xbd, 0x11, 0xfe, 0x3f, 0x38, 0xd7, 0xfe, 0x3b, 0x00, 0xd1,
+	0x2e, 0x43, 0x5c, 0xf5, 0x2b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a UserInfo by id.
+	UserInfo(ctx context.Context, in *QueryGetUserInfoRequest, opts ...grpc.CallOption) (*QueryGetUserInfoResponse, error)
+	// Queries a list of UserInfo items.
+	UserInfoAll(ctx context.Context, in *QueryAllUserInfoRequest, opts ...grpc.CallOption) (*QueryAllUserInfoResponse, error)
+	// Queries a UserRelation by id.
+	UserRelation(ctx context.Context, in *QueryGetUserRelationRequest, opts ...grpc.CallOption) (*QueryGetUserRelationResponse, error)
+	// Queries a list of UserRelation items.
+	UserRelationAll(ctx context.Context, in *QueryAllUserRelationRequest, opts ...grpc.CallOption) (*QueryAllUserRelationResponse, error)
+	// Queries a AppInfo by id.
+	AppInfo(ctx context.Context, in *QueryGetAppInfoRequest, opts ...grpc.CallOption) (*QueryGetAppInfoResponse, error)
+	// Queries a list of AppInfo items.
+	AppInfoAll(ctx context.Context, in *QueryAllAppInfoRequest, opts ...grpc.CallOption) (*QueryAllAppInfoResponse, error)
+	// Queries a DidRegistry by id.
+	DidRegistry(ctx context.Context, in *QueryGetDidRegistryRequest, opts ...grpc.CallOption) (*QueryGetDidRegistryResponse, error)
+	// Queries a list of DidRegistry items.
+	DidRegistryAll(ctx context.Context, in *QueryAllDidRegistryRequest, opts ...grpc.CallOption) (*QueryAllDidRegistryResponse, error)
+	// Queries a UserList by id.
+	UserList(ctx context.Context, in *QueryGetUserListRequest, opts ...grpc.CallOption) (*QueryGetUserListResponse, error)
+	// Queries a list of UserList items.
+	UserListAll(ctx context.Context, in *QueryAllUserListRequest, opts ...grpc.CallOption) (*QueryAllUserListResponse, error)
+	// Queries the UserList items owned by a user.
+	UserListsByOwner(ctx context.Context, in *QueryUserListsByOwnerRequest, opts ...grpc.CallOption) (*QueryUserListsByOwnerResponse, error)
+	// Queries the UserList items containing a user.
+	UserListsByMember(ctx context.Context, in *QueryUserListsByMemberRequest, opts ...grpc.CallOption) (*QueryUserListsByMemberResponse, error)
+	// Queries a Community by id.
+	Community(ctx context.Context, in *QueryGetCommunityRequest, opts ...grpc.CallOption) (*QueryGetCommunityResponse, error)
+	// Queries a list of Community items.
+	CommunityAll(ctx context.Context, in *QueryAllCommunityRequest, opts ...grpc.CallOption) (*QueryAllCommunityResponse, error)
+	// Queries the members of a Community.
+	CommunityMembers(ctx context.Context, in *QueryCommunityMembersRequest, opts ...grpc.CallOption) (*QueryCommunityMembersResponse, error)
+	// Queries the Community memberships of a DID.
+	CommunitiesByMember(ctx context.Context, in *QueryCommunitiesByMemberRequest, opts ...grpc.CallOption) (*QueryCommunitiesByMemberResponse, error)
+	// Queries the fee grant templates of an app.
+	AppFeeGrantTemplates(ctx context.Context, in *QueryAppFeeGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryAppFeeGrantTemplatesResponse, error)
+	// Queries the users granted a fee allowance by an app.
+	AppFeeGrantees(ctx context.Context, in *QueryAppFeeGranteesRequest, opts ...grpc.CallOption) (*QueryAppFeeGranteesResponse, error)
+	// Queries the apps a user authorized.
+	AppAuthorizationsByUser(ctx context.Context, in *QueryAppAuthorizationsByUserRequest, opts ...grpc.CallOption) (*QueryAppAuthorizationsByUserResponse, error)
+	// Queries the users who authorized an app.
+	AppAuthorizationsByApp(ctx context.Context, in *QueryAppAuthorizationsByAppRequest, opts ...grpc.CallOption) (*QueryAppAuthorizationsByAppResponse, error)
+	// Queries the DID sponsoring statistics of an app.
+	AppSponsorStat(ctx context.Context, in *QueryAppSponsorStatRequest, opts ...grpc.CallOption) (*QueryAppSponsorStatResponse, error)
+	// Queries the DIDs sponsored by an app.
+	AppSponsoredAccounts(ctx context.Context, in *QueryAppSponsoredAccountsRequest, opts ...grpc.CallOption) (*QueryAppSponsoredAccountsResponse, error)
+	// Queries the denoms issued by an app.
+	AppDenoms(ctx context.Context, in *QueryAppDenomsRequest, opts ...grpc.CallOption) (*QueryAppDenomsResponse, error)
+	// Queries the royalty of a NFT class, and what it amounts to for a sale price.
+	NFTClassRoyalty(ctx context.Context, in *QueryNFTClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryNFTClassRoyaltyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserInfo(ctx context.Context, in *QueryGetUserInfoRequest, opts ...grpc.CallOption) (*QueryGetUserInfoResponse, error) {
+	out := new(QueryGetUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserInfoAll(ctx context.Context, in *QueryAllUserInfoRequest, opts ...grpc.CallOption) (*QueryAllUserInfoResponse, error) {
+	out := new(QueryAllUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserRelation(ctx context.Context, in *QueryGetUserRelationRequest, opts ...grpc.CallOption) (*QueryGetUserRelationResponse, error) {
+	out := new(QueryGetUserRelationResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserRelationAll(ctx context.Context, in *QueryAllUserRelationRequest, opts ...grpc.CallOption) (*QueryAllUserRelationResponse, error) {
+	out := new(QueryAllUserRelationResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserRelationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppInfo(ctx context.Context, in *QueryGetAppInfoRequest, opts ...grpc.CallOption) (*QueryGetAppInfoResponse, error) {
+	out := new(QueryGetAppInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppInfoAll(ctx context.Context, in *QueryAllAppInfoRequest, opts ...grpc.CallOption) (*QueryAllAppInfoResponse, error) {
+	out := new(QueryAllAppInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidRegistry(ctx context.Context, in *QueryGetDidRegistryRequest, opts ...grpc.CallOption) (*QueryGetDidRegistryResponse, error) {
+	out := new(QueryGetDidRegistryResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/DidRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidRegistryAll(ctx context.Context, in *QueryAllDidRegistryRequest, opts ...grpc.CallOption) (*QueryAllDidRegistryResponse, error) {
+	out := new(QueryAllDidRegistryResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/DidRegistryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserList(ctx context.Context, in *QueryGetUserListRequest, opts ...grpc.CallOption) (*QueryGetUserListResponse, error) {
+	out := new(QueryGetUserListResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserListAll(ctx context.Context, in *QueryAllUserListRequest, opts ...grpc.CallOption) (*QueryAllUserListResponse, error) {
+	out := new(QueryAllUserListResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserListAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserListsByOwner(ctx context.Context, in *QueryUserListsByOwnerRequest, opts ...grpc.CallOption) (*QueryUserListsByOwnerResponse, error) {
+	out := new(QueryUserListsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserListsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserListsByMember(ctx context.Context, in *QueryUserListsByMemberRequest, opts ...grpc.CallOption) (*QueryUserListsByMemberResponse, error) {
+	out := new(QueryUserListsByMemberResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/UserListsByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Community(ctx context.Context, in *QueryGetCommunityRequest, opts ...grpc.CallOption) (*QueryGetCommunityResponse, error) {
+	out := new(QueryGetCommunityResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/Community", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityAll(ctx context.Context, in *QueryAllCommunityRequest, opts ...grpc.CallOption) (*QueryAllCommunityResponse, error) {
+	out := new(QueryAllCommunityResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/CommunityAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityMembers(ctx context.Context, in *QueryCommunityMembersRequest, opts ...grpc.CallOption) (*QueryCommunityMembersResponse, error) {
+	out := new(QueryCommunityMembersResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/CommunityMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunitiesByMember(ctx context.Context, in *QueryCommunitiesByMemberRequest, opts ...grpc.CallOption) (*QueryCommunitiesByMemberResponse, error) {
+	out := new(QueryCommunitiesByMemberResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/CommunitiesByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppFeeGrantTemplates(ctx context.Context, in *QueryAppFeeGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryAppFeeGrantTemplatesResponse, error) {
+	out := new(QueryAppFeeGrantTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppFeeGrantTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppFeeGrantees(ctx context.Context, in *QueryAppFeeGranteesRequest, opts ...grpc.CallOption) (*QueryAppFeeGranteesResponse, error) {
+	out := new(QueryAppFeeGranteesResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppFeeGrantees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppAuthorizationsByUser(ctx context.Context, in *QueryAppAuthorizationsByUserRequest, opts ...grpc.CallOption) (*QueryAppAuthorizationsByUserResponse, error) {
+	out := new(QueryAppAuthorizationsByUserResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppAuthorizationsByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppAuthorizationsByApp(ctx context.Context, in *QueryAppAuthorizationsByAppRequest, opts ...grpc.CallOption) (*QueryAppAuthorizationsByAppResponse, error) {
+	out := new(QueryAppAuthorizationsByAppResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppAuthorizationsByApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppSponsorStat(ctx context.Context, in *QueryAppSponsorStatRequest, opts ...grpc.CallOption) (*QueryAppSponsorStatResponse, error) {
+	out := new(QueryAppSponsorStatResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppSponsorStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppSponsoredAccounts(ctx context.Context, in *QueryAppSponsoredAccountsRequest, opts ...grpc.CallOption) (*QueryAppSponsoredAccountsResponse, error) {
+	out := new(QueryAppSponsoredAccountsResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppSponsoredAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppDenoms(ctx context.Context, in *QueryAppDenomsRequest, opts ...grpc.CallOption) (*QueryAppDenomsResponse, error) {
+	out := new(QueryAppDenomsResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/AppDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTClassRoyalty(ctx context.Context, in *QueryNFTClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryNFTClassRoyaltyResponse, error) {
+	out := new(QueryNFTClassRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Query/NFTClassRoyalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a UserInfo by id.
+	UserInfo(context.Context, *QueryGetUserInfoRequest) (*QueryGetUserInfoResponse, error)
+	// Queries a list of UserInfo items.
+	UserInfoAll(context.Context, *QueryAllUserInfoRequest) (*QueryAllUserInfoResponse, error)
+	// Queries a UserRelation by id.
+	UserRelation(context.Context, *QueryGetUserRelationRequest) (*QueryGetUserRelationResponse, error)
+	// Queries a list of UserRelation items.
+	UserRelationAll(context.Context, *QueryAllUserRelationRequest) (*QueryAllUserRelationResponse, error)
+	// Queries a AppInfo by id.
+	AppInfo(context.Context, *QueryGetAppInfoRequest) (*QueryGetAppInfoResponse, error)
+	// Queries a list of AppInfo items.
+	AppInfoAll(context.Context, *QueryAllAppInfoRequest) (*QueryAllAppInfoResponse, error)
+	// Queries a DidRegistry by id.
+	DidRegistry(context.Context, *QueryGetDidRegistryRequest) (*QueryGetDidRegistryResponse, error)
+	// Queries a list of DidRegistry items.
+	DidRegistryAll(context.Context, *QueryAllDidRegistryRequest) (*QueryAllDidRegistryResponse, error)
+	// Queries a UserList by id.
+	UserList(context.Context, *QueryGetUserListRequest) (*QueryGetUserListResponse, error)
+	// Queries a list of UserList items.
+	UserListAll(context.Context, *QueryAllUserListRequest) (*QueryAllUserListResponse, error)
+	// Queries the UserList items owned by a user.
+	UserListsByOwner(context.Context, *QueryUserListsByOwnerRequest) (*QueryUserListsByOwnerResponse, error)
+	// Queries the UserList items containing a user.
+	UserListsByMember(context.Context, *QueryUserListsByMemberRequest) (*QueryUserListsByMemberResponse, error)
+	// Queries a Community by id.
+	Community(context.Context, *QueryGetCommunityRequest) (*QueryGetCommunityResponse, error)
+	// Queries a list of Community items.
+	CommunityAll(context.Context, *QueryAllCommunityRequest) (*QueryAllCommunityResponse, error)
+	// Queries the members of a Community.
+	CommunityMembers(context.Context, *QueryCommunityMembersRequest) (*QueryCommunityMembersResponse, error)
+	// Queries the Community memberships of a DID.
+	CommunitiesByMember(context.Context, *QueryCommunitiesByMemberRequest) (*QueryCommunitiesByMemberResponse, error)
+	// Queries the fee grant templates of an app.
+	AppFeeGrantTemplates(context.Context, *QueryAppFeeGrantTemplatesRequest) (*QueryAppFeeGrantTemplatesResponse, error)
+	// Queries the users granted a fee allowance by an app.
+	AppFeeGrantees(context.Context, *QueryAppFeeGranteesRequest) (*QueryAppFeeGranteesResponse, error)
+	// Queries the apps a user authorized.
+	AppAuthorizationsByUser(context.Context, *QueryAppAuthorizationsByUserRequest) (*QueryAppAuthorizationsByUserResponse, error)
+	// Queries the users who authorized an app.
+	AppAuthorizationsByApp(context.Context, *QueryAppAuthorizationsByAppRequest) (*QueryAppAuthorizationsByAppResponse, error)
+	// Queries the DID sponsoring statistics of an app.
+	AppSponsorStat(context.Context, *QueryAppSponsorStatRequest) (*QueryAppSponsorStatResponse, error)
+	// Queries the DIDs sponsored by an app.
+	AppSponsoredAccounts(context.Context, *QueryAppSponsoredAccountsRequest) (*QueryAppSponsoredAccountsResponse, error)
+	// Queries the denoms issued by an app.
+	AppDenoms(context.Context, *QueryAppDenomsRequest) (*QueryAppDenomsResponse, error)
+	// Queries the royalty of a NFT class, and what it amounts to for a sale price.
+	NFTClassRoyalty(context.Context, *QueryNFTClassRoyaltyRequest) (*QueryNFTClassRoyaltyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UserInfo(ctx context.Context, req *QueryGetUserInfoRequest) (*QueryGetUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
+}
+func (*UnimplementedQueryServer) UserInfoAll(ctx context.Context, req *QueryAllUserInfoRequest) (*QueryAllUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) UserRelation(ctx context.Context, req *QueryGetUserRelationRequest) (*QueryGetUserRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRelation not implemented")
+}
+func (*UnimplementedQueryServer) UserRelationAll(ctx context.Context, req *QueryAllUserRelationRequest) (*QueryAllUserRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRelationAll not implemented")
+}
+func (*UnimplementedQueryServer) AppInfo(ctx context.Context, req *QueryGetAppInfoRequest) (*QueryGetAppInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppInfo not implemented")
+}
+func (*UnimplementedQueryServer) AppInfoAll(ctx context.Context, req *QueryAllAppInfoRequest) (*QueryAllAppInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) DidRegistry(ctx context.Context, req *QueryGetDidRegistryRequest) (*QueryGetDidRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidRegistry not implemented")
+}
+func (*UnimplementedQueryServer) DidRegistryAll(ctx context.Context, req *QueryAllDidRegistryRequest) (*QueryAllDidRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidRegistryAll not implemented")
+}
+func (*UnimplementedQueryServer) UserList(ctx context.Context, req *QueryGetUserListRequest) (*QueryGetUserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserList not implemented")
+}
+func (*UnimplementedQueryServer) UserListAll(ctx context.Context, req *QueryAllUserListRequest) (*QueryAllUserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListAll not implemented")
+}
+func (*UnimplementedQueryServer) UserListsByOwner(ctx context.Context, req *QueryUserListsByOwnerRequest) (*QueryUserListsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) UserListsByMember(ctx context.Context, req *QueryUserListsByMemberRequest) (*QueryUserListsByMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListsByMember not implemented")
+}
+func (*UnimplementedQueryServer) Community(ctx context.Context, req *QueryGetCommunityRequest) (*QueryGetCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Community not implemented")
+}
+func (*UnimplementedQueryServer) CommunityAll(ctx context.Context, req *QueryAllCommunityRequest) (*QueryAllCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityAll not implemented")
+}
+func (*UnimplementedQueryServer) CommunityMembers(ctx context.Context, req *QueryCommunityMembersRequest) (*QueryCommunityMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityMembers not implemented")
+}
+func (*UnimplementedQueryServer) CommunitiesByMember(ctx context.Context, req *QueryCommunitiesByMemberRequest) (*QueryCommunitiesByMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunitiesByMember not implemented")
+}
+func (*UnimplementedQueryServer) AppFeeGrantTemplates(ctx context.Context, req *QueryAppFeeGrantTemplatesRequest) (*QueryAppFeeGrantTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppFeeGrantTemplates not implemented")
+}
+func (*UnimplementedQueryServer) AppFeeGrantees(ctx context.Context, req *QueryAppFeeGranteesRequest) (*QueryAppFeeGranteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppFeeGrantees not implemented")
+}
+func (*UnimplementedQueryServer) AppAuthorizationsByUser(ctx context.Context, req *QueryAppAuthorizationsByUserRequest) (*QueryAppAuthorizationsByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppAuthorizationsByUser not implemented")
+}
+func (*UnimplementedQueryServer) AppAuthorizationsByApp(ctx context.Context, req *QueryAppAuthorizationsByAppRequest) (*QueryAppAuthorizationsByAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppAuthorizationsByApp not implemented")
+}
+func (*UnimplementedQueryServer) AppSponsorStat(ctx context.Context, req *QueryAppSponsorStatRequest) (*QueryAppSponsorStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppSponsorStat not implemented")
+}
+func (*UnimplementedQueryServer) AppSponsoredAccounts(ctx context.Context, req *QueryAppSponsoredAccountsRequest) (*QueryAppSponsoredAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppSponsoredAccounts not implemented")
+}
+func (*UnimplementedQueryServer) AppDenoms(ctx context.Context, req *QueryAppDenomsRequest) (*QueryAppDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppDenoms not implemented")
+}
+func (*UnimplementedQueryServer) NFTClassRoyalty(ctx context.Context, req *QueryNFTClassRoyaltyRequest) (*QueryNFTClassRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTClassRoyalty not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserInfo(ctx, req.(*QueryGetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserInfoAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserInfoAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserInfoAll(ctx, req.(*QueryAllUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUserRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserRelation(ctx, req.(*QueryGetUserRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserRelationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUserRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserRelationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserRelationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserRelationAll(ctx, req.(*QueryAllUserRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAppInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppInfo(ctx, req.(*QueryGetAppInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAppInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppInfoAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppInfoAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppInfoAll(ctx, req.(*QueryAllAppInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDidRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/DidRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidRegistry(ctx, req.(*QueryGetDidRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidRegistryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidRegistryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/DidRegistryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidRegistryAll(ctx, req.(*QueryAllDidRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserList(ctx, req.(*QueryGetUserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserListAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserListAll(ctx, req.(*QueryAllUserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserListsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserListsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserListsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserListsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserListsByOwner(ctx, req.(*QueryUserListsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserListsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserListsByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserListsByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/UserListsByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserListsByMember(ctx, req.(*QueryUserListsByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Community_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Community(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/Community",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Community(ctx, req.(*QueryGetCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/CommunityAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityAll(ctx, req.(*QueryAllCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/CommunityMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityMembers(ctx, req.(*QueryCommunityMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunitiesByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunitiesByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunitiesByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/CommunitiesByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunitiesByMember(ctx, req.(*QueryCommunitiesByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppFeeGrantTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppFeeGrantTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppFeeGrantTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppFeeGrantTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppFeeGrantTemplates(ctx, req.(*QueryAppFeeGrantTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppFeeGrantees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppFeeGranteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppFeeGrantees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppFeeGrantees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppFeeGrantees(ctx, req.(*QueryAppFeeGranteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppAuthorizationsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppAuthorizationsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppAuthorizationsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppAuthorizationsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppAuthorizationsByUser(ctx, req.(*QueryAppAuthorizationsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppAuthorizationsByApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppAuthorizationsByAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppAuthorizationsByApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppAuthorizationsByApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppAuthorizationsByApp(ctx, req.(*QueryAppAuthorizationsByAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppSponsorStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppSponsorStatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppSponsorStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppSponsorStat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppSponsorStat(ctx, req.(*QueryAppSponsorStatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppSponsoredAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppSponsoredAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppSponsoredAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppSponsoredAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppSponsoredAccounts(ctx, req.(*QueryAppSponsoredAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/AppDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppDenoms(ctx, req.(*QueryAppDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTClassRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTClassRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTClassRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Query/NFTClassRoyalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTClassRoyalty(ctx, req.(*QueryNFTClassRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "misesid.misestm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserInfo",
+			Handler:    _Query_UserInfo_Handler,
+		},
+		{
+			MethodName: "UserInfoAll",
+			Handler:    _Query_UserInfoAll_Handler,
+		},
+		{
+			MethodName: "UserRelation",
+			Handler:    _Query_UserRelation_Handler,
+		},
+		{
+			MethodName: "UserRelationAll",
+			Handler:    _Query_UserRelationAll_Handler,
+		},
+		{
+			MethodName: "AppInfo",
+			Handler:    _Query_AppInfo_Handler,
+		},
+		{
+			MethodName: "AppInfoAll",
+			Handler:    _Query_AppInfoAll_Handler,
+		},
+		{
+			MethodName: "DidRegistry",
+			Handler:    _Query_DidRegistry_Handler,
+		},
+		{
+			MethodName: "DidRegistryAll",
+			Handler:    _Query_DidRegistryAll_Handler,
+		},
+		{
+			MethodName: "UserList",
+			Handler:    _Query_UserList_Handler,
+		},
+		{
+			MethodName: "UserListAll",
+			Handler:    _Query_UserListAll_Handler,
+		},
+		{
+			MethodName: "UserListsByOwner",
+			Handler:    _Query_UserListsByOwner_Handler,
+		},
+		{
+			MethodName: "UserListsByMember",
+			Handler:    _Query_UserListsByMember_Handler,
+		},
+		{
+			MethodName: "Community",
+			Handler:    _Query_Community_Handler,
+		},
+		{
+			MethodName: "CommunityAll",
+			Handler:    _Query_CommunityAll_Handler,
+		},
+		{
+			MethodName: "CommunityMembers",
+			Handler:    _Query_CommunityMembers_Handler,
+		},
+		{
+			MethodName: "CommunitiesByMember",
+			Handler:    _Query_CommunitiesByMember_Handler,
+		},
+		{
+			MethodName: "AppFeeGrantTemplates",
+			Handler:    _Query_AppFeeGrantTemplates_Handler,
+		},
+		{
+			MethodName: "AppFeeGrantees",
+			Handler:    _Query_AppFeeGrantees_Handler,
+		},
+		{
+			MethodName: "AppAuthorizationsByUser",
+			Handler:    _Query_AppAuthorizationsByUser_Handler,
+		},
+		{
+			MethodName: "AppAuthorizationsByApp",
+			Handler:    _Query_AppAuthorizationsByApp_Handler,
+		},
+		{
+			MethodName: "AppSponsorStat",
+			Handler:    _Query_AppSponsorStat_Handler,
+		},
+		{
+			MethodName: "AppSponsoredAccounts",
+			Handler:    _Query_AppSponsoredAccounts_Handler,
+		},
+		{
+			MethodName: "AppDenoms",
+			Handler:    _Query_AppDenoms_Handler,
+		},
+		{
+			MethodName: "NFTClassRoyalty",
+			Handler:    _Query_NFTClassRoyalty_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "misestm/v1beta1/query.proto",
+}
+
+func (m *QueryGetUserInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserInfo != nil {
+		{
+			size, err := m.UserInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserInfo) > 0 {
+		for iNdEx := len(m.UserInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserRelationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserRelationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserRelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserRelationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserRelation != nil {
+		{
+			size, err := m.UserRelation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserRelationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserRelationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserRelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserRelationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserRelation) > 0 {
+		for iNdEx := len(m.UserRelation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserRelation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAppInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAppInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAppInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAppInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppInfo != nil {
+		{
+			size, err := m.AppInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppInfo) > 0 {
+		for iNdEx := len(m.AppInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidRegistry != nil {
+		{
+			size, err := m.DidRegistry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidRegistry) > 0 {
+		for iNdEx := len(m.DidRegistry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidRegistry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserList != nil {
+		{
+			size, err := m.UserList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserList) > 0 {
+		for iNdEx := len(m.UserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserListsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserListsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserListsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserListsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserListsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserListsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserList) > 0 {
+		for iNdEx := len(m.UserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserListsByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserListsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserListsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserListsByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserListsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserListsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserList) > 0 {
+		for iNdEx := len(m.UserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommunityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommunityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommunityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommunityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommunityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommunityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Community != nil {
+		{
+			size, err := m.Community.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCommunityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCommunityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCommunityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCommunityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCommunityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCommunityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Community) > 0 {
+		for iNdEx := len(m.Community) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Community[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunitiesByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunitiesByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunitiesByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunitiesByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunitiesByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunitiesByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppFeeGrantTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppFeeGrantTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppFeeGrantTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppFeeGrantTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppFeeGrantTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppFeeGrantTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppFeeGranteesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppFeeGranteesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppFeeGranteesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppFeeGranteesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppFeeGranteesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppFeeGranteesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grantees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppAuthorizationsByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppAuthorizationsByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppAuthorizationsByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppAuthorizationsByUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppAuthorizationsByUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppAuthorizationsByUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppAuthorizationsByAppRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppAuthorizationsByAppRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppAuthorizationsByAppRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppSponsorStatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppSponsorStatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppSponsorStatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppSponsorStatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppSponsorStatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppSponsorStatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stat != nil {
+		{
+			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppSponsoredAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppSponsoredAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppSponsoredAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppSponsoredAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppSponsoredAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppSponsoredAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTClassRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTClassRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTClassRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTClassRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTClassRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTClassRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for iNdEx := len(m.Royalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetUserInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetUserInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserInfo != nil {
+		l = m.UserInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserInfo) > 0 {
+		for _, e := range m.UserInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUserRelationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetUserRelationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserRelation != nil {
+		l = m.UserRelation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserRelationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserRelationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserRelation) > 0 {
+		for _, e := range m.UserRelation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAppInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetAppInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppInfo != nil {
+		l = m.AppInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAppInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAppInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AppInfo) > 0 {
+		for _, e := range m.AppInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDidRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDidRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidRegistry != nil {
+		l = m.DidRegistry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidRegistry) > 0 {
+		for _, e := range m.DidRegistry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUserListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetUserListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserList != nil {
+		l = m.UserList.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserList) > 0 {
+		for _, e := range m.UserList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserListsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserListsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserList) > 0 {
+		for _, e := range m.UserList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserListsByMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserListsByMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserList) > 0 {
+		for _, e := range m.UserList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCommunityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCommunityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Community != nil {
+		l = m.Community.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCommunityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCommunityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Community) > 0 {
+		for _, e := range m.Community {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityId != 0 {
+		n += 1 + sovQuery(uint64(m.CommunityId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunitiesByMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunitiesByMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppFeeGrantTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppFeeGrantTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppFeeGranteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppFeeGranteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for _, e := range m.Grantees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppAuthorizationsByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppAuthorizationsByUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppAuthorizationsByAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppAuthorizationsByAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppSponsorStatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppSponsorStatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stat != nil {
+		l = m.Stat.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppSponsoredAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppSponsoredAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTClassRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTClassRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovQuery(uint64(m.RoyaltyBasisPoints))
+	}
+	if len(m.Royalty) > 0 {
+		for _, e := range m.Royalty {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetUserInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserInfo == nil {
+				m.UserInfo = &UserInfo{}
+			}
+			if err := m.UserInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserInfo = append(m.UserInfo, &UserInfo{})
+			if err := m.UserInfo[len(m.UserInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserRelationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserRelationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserRelationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserRelationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRelation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserRelation == nil {
+				m.UserRelation = &UserRelation{}
+			}
+			if err := m.UserRelation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserRelationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserRelationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserRelationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserRelationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRelation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserRelation = append(m.UserRelation, &UserRelation{})
+			if err := m.UserRelation[len(m.UserRelation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAppInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAppInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAppInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAppInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAppInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppInfo == nil {
+				m.AppInfo = &AppInfo{}
+			}
+			if err := m.AppInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppInfo = append(m.AppInfo, &AppInfo{})
+			if err := m.AppInfo[len(m.AppInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidRegistryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidRegistryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidRegistry == nil {
+				m.DidRegistry = &DidRegistry{}
+			}
+			if err := m.DidRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidRegistryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidRegistryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidRegistry = append(m.DidRegistry, &DidRegistry{})
+			if err := m.DidRegistry[len(m.DidRegistry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserList == nil {
+				m.UserList = &UserList{}
+			}
+			if err := m.UserList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserList = append(m.UserList, &UserList{})
+			if err := m.UserList[len(m.UserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserListsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserListsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserListsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserListsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserListsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserListsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserList = append(m.UserList, &UserList{})
+			if err := m.UserList[len(m.UserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserListsByMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserListsByMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserListsByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserListsByMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserListsByMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserListsByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserList = append(m.UserList, &UserList{})
+			if err := m.UserList[len(m.UserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCommunityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCommunityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCommunityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCommunityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCommunityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCommunityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Community", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Community == nil {
+				m.Community = &Community{}
+			}
+			if err := m.Community.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCommunityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCommunityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCommunityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCommunityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCommunityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCommunityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Community", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Community = append(m.Community, &Community{})
+			if err := m.Community[len(m.Community)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &CommunityMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunitiesByMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunitiesByMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunitiesByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunitiesByMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunitiesByMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunitiesByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &CommunityMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppFeeGrantTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppFeeGrantTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppFeeGrantTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllDidRegistryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllDidRegistryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DidRegistry) > 0 {
-		for _, e := range m.DidRegistry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAppFeeGrantTemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppFeeGrantTemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppFeeGrantTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &AppFeeGrantTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetUserInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppFeeGranteesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,17 +9804,17 @@ func (m *QueryGetUserInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppFeeGranteesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppFeeGranteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2063,11 +9824,60 @@ func (m *QueryGetUserInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2089,7 +9899,7 @@ func (m *QueryGetUserInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppFeeGranteesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2102,25 +9912,59 @@ func (m *QueryGetUserInfoResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppFeeGranteesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppFeeGranteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantees = append(m.Grantees, &AppFeeGrantee{})
+			if err := m.Grantees[len(m.Grantees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2147,10 +9991,10 @@ func (m *QueryGetUserInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserInfo == nil {
-				m.UserInfo = &UserInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.UserInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2175,7 +10019,7 @@ func (m *QueryGetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppAuthorizationsByUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2198,13 +10042,45 @@ func (m *QueryAllUserInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppAuthorizationsByUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppAuthorizationsByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2261,7 +10137,7 @@ func (m *QueryAllUserInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppAuthorizationsByUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2284,15 +10160,15 @@ func (m *QueryAllUserInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppAuthorizationsByUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppAuthorizationsByUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2319,8 +10195,8 @@ func (m *QueryAllUserInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserInfo = append(m.UserInfo, &UserInfo{})
-			if err := m.UserInfo[len(m.UserInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authorizations = append(m.Authorizations, &AppAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2381,7 +10257,7 @@ func (m *QueryAllUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserRelationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppAuthorizationsByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,17 +10280,17 @@ func (m *QueryGetUserRelationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserRelationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppAuthorizationsByAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppAuthorizationsByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2424,11 +10300,60 @@ func (m *QueryGetUserRelationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2450,7 +10375,7 @@ func (m *QueryGetUserRelationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserRelationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppAuthorizationsByAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2473,15 +10398,15 @@ func (m *QueryGetUserRelationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserRelationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppAuthorizationsByAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppAuthorizationsByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRelation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2508,10 +10433,44 @@ func (m *QueryGetUserRelationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserRelation == nil {
-				m.UserRelation = &UserRelation{}
+			m.Authorizations = append(m.Authorizations, &AppAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.UserRelation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2536,7 +10495,7 @@ func (m *QueryGetUserRelationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserRelationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppSponsorStatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,17 +10518,17 @@ func (m *QueryAllUserRelationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRelationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppSponsorStatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppSponsorStatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2579,27 +10538,23 @@ func (m *QueryAllUserRelationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2622,7 +10577,7 @@ func (m *QueryAllUserRelationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserRelationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppSponsorStatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2645,49 +10600,15 @@ func (m *QueryAllUserRelationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRelationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppSponsorStatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppSponsorStatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRelation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UserRelation = append(m.UserRelation, &UserRelation{})
-			if err := m.UserRelation[len(m.UserRelation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2714,10 +10635,10 @@ func (m *QueryAllUserRelationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Stat == nil {
+				m.Stat = &AppSponsorStat{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2742,7 +10663,7 @@ func (m *QueryAllUserRelationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAppInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppSponsoredAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2765,17 +10686,17 @@ func (m *QueryGetAppInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAppInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppSponsoredAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAppInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppSponsoredAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2785,11 +10706,60 @@ func (m *QueryGetAppInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2811,7 +10781,7 @@ func (m *QueryGetAppInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAppInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppSponsoredAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,15 +10804,15 @@ func (m *QueryGetAppInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAppInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppSponsoredAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppSponsoredAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2869,10 +10839,44 @@ func (m *QueryGetAppInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppInfo == nil {
-				m.AppInfo = &AppInfo{}
+			m.Accounts = append(m.Accounts, &MisesAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.AppInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2897,7 +10901,7 @@ func (m *QueryGetAppInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAppInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2920,13 +10924,45 @@ func (m *QueryAllAppInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAppInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAppInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2983,7 +11019,7 @@ func (m *QueryAllAppInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAppInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,15 +11042,15 @@ func (m *QueryAllAppInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAppInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3041,8 +11077,8 @@ func (m *QueryAllAppInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppInfo = append(m.AppInfo, &AppInfo{})
-			if err := m.AppInfo[len(m.AppInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, &AppDenom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3103,7 +11139,7 @@ func (m *QueryAllAppInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidRegistryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTClassRoyaltyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,17 +11162,17 @@ func (m *QueryGetDidRegistryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidRegistryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTClassRoyaltyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTClassRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3146,11 +11182,56 @@ func (m *QueryGetDidRegistryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3172,7 +11253,7 @@ func (m *QueryGetDidRegistryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTClassRoyaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,15 +11276,66 @@ func (m *QueryGetDidRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTClassRoyaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTClassRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidRegistry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
+			}
+			m.RoyaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3230,10 +11362,8 @@ func (m *QueryGetDidRegistryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidRegistry == nil {
-				m.DidRegistry = &DidRegistry{}
-			}
-			if err := m.DidRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Royalty = append(m.Royalty, types.Coin{})
+			if err := m.Royalty[len(m.Royalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3258,7 +11388,7 @@ func (m *QueryGetDidRegistryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidRegistryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3281,48 +11411,12 @@ func (m *QueryAllDidRegistryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidRegistryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3344,7 +11438,7 @@ func (m *QueryAllDidRegistryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3367,49 +11461,15 @@ func (m *QueryAllDidRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidRegistry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DidRegistry = append(m.DidRegistry, &DidRegistry{})
-			if err := m.DidRegistry[len(m.DidRegistry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3436,10 +11496,7 @@ func (m *QueryAllDidRegistryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
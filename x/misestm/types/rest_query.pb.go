@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	nft "github.com/cosmos/cosmos-sdk/x/nft"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -232,6 +234,10 @@ type RestQueryUserRelationRequest struct {
 	MisesUid   string             `protobuf:"bytes,1,opt,name=mises_uid,json=misesUid,proto3" json:"mises_uid,omitempty"`
 	Filter     string             `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sort is either empty (by mises id) or "followed_since" (latest followers first)
+	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// followed_since filters following relations set at or after this unix time
+	FollowedSince int64 `protobuf:"varint,5,opt,name=followed_since,json=followedSince,proto3" json:"followed_since,omitempty"`
 }
 
 func (m *RestQueryUserRelationRequest) Reset()         { *m = RestQueryUserRelationRequest{} }
@@ -288,9 +294,25 @@ func (m *RestQueryUserRelationRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *RestQueryUserRelationRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
+func (m *RestQueryUserRelationRequest) GetFollowedSince() int64 {
+	if m != nil {
+		return m.FollowedSince
+	}
+	return 0
+}
+
 type MisesID struct {
-	MisesId string `protobuf:"bytes,1,opt,name=mises_id,json=misesId,proto3" json:"mises_id,omitempty"`
-	RelType string `protobuf:"bytes,2,opt,name=rel_type,json=relType,proto3" json:"rel_type,omitempty"`
+	MisesId     string         `protobuf:"bytes,1,opt,name=mises_id,json=misesId,proto3" json:"mises_id,omitempty"`
+	RelType     string         `protobuf:"bytes,2,opt,name=rel_type,json=relType,proto3" json:"rel_type,omitempty"`
+	RelTypeBits uint64         `protobuf:"varint,3,opt,name=rel_type_bits,json=relTypeBits,proto3" json:"rel_type_bits,omitempty"`
+	Flags       []RelationFlag `protobuf:"bytes,4,rep,name=flags,proto3" json:"flags"`
 }
 
 func (m *MisesID) Reset()         { *m = MisesID{} }
@@ -340,6 +362,20 @@ func (m *MisesID) GetRelType() string {
 	return ""
 }
 
+func (m *MisesID) GetRelTypeBits() uint64 {
+	if m != nil {
+		return m.RelTypeBits
+	}
+	return 0
+}
+
+func (m *MisesID) GetFlags() []RelationFlag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
 type RestQueryUserRelationResponse struct {
 	MisesList  []*MisesID          `protobuf:"bytes,1,rep,name=mises_list,json=misesList,proto3" json:"mises_list,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -437,8 +473,12 @@ func (m *RestQueryAppRequest) GetMisesAppid() string {
 }
 
 type RestQueryAppResponse struct {
-	PubInfo *PublicAppInfo `protobuf:"bytes,1,opt,name=pub_info,json=pubInfo,proto3" json:"pub_info,omitempty"`
-	Version uint64         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	PubInfo            *PublicAppInfo `protobuf:"bytes,1,opt,name=pub_info,json=pubInfo,proto3" json:"pub_info,omitempty"`
+	Version            uint64         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Verified           bool           `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerificationReason string         `protobuf:"bytes,4,opt,name=verification_reason,json=verificationReason,proto3" json:"verification_reason,omitempty"`
+	Owner              string         `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Admins             []string       `protobuf:"bytes,6,rep,name=admins,proto3" json:"admins,omitempty"`
 }
 
 func (m *RestQueryAppResponse) Reset()         { *m = RestQueryAppResponse{} }
@@ -488,6 +528,297 @@ func (m *RestQueryAppResponse) GetVersion() uint64 {
 	return 0
 }
 
+func (m *RestQueryAppResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *RestQueryAppResponse) GetVerificationReason() string {
+	if m != nil {
+		return m.VerificationReason
+	}
+	return ""
+}
+
+func (m *RestQueryAppResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RestQueryAppResponse) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+type RestQueryAppByDomainRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (m *RestQueryAppByDomainRequest) Reset()         { *m = RestQueryAppByDomainRequest{} }
+func (m *RestQueryAppByDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*RestQueryAppByDomainRequest) ProtoMessage()    {}
+func (*RestQueryAppByDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2297eb53b474b55, []int{9}
+}
+func (m *RestQueryAppByDomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestQueryAppByDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestQueryAppByDomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestQueryAppByDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestQueryAppByDomainRequest.Merge(m, src)
+}
+func (m *RestQueryAppByDomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestQueryAppByDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestQueryAppByDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestQueryAppByDomainRequest proto.InternalMessageInfo
+
+func (m *RestQueryAppByDomainRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type RestQueryAppByDomainResponse struct {
+	MisesAppid string                `protobuf:"bytes,1,opt,name=mises_appid,json=misesAppid,proto3" json:"mises_appid,omitempty"`
+	App        *RestQueryAppResponse `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+}
+
+func (m *RestQueryAppByDomainResponse) Reset()         { *m = RestQueryAppByDomainResponse{} }
+func (m *RestQueryAppByDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*RestQueryAppByDomainResponse) ProtoMessage()    {}
+func (*RestQueryAppByDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2297eb53b474b55, []int{10}
+}
+func (m *RestQueryAppByDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestQueryAppByDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestQueryAppByDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestQueryAppByDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestQueryAppByDomainResponse.Merge(m, src)
+}
+func (m *RestQueryAppByDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestQueryAppByDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestQueryAppByDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestQueryAppByDomainResponse proto.InternalMessageInfo
+
+func (m *RestQueryAppByDomainResponse) GetMisesAppid() string {
+	if m != nil {
+		return m.MisesAppid
+	}
+	return ""
+}
+
+func (m *RestQueryAppByDomainResponse) GetApp() *RestQueryAppResponse {
+	if m != nil {
+		return m.App
+	}
+	return nil
+}
+
+type RestQueryNFTRequest struct {
+	MisesId string `protobuf:"bytes,1,opt,name=mises_id,json=misesId,proto3" json:"mises_id,omitempty"`
+	// only list the nfts of this class when set
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// pagination runs over the nfts owned by the mises id, in class then nft id order
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RestQueryNFTRequest) Reset()         { *m = RestQueryNFTRequest{} }
+func (m *RestQueryNFTRequest) String() string { return proto.CompactTextString(m) }
+func (*RestQueryNFTRequest) ProtoMessage()    {}
+func (*RestQueryNFTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2297eb53b474b55, []int{11}
+}
+func (m *RestQueryNFTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestQueryNFTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestQueryNFTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestQueryNFTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestQueryNFTRequest.Merge(m, src)
+}
+func (m *RestQueryNFTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestQueryNFTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestQueryNFTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestQueryNFTRequest proto.InternalMessageInfo
+
+func (m *RestQueryNFTRequest) GetMisesId() string {
+	if m != nil {
+		return m.MisesId
+	}
+	return ""
+}
+
+func (m *RestQueryNFTRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *RestQueryNFTRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type NFTsOfClass struct {
+	ClassId string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Nfts    []nft.NFT `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
+}
+
+func (m *NFTsOfClass) Reset()         { *m = NFTsOfClass{} }
+func (m *NFTsOfClass) String() string { return proto.CompactTextString(m) }
+func (*NFTsOfClass) ProtoMessage()    {}
+func (*NFTsOfClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2297eb53b474b55, []int{12}
+}
+func (m *NFTsOfClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTsOfClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTsOfClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTsOfClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTsOfClass.Merge(m, src)
+}
+func (m *NFTsOfClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTsOfClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTsOfClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTsOfClass proto.InternalMessageInfo
+
+func (m *NFTsOfClass) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTsOfClass) GetNfts() []nft.NFT {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+type RestQueryNFTResponse struct {
+	// the nfts of the page grouped by class, only classes with nfts owned by the mises id are listed
+	Classes    []NFTsOfClass       `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RestQueryNFTResponse) Reset()         { *m = RestQueryNFTResponse{} }
+func (m *RestQueryNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*RestQueryNFTResponse) ProtoMessage()    {}
+func (*RestQueryNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2297eb53b474b55, []int{13}
+}
+func (m *RestQueryNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestQueryNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestQueryNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestQueryNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestQueryNFTResponse.Merge(m, src)
+}
+func (m *RestQueryNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestQueryNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestQueryNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestQueryNFTResponse proto.InternalMessageInfo
+
+func (m *RestQueryNFTResponse) GetClasses() []NFTsOfClass {
+	if m != nil {
+		return m.Classes
+	}
+	return nil
+}
+
+func (m *RestQueryNFTResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type RestQueryTxRequest struct {
 	Txhash string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
 }
@@ -496,7 +827,7 @@ func (m *RestQueryTxRequest) Reset()         { *m = RestQueryTxRequest{} }
 func (m *RestQueryTxRequest) String() string { return proto.CompactTextString(m) }
 func (*RestQueryTxRequest) ProtoMessage()    {}
 func (*RestQueryTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2297eb53b474b55, []int{9}
+	return fileDescriptor_c2297eb53b474b55, []int{14}
 }
 func (m *RestQueryTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +873,7 @@ func (m *RestTxResponse) Reset()         { *m = RestTxResponse{} }
 func (m *RestTxResponse) String() string { return proto.CompactTextString(m) }
 func (*RestTxResponse) ProtoMessage()    {}
 func (*RestTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2297eb53b474b55, []int{10}
+	return fileDescriptor_c2297eb53b474b55, []int{15}
 }
 func (m *RestTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +925,7 @@ func (m *RestQueryAppFeeGrantRequest) Reset()         { *m = RestQueryAppFeeGran
 func (m *RestQueryAppFeeGrantRequest) String() string { return proto.CompactTextString(m) }
 func (*RestQueryAppFeeGrantRequest) ProtoMessage()    {}
 func (*RestQueryAppFeeGrantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2297eb53b474b55, []int{11}
+	return fileDescriptor_c2297eb53b474b55, []int{16}
 }
 func (m *RestQueryAppFeeGrantRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,16 +969,27 @@ func (m *RestQueryAppFeeGrantRequest) GetMisesUid() string {
 }
 
 type AppFeeGrant struct {
+	// set only when the spend limit has exactly one coin, use spend_limits instead
 	SpendLimit *types.Coin   `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
 	Period     time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
 	Expiration *time.Time    `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// basic or periodic
+	AllowanceType string `protobuf:"bytes,4,opt,name=allowance_type,json=allowanceType,proto3" json:"allowance_type,omitempty"`
+	// empty means unlimited
+	SpendLimits      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limits,json=spendLimits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limits"`
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// what can still be spent in the current period
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	PeriodReset    *time.Time                               `protobuf:"bytes,8,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// set when the allowance only pays for these messages
+	AllowedMessages []string `protobuf:"bytes,9,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
 }
 
 func (m *AppFeeGrant) Reset()         { *m = AppFeeGrant{} }
 func (m *AppFeeGrant) String() string { return proto.CompactTextString(m) }
 func (*AppFeeGrant) ProtoMessage()    {}
 func (*AppFeeGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2297eb53b474b55, []int{12}
+	return fileDescriptor_c2297eb53b474b55, []int{17}
 }
 func (m *AppFeeGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,6 +1039,48 @@ func (m *AppFeeGrant) GetExpiration() *time.Time {
 	return nil
 }
 
+func (m *AppFeeGrant) GetAllowanceType() string {
+	if m != nil {
+		return m.AllowanceType
+	}
+	return ""
+}
+
+func (m *AppFeeGrant) GetSpendLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return nil
+}
+
+func (m *AppFeeGrant) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *AppFeeGrant) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *AppFeeGrant) GetPeriodReset() *time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return nil
+}
+
+func (m *AppFeeGrant) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
 type RestQueryAppFeeGrantResponse struct {
 	Grant *AppFeeGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
 }
@@ -705,7 +1089,7 @@ func (m *RestQueryAppFeeGrantResponse) Reset()         { *m = RestQueryAppFeeGra
 func (m *RestQueryAppFeeGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*RestQueryAppFeeGrantResponse) ProtoMessage()    {}
 func (*RestQueryAppFeeGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2297eb53b474b55, []int{13}
+	return fileDescriptor_c2297eb53b474b55, []int{18}
 }
 func (m *RestQueryAppFeeGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,6 +1135,11 @@ func init() {
 	proto.RegisterType((*RestQueryUserRelationResponse)(nil), "misesid.misestm.v1beta1.RestQueryUserRelationResponse")
 	proto.RegisterType((*RestQueryAppRequest)(nil), "misesid.misestm.v1beta1.RestQueryAppRequest")
 	proto.RegisterType((*RestQueryAppResponse)(nil), "misesid.misestm.v1beta1.RestQueryAppResponse")
+	proto.RegisterType((*RestQueryAppByDomainRequest)(nil), "misesid.misestm.v1beta1.RestQueryAppByDomainRequest")
+	proto.RegisterType((*RestQueryAppByDomainResponse)(nil), "misesid.misestm.v1beta1.RestQueryAppByDomainResponse")
+	proto.RegisterType((*RestQueryNFTRequest)(nil), "misesid.misestm.v1beta1.RestQueryNFTRequest")
+	proto.RegisterType((*NFTsOfClass)(nil), "misesid.misestm.v1beta1.NFTsOfClass")
+	proto.RegisterType((*RestQueryNFTResponse)(nil), "misesid.misestm.v1beta1.RestQueryNFTResponse")
 	proto.RegisterType((*RestQueryTxRequest)(nil), "misesid.misestm.v1beta1.RestQueryTxRequest")
 	proto.RegisterType((*RestTxResponse)(nil), "misesid.misestm.v1beta1.RestTxResponse")
 	proto.RegisterType((*RestQueryAppFeeGrantRequest)(nil), "misesid.misestm.v1beta1.RestQueryAppFeeGrantRequest")
@@ -761,71 +1150,101 @@ func init() {
 func init() { proto.RegisterFile("misestm/v1beta1/rest_query.proto", fileDescriptor_c2297eb53b474b55) }
 
 var fileDescriptor_c2297eb53b474b55 = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xba, 0xc1, 0x1f, 0xef, 0x52, 0x44, 0x27, 0x69, 0x48, 0x9c, 0xd4, 0x31, 0x0b, 0x6a,
-	0x23, 0x48, 0x77, 0x69, 0x4a, 0x7b, 0x28, 0x87, 0xe2, 0x34, 0xa4, 0xaa, 0x54, 0x24, 0x58, 0xa5,
-	0x97, 0x22, 0x61, 0xad, 0xbd, 0x63, 0x67, 0x84, 0xbd, 0x33, 0x9d, 0x99, 0x8d, 0x1c, 0x71, 0x40,
-	0xe2, 0x17, 0x54, 0xe2, 0x82, 0x04, 0x07, 0xae, 0xfc, 0x05, 0xc4, 0x0f, 0xc8, 0xb1, 0x12, 0x17,
-	0x4e, 0x80, 0x12, 0x7e, 0x48, 0xb5, 0x33, 0xb3, 0xeb, 0xb1, 0x53, 0x37, 0xee, 0x6d, 0xc7, 0xf3,
-	0xbc, 0xcf, 0xfb, 0xbc, 0x9f, 0x63, 0x68, 0x0e, 0x89, 0xc0, 0x42, 0x0e, 0x83, 0xa3, 0x5b, 0x1d,
-	0x2c, 0xa3, 0x5b, 0x01, 0xc7, 0x42, 0xb6, 0x9f, 0xa5, 0x98, 0x1f, 0xfb, 0x8c, 0x53, 0x49, 0xd1,
-	0x7b, 0x0a, 0x41, 0x62, 0xdf, 0x20, 0x7d, 0x83, 0xac, 0x2f, 0xf7, 0x69, 0x9f, 0x2a, 0x4c, 0x90,
-	0x7d, 0x69, 0x78, 0x7d, 0xa3, 0x4f, 0x69, 0x7f, 0x80, 0x83, 0x88, 0x91, 0x20, 0x4a, 0x12, 0x2a,
-	0x23, 0x49, 0x68, 0x22, 0xcc, 0x6d, 0xc3, 0xdc, 0xaa, 0x53, 0x27, 0xed, 0x05, 0x71, 0xca, 0x15,
-	0xc0, 0xdc, 0x6f, 0x4e, 0xdf, 0x4b, 0x32, 0xc4, 0x42, 0x46, 0x43, 0x66, 0x00, 0x1f, 0x75, 0xa9,
-	0x18, 0x52, 0x11, 0x74, 0x22, 0x81, 0x03, 0x25, 0xb3, 0x50, 0xce, 0xa2, 0x3e, 0x49, 0x6c, 0xb2,
-	0x0f, 0x6c, 0x6c, 0xd4, 0xe9, 0x92, 0x02, 0x9a, 0x1d, 0x72, 0x45, 0x36, 0x28, 0xbf, 0xef, 0x52,
-	0x92, 0x93, 0xbc, 0x3f, 0x9d, 0xa0, 0x3d, 0x12, 0x87, 0xb8, 0x4f, 0x84, 0xcc, 0x33, 0x54, 0x6f,
-	0x4c, 0x43, 0x9e, 0x08, 0xcc, 0x1f, 0x25, 0xbd, 0x3c, 0x25, 0xde, 0xab, 0xee, 0x43, 0x3c, 0xb0,
-	0xb5, 0x5e, 0x9b, 0xc6, 0xb4, 0x18, 0x1b, 0x53, 0x78, 0x9f, 0xc0, 0x52, 0x88, 0x85, 0xfc, 0x3a,
-	0x0b, 0x58, 0x09, 0x78, 0x96, 0x62, 0x21, 0xd1, 0x1a, 0x54, 0x95, 0x5d, 0x9b, 0xc4, 0xab, 0x4e,
-	0xd3, 0xd9, 0xaa, 0x85, 0x15, 0x75, 0x7e, 0x14, 0x7b, 0xdf, 0xc2, 0xf2, 0xa4, 0x85, 0x60, 0x34,
-	0x11, 0x18, 0xed, 0x83, 0x1b, 0x8f, 0x23, 0x50, 0x56, 0xee, 0xce, 0x87, 0xfe, 0x8c, 0x22, 0xfb,
-	0x56, 0xb4, 0xa1, 0x6d, 0xe8, 0xdd, 0xb6, 0xf8, 0x75, 0x3c, 0x5a, 0xd2, 0x3a, 0xd4, 0xb4, 0xa4,
-	0xb4, 0xd0, 0xa4, 0x35, 0x3e, 0x21, 0xb1, 0xf7, 0x87, 0x03, 0x57, 0xa7, 0xac, 0x8c, 0xac, 0x5d,
-	0xa8, 0xb2, 0xb4, 0xd3, 0x26, 0x49, 0x8f, 0x1a, 0x4d, 0x37, 0x66, 0x6a, 0xfa, 0x2a, 0xed, 0x0c,
-	0x48, 0x37, 0x4f, 0x72, 0x58, 0x61, 0x69, 0x27, 0xfb, 0x40, 0x0f, 0xa0, 0xca, 0x38, 0xd1, 0x1c,
-	0x25, 0xc5, 0xb1, 0x35, 0x9b, 0x83, 0x93, 0xa3, 0x48, 0x62, 0x8b, 0x84, 0x13, 0x45, 0xb2, 0x0a,
-	0x95, 0x23, 0xcc, 0x05, 0xa1, 0xc9, 0xea, 0xa5, 0xa6, 0xb3, 0xb5, 0x18, 0xe6, 0x47, 0xef, 0x17,
-	0x07, 0x36, 0xa6, 0xc4, 0xeb, 0x12, 0xce, 0x13, 0x3a, 0x5a, 0x81, 0x72, 0x8f, 0x0c, 0x24, 0xe6,
-	0x4a, 0x5a, 0x2d, 0x34, 0x27, 0xb4, 0x0f, 0x30, 0x6e, 0x5c, 0xe5, 0xd2, 0xdd, 0xb9, 0xee, 0xeb,
-	0xa6, 0xf4, 0xb3, 0xa6, 0xf4, 0xf5, 0x30, 0x16, 0xc2, 0xa3, 0x3e, 0x36, 0x0e, 0x43, 0xcb, 0xd2,
-	0xbb, 0x0f, 0x95, 0x2f, 0x55, 0xe9, 0xf7, 0x5e, 0xd3, 0x15, 0xd9, 0x15, 0xc7, 0x83, 0xb6, 0x3c,
-	0x66, 0xd8, 0xe8, 0xa8, 0x70, 0x3c, 0x38, 0x38, 0x66, 0xd8, 0xfb, 0xdd, 0x81, 0x6b, 0x33, 0xc2,
-	0x33, 0x35, 0xba, 0x0f, 0xa0, 0x79, 0x07, 0x44, 0xc8, 0x55, 0xa7, 0x79, 0x69, 0xcb, 0xdd, 0x69,
-	0xce, 0xcc, 0xb0, 0x51, 0x13, 0xea, 0x9c, 0x3c, 0x26, 0x42, 0xa2, 0x87, 0x13, 0xb1, 0x96, 0x4c,
-	0x99, 0x2f, 0x8a, 0x55, 0x7b, 0x9f, 0x08, 0xf6, 0xae, 0x35, 0x0e, 0x2d, 0xc6, 0xf2, 0x02, 0x6c,
-	0x82, 0xab, 0x05, 0x46, 0x8c, 0x15, 0xb1, 0x6b, 0xcd, 0xad, 0xec, 0x17, 0x4f, 0x58, 0x4d, 0xab,
-	0xec, 0x4c, 0x64, 0xad, 0x73, 0xdd, 0x77, 0xfd, 0x82, 0xee, 0x33, 0xe3, 0x39, 0x6e, 0x3e, 0xab,
-	0x6f, 0x4a, 0x93, 0x7d, 0xb3, 0x0d, 0xa8, 0x70, 0x7a, 0x30, 0xca, 0xb5, 0xae, 0x40, 0x59, 0x8e,
-	0x0e, 0x23, 0x71, 0x68, 0x64, 0x9a, 0x93, 0xf7, 0x1d, 0xbc, 0x93, 0xa1, 0x33, 0xa0, 0x11, 0xf7,
-	0x05, 0xb8, 0x72, 0xd4, 0xe6, 0xe6, 0x58, 0x4c, 0xac, 0x9d, 0x36, 0xb5, 0xcf, 0x72, 0x81, 0x63,
-	0xd3, 0x10, 0xe4, 0x98, 0x06, 0xc1, 0x62, 0x97, 0xc6, 0xba, 0xec, 0x97, 0x43, 0xf5, 0xed, 0x7d,
-	0x03, 0xeb, 0x76, 0x3e, 0xf6, 0x31, 0x7e, 0xc8, 0xa3, 0x44, 0xce, 0x9b, 0xcf, 0xc9, 0x8e, 0x2f,
-	0x4d, 0x0d, 0xfb, 0x89, 0x03, 0xae, 0x45, 0x8a, 0xee, 0x81, 0x2b, 0x18, 0x4e, 0xe2, 0xf6, 0x80,
-	0x0c, 0x89, 0x34, 0x71, 0xac, 0x4d, 0xc4, 0x91, 0x87, 0xf0, 0x80, 0x92, 0x24, 0x04, 0x85, 0x7e,
-	0x9c, 0x81, 0xd1, 0x67, 0x50, 0x66, 0x98, 0x13, 0x1a, 0x9b, 0xae, 0x59, 0xf3, 0xf5, 0x43, 0xe1,
-	0xe7, 0x0f, 0x85, 0xbf, 0x67, 0x1e, 0x92, 0xdd, 0xea, 0xc9, 0x3f, 0x9b, 0x0b, 0x3f, 0xff, 0xbb,
-	0xe9, 0x84, 0xc6, 0x04, 0x7d, 0x0e, 0x80, 0x47, 0x8c, 0x70, 0x7b, 0xc4, 0xea, 0xe7, 0x08, 0x0e,
-	0xf2, 0x97, 0x66, 0x77, 0xf1, 0x79, 0x66, 0x6d, 0xd9, 0x78, 0x4f, 0xad, 0xc9, 0x9f, 0xc8, 0x93,
-	0xc9, 0xed, 0x3d, 0x78, 0xab, 0x9f, 0xfd, 0x70, 0xe1, 0x3a, 0xb5, 0x8d, 0xb5, 0xc9, 0xce, 0x9f,
-	0x65, 0xa8, 0x15, 0xe4, 0xe8, 0x7b, 0xa8, 0xe6, 0x2b, 0x1b, 0x6d, 0xcf, 0xa4, 0x79, 0xc5, 0x5b,
-	0x50, 0xbf, 0x39, 0x27, 0x5a, 0x4b, 0xf6, 0xd0, 0x8f, 0x7f, 0xfd, 0xff, 0x53, 0xe9, 0x6d, 0x04,
-	0x81, 0x82, 0x07, 0x31, 0x89, 0xd1, 0x0f, 0x50, 0x2b, 0xa6, 0x1f, 0xcd, 0xc1, 0x67, 0xed, 0xfd,
-	0xba, 0x3f, 0x2f, 0xdc, 0xf8, 0x5f, 0x52, 0xfe, 0x2f, 0x23, 0xd7, 0xf8, 0x4f, 0x33, 0x9f, 0xbf,
-	0x39, 0x70, 0xe5, 0xdc, 0xfe, 0x41, 0x77, 0xe6, 0xa5, 0x9e, 0x58, 0xc7, 0xf5, 0xbb, 0x6f, 0x6a,
-	0x66, 0x94, 0x6d, 0x28, 0x65, 0x2b, 0x68, 0xd9, 0x52, 0x16, 0xf0, 0x5c, 0x4c, 0x5e, 0xa0, 0x16,
-	0x63, 0xf3, 0x14, 0x68, 0xbc, 0x9d, 0xe6, 0x29, 0x90, 0xb5, 0x93, 0xce, 0x15, 0x28, 0x62, 0x0c,
-	0xfd, 0xea, 0xc0, 0xbb, 0xd3, 0x4d, 0x88, 0x3e, 0x9d, 0x8b, 0x77, 0x6a, 0xb6, 0xeb, 0x77, 0xde,
-	0xd0, 0xca, 0xa8, 0x5a, 0x57, 0xaa, 0xae, 0xa2, 0xa5, 0xb1, 0xaa, 0xa0, 0x87, 0xb1, 0x6a, 0x65,
-	0xc4, 0xa1, 0x62, 0xb6, 0x1c, 0xfa, 0xf8, 0x62, 0xfa, 0x62, 0x17, 0xd6, 0x6f, 0xbc, 0x16, 0x3c,
-	0xde, 0x67, 0xde, 0x15, 0xe5, 0xdd, 0x45, 0x35, 0xe3, 0x5d, 0x8e, 0x76, 0xf7, 0x4f, 0x4e, 0x1b,
-	0xce, 0x8b, 0xd3, 0x86, 0xf3, 0xdf, 0x69, 0xc3, 0x79, 0x7e, 0xd6, 0x58, 0x78, 0x71, 0xd6, 0x58,
-	0xf8, 0xfb, 0xac, 0xb1, 0xf0, 0x74, 0xbb, 0x4f, 0xe4, 0x61, 0xda, 0xf1, 0xbb, 0x74, 0xa8, 0xe1,
-	0x37, 0x49, 0x6c, 0x3e, 0xe4, 0x30, 0x18, 0x05, 0xf9, 0x3f, 0xae, 0xec, 0x31, 0x14, 0x9d, 0xb2,
-	0x5a, 0x04, 0xb7, 0x5f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x13, 0x59, 0x48, 0x14, 0x11, 0x0b, 0x00,
-	0x00,
+	// 1490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x26, 0x4e, 0x62, 0xbf, 0x25, 0x7c, 0x61, 0x12, 0xc0, 0x98, 0xe0, 0xf8, 0xbb, 0x2d,
+	0x90, 0xb6, 0x89, 0x17, 0x42, 0xc3, 0x81, 0x1e, 0x68, 0x9c, 0xd4, 0x08, 0x09, 0xd2, 0x76, 0x31,
+	0x17, 0x90, 0x6a, 0x8d, 0xbd, 0x63, 0x33, 0xc2, 0xde, 0x5d, 0x76, 0xc6, 0xc1, 0x51, 0x0f, 0xad,
+	0xfa, 0x17, 0x20, 0x71, 0xe9, 0xa1, 0x95, 0x7a, 0x69, 0xa5, 0xf6, 0x4f, 0xe8, 0xad, 0x37, 0x8e,
+	0x48, 0x95, 0xaa, 0xf6, 0x52, 0x2a, 0xe8, 0xb9, 0x7f, 0x43, 0xb5, 0x33, 0xb3, 0xeb, 0xb1, 0x53,
+	0xc7, 0x8b, 0x94, 0x93, 0x77, 0xe6, 0xfd, 0xfa, 0xbc, 0x5f, 0xf3, 0x9e, 0xa1, 0xd4, 0xa5, 0x8c,
+	0x30, 0xde, 0xb5, 0xf7, 0xae, 0x34, 0x08, 0xc7, 0x57, 0xec, 0x90, 0x30, 0x5e, 0x7f, 0xdc, 0x23,
+	0xe1, 0x7e, 0x39, 0x08, 0x7d, 0xee, 0xa3, 0x33, 0x82, 0x83, 0xba, 0x65, 0xc5, 0x59, 0x56, 0x9c,
+	0x85, 0xa5, 0xb6, 0xdf, 0xf6, 0x05, 0x8f, 0x1d, 0x7d, 0x49, 0xf6, 0xc2, 0x72, 0xdb, 0xf7, 0xdb,
+	0x1d, 0x62, 0xe3, 0x80, 0xda, 0xd8, 0xf3, 0x7c, 0x8e, 0x39, 0xf5, 0x3d, 0xa6, 0xa8, 0x45, 0x45,
+	0x15, 0xa7, 0x46, 0xaf, 0x65, 0xbb, 0xbd, 0x50, 0x30, 0x28, 0xfa, 0xca, 0x28, 0x9d, 0xd3, 0x2e,
+	0x61, 0x1c, 0x77, 0x03, 0xc5, 0xf0, 0x6e, 0xd3, 0x67, 0x5d, 0x9f, 0xd9, 0x0d, 0xcc, 0x88, 0x2d,
+	0x60, 0x26, 0xc8, 0x03, 0xdc, 0xa6, 0x9e, 0xae, 0xec, 0x2d, 0x9d, 0x17, 0x37, 0x9a, 0x34, 0x61,
+	0x8d, 0x0e, 0x31, 0x22, 0x9d, 0x29, 0xa6, 0x37, 0x7d, 0x1a, 0x2b, 0x59, 0x56, 0x74, 0xaf, 0xc5,
+	0x13, 0xb2, 0xd7, 0xe2, 0x8a, 0xfa, 0xff, 0xd1, 0xf0, 0xed, 0x50, 0xd7, 0x21, 0x6d, 0xca, 0x78,
+	0x1c, 0xbf, 0x42, 0x71, 0x94, 0xe5, 0x1e, 0x23, 0xe1, 0x2d, 0xaf, 0x15, 0x07, 0xcc, 0xfa, 0x2f,
+	0xba, 0x43, 0x3a, 0xba, 0x27, 0xe7, 0x47, 0x79, 0xb6, 0x82, 0x60, 0xa0, 0xc2, 0xba, 0x0c, 0x8b,
+	0x0e, 0x61, 0xfc, 0xd3, 0x28, 0x1c, 0x02, 0xc0, 0xe3, 0x1e, 0x61, 0x1c, 0x9d, 0x85, 0xac, 0x90,
+	0xab, 0x53, 0x37, 0x6f, 0x94, 0x8c, 0xd5, 0x9c, 0x33, 0x2f, 0xce, 0xb7, 0x5c, 0xeb, 0x33, 0x58,
+	0x1a, 0x96, 0x60, 0x81, 0xef, 0x31, 0x82, 0xaa, 0x60, 0xba, 0x03, 0x0f, 0x84, 0x94, 0xb9, 0xf1,
+	0x76, 0x79, 0x4c, 0x09, 0x94, 0x35, 0x6f, 0x1d, 0x5d, 0xd0, 0xba, 0xaa, 0xe9, 0x97, 0xfe, 0x48,
+	0x48, 0xe7, 0x20, 0x27, 0x21, 0xf5, 0x12, 0x4c, 0x12, 0xe3, 0x3d, 0xea, 0x5a, 0x3f, 0x1b, 0x70,
+	0x6a, 0x44, 0x4a, 0xc1, 0xaa, 0x40, 0x36, 0xe8, 0x35, 0xea, 0xd4, 0x6b, 0xf9, 0x0a, 0xd3, 0xa5,
+	0xb1, 0x98, 0x3e, 0xe9, 0x35, 0x3a, 0xb4, 0x19, 0x07, 0xd9, 0x99, 0x0f, 0x7a, 0x8d, 0xe8, 0x03,
+	0x6d, 0x43, 0x36, 0x08, 0xa9, 0xd4, 0x31, 0x2d, 0x74, 0xac, 0x8e, 0xd7, 0x11, 0xd2, 0x3d, 0xcc,
+	0x89, 0xa6, 0x24, 0xa4, 0x42, 0x49, 0x1e, 0xe6, 0xf7, 0x48, 0xc8, 0xa8, 0xef, 0xe5, 0x67, 0x4a,
+	0xc6, 0x6a, 0xc6, 0x89, 0x8f, 0xd6, 0x6f, 0x06, 0x2c, 0x8f, 0x80, 0x97, 0x29, 0x4c, 0xe3, 0x3a,
+	0x3a, 0x0d, 0x73, 0x2d, 0xda, 0xe1, 0x24, 0x14, 0xd0, 0x72, 0x8e, 0x3a, 0xa1, 0x2a, 0xc0, 0xa0,
+	0xac, 0x85, 0x49, 0x73, 0xe3, 0x62, 0x59, 0x96, 0x64, 0x39, 0x2a, 0xd9, 0xb2, 0x6c, 0xd5, 0x04,
+	0x38, 0x6e, 0x13, 0x65, 0xd0, 0xd1, 0x24, 0x11, 0x82, 0x0c, 0xf3, 0x43, 0x9e, 0xcf, 0x08, 0xed,
+	0xe2, 0x1b, 0x5d, 0x80, 0xe3, 0x2d, 0xbf, 0xd3, 0xf1, 0x9f, 0x10, 0xb7, 0xce, 0xa8, 0xd7, 0x24,
+	0xf9, 0xd9, 0x92, 0xb1, 0x3a, 0xe3, 0x2c, 0xc4, 0xb7, 0x77, 0xa3, 0x4b, 0xeb, 0x07, 0x03, 0xe6,
+	0xef, 0x88, 0xb2, 0xd9, 0x39, 0xa4, 0xa2, 0x22, 0x52, 0x48, 0x3a, 0x75, 0xbe, 0x1f, 0x10, 0xe5,
+	0xc3, 0x7c, 0x48, 0x3a, 0xb5, 0xfd, 0x80, 0x20, 0x0b, 0x16, 0x62, 0x52, 0xbd, 0x41, 0x39, 0x53,
+	0xa1, 0x33, 0x15, 0xbd, 0x42, 0x39, 0x43, 0x5b, 0x30, 0xdb, 0xea, 0xe0, 0x36, 0xcb, 0x67, 0x4a,
+	0x33, 0xab, 0xe6, 0xc6, 0x85, 0xb1, 0xa9, 0x89, 0xc3, 0x5a, 0xed, 0xe0, 0x76, 0x25, 0xf3, 0xfc,
+	0xcf, 0x95, 0x29, 0x47, 0x4a, 0x5a, 0x3f, 0x1a, 0x70, 0x7e, 0x4c, 0x06, 0x54, 0x19, 0xdd, 0x00,
+	0x90, 0xf0, 0x3b, 0x94, 0xf1, 0xbc, 0x21, 0x2c, 0x95, 0xc6, 0x5a, 0x52, 0x4e, 0x3b, 0x32, 0x6d,
+	0xb7, 0x29, 0xe3, 0xe8, 0xe6, 0x50, 0x3a, 0xa6, 0x55, 0x25, 0x4e, 0x4a, 0x87, 0xb4, 0xae, 0xe7,
+	0xc3, 0xba, 0xa6, 0x75, 0xec, 0x56, 0x10, 0xc4, 0x35, 0xb2, 0x02, 0xa6, 0x04, 0x88, 0x83, 0x20,
+	0x09, 0xb1, 0xc4, 0xbc, 0x15, 0xdd, 0x58, 0xff, 0x18, 0xb0, 0x34, 0x2c, 0xa8, 0x5c, 0xdb, 0x3a,
+	0xd0, 0x21, 0x17, 0x27, 0x74, 0x88, 0x7a, 0x42, 0x06, 0x0d, 0xa2, 0xd5, 0xf6, 0xf4, 0x50, 0x6d,
+	0xa3, 0x02, 0x64, 0xf7, 0x48, 0x48, 0x5b, 0x94, 0xb8, 0x22, 0x77, 0x59, 0x27, 0x39, 0x23, 0x1b,
+	0x16, 0xe5, 0x77, 0x53, 0x78, 0x56, 0x0f, 0x09, 0x66, 0xbe, 0xa7, 0x0a, 0x0d, 0xe9, 0x24, 0x47,
+	0x50, 0xd0, 0x12, 0xcc, 0xfa, 0x4f, 0x3c, 0x12, 0x8a, 0x6a, 0xcb, 0x39, 0xf2, 0x10, 0x35, 0x00,
+	0x76, 0xbb, 0xd4, 0x63, 0xf9, 0xb9, 0xd2, 0x4c, 0xd4, 0x00, 0xf2, 0x64, 0x6d, 0xc2, 0x39, 0xdd,
+	0xdf, 0xca, 0xfe, 0x8e, 0xdf, 0xc5, 0x34, 0x69, 0xaa, 0xd3, 0x30, 0xe7, 0x8a, 0x0b, 0x15, 0x2b,
+	0x75, 0xb2, 0xbe, 0xd4, 0xbb, 0x71, 0x48, 0x4e, 0xc5, 0x6b, 0x52, 0xa4, 0xd1, 0x0d, 0x98, 0xc1,
+	0x41, 0xa0, 0x72, 0xbc, 0x7e, 0x48, 0x39, 0x1e, 0x4c, 0x86, 0x13, 0x49, 0x5a, 0xcf, 0x0c, 0x2d,
+	0xc7, 0xbb, 0xd5, 0xda, 0xe4, 0x57, 0x39, 0x22, 0x35, 0x3b, 0x98, 0x09, 0x92, 0xea, 0x21, 0x71,
+	0xbe, 0xe5, 0x1e, 0xd5, 0x43, 0x60, 0x3d, 0x00, 0x73, 0xb7, 0x5a, 0x63, 0x1f, 0xb7, 0xb6, 0x23,
+	0xc5, 0x43, 0x16, 0x8d, 0x61, 0x8b, 0x57, 0x20, 0xe3, 0xb5, 0x38, 0xcb, 0x4f, 0x8b, 0x36, 0x39,
+	0x13, 0xdb, 0x8a, 0x66, 0x5f, 0x6c, 0x64, 0xb7, 0x5a, 0x53, 0x2d, 0x28, 0x58, 0xad, 0xef, 0xf5,
+	0xea, 0x14, 0x2e, 0xab, 0x68, 0xef, 0x80, 0x54, 0x4b, 0x98, 0xea, 0xba, 0xf1, 0x23, 0x45, 0x43,
+	0xa7, 0x74, 0xc7, 0xa2, 0x47, 0xd7, 0x7d, 0x6b, 0x80, 0x12, 0x98, 0xb5, 0xbe, 0x56, 0x4b, 0xbc,
+	0xff, 0x10, 0xb3, 0x87, 0x71, 0x2d, 0xc9, 0x93, 0xf5, 0x08, 0x8e, 0x47, 0xdc, 0xb5, 0x7e, 0xac,
+	0x0b, 0x7d, 0x04, 0x26, 0xef, 0xd7, 0x43, 0x75, 0x4c, 0xa6, 0xa4, 0x8e, 0x44, 0x6c, 0x18, 0x31,
+	0x90, 0x81, 0xa8, 0x03, 0x7c, 0xa0, 0x06, 0x41, 0xa6, 0xe9, 0xbb, 0xf2, 0xb9, 0x5c, 0x70, 0xc4,
+	0xb7, 0xf5, 0x60, 0xb8, 0xde, 0xab, 0x84, 0xdc, 0x0c, 0xb1, 0xc7, 0xd3, 0x3e, 0x10, 0xc3, 0x53,
+	0x66, 0x7a, 0x64, 0xc0, 0xfe, 0x32, 0x0b, 0xa6, 0xa6, 0x14, 0x5d, 0x07, 0x93, 0x05, 0xc4, 0x73,
+	0xeb, 0x1d, 0xda, 0xa5, 0x5c, 0xf9, 0x71, 0x76, 0xc8, 0x8f, 0xd8, 0x85, 0x6d, 0x9f, 0x7a, 0x0e,
+	0x08, 0xee, 0xdb, 0x11, 0x33, 0xfa, 0x00, 0xe6, 0x02, 0x12, 0x52, 0xdf, 0x55, 0x89, 0x38, 0x5b,
+	0x96, 0xab, 0x5b, 0x39, 0x5e, 0xdd, 0xca, 0x3b, 0x6a, 0xb5, 0xab, 0x64, 0xa3, 0x34, 0x7e, 0xfd,
+	0x72, 0xc5, 0x70, 0x94, 0x08, 0xfa, 0x10, 0x80, 0xf4, 0x03, 0x1a, 0xea, 0xd5, 0x5c, 0x38, 0xa0,
+	0xa0, 0x16, 0xef, 0x7e, 0x95, 0xcc, 0xd3, 0x48, 0x5a, 0x93, 0x89, 0x86, 0x17, 0x8e, 0xa6, 0x14,
+	0xf6, 0x9a, 0x44, 0x0e, 0x1d, 0xf9, 0xe2, 0x2c, 0x24, 0xb7, 0x62, 0xf4, 0x78, 0x70, 0x4c, 0xf3,
+	0x90, 0xe5, 0x67, 0x4b, 0x33, 0x87, 0xba, 0x58, 0xb9, 0x1c, 0x61, 0xfd, 0xe9, 0xe5, 0xca, 0x6a,
+	0x9b, 0xf2, 0x87, 0xbd, 0x46, 0xb9, 0xe9, 0x77, 0x6d, 0xc9, 0xac, 0x7e, 0xd6, 0x99, 0xfb, 0xc8,
+	0x8e, 0x0c, 0x32, 0x21, 0xc0, 0x1c, 0x73, 0x10, 0x14, 0x86, 0xf6, 0x01, 0x49, 0x17, 0xeb, 0x7a,
+	0x60, 0xe7, 0x8e, 0xde, 0xea, 0x09, 0x69, 0xe6, 0xee, 0x20, 0x21, 0x3d, 0x50, 0x77, 0xf5, 0x26,
+	0xf6, 0xa4, 0xf9, 0xfc, 0xfc, 0xd1, 0x1b, 0x3e, 0x2e, 0x8d, 0x6c, 0x63, 0x4f, 0xd8, 0x46, 0xdb,
+	0x70, 0x4c, 0x99, 0x0d, 0x09, 0x23, 0x3c, 0x9f, 0x4d, 0x99, 0x4c, 0x53, 0x4a, 0x39, 0x91, 0x10,
+	0x7a, 0x07, 0x4e, 0x60, 0xb5, 0x89, 0x74, 0x09, 0x63, 0xb8, 0x4d, 0x58, 0x3e, 0x27, 0xe6, 0xc0,
+	0xff, 0xd4, 0xfd, 0x1d, 0x75, 0x6d, 0xdd, 0x1f, 0x7e, 0xd8, 0x07, 0x0d, 0xa2, 0x9a, 0xea, 0x3a,
+	0xcc, 0xb6, 0xa3, 0x8b, 0x89, 0xbb, 0xab, 0x2e, 0x2c, 0x45, 0x36, 0xfe, 0xc8, 0x42, 0x2e, 0x51,
+	0x8e, 0x3e, 0x87, 0x6c, 0xbc, 0x1f, 0xa3, 0xb5, 0xc9, 0x03, 0x60, 0xb0, 0x78, 0x17, 0xd6, 0x53,
+	0x72, 0x4b, 0xc8, 0x16, 0xfa, 0xea, 0xd7, 0xbf, 0x9f, 0x4d, 0x1f, 0x43, 0x60, 0x0b, 0x76, 0xdb,
+	0xa5, 0x2e, 0xfa, 0x02, 0x72, 0xc9, 0x1e, 0x83, 0x52, 0xe8, 0xd3, 0x96, 0xec, 0x42, 0x39, 0x2d,
+	0xbb, 0xb2, 0xbf, 0x28, 0xec, 0x2f, 0x20, 0x53, 0xd9, 0xef, 0x45, 0x36, 0xbf, 0x33, 0xe0, 0xe4,
+	0x81, 0x4d, 0x0a, 0x6d, 0xa6, 0x55, 0x3d, 0xb4, 0xfb, 0x16, 0xae, 0xbd, 0xa9, 0x98, 0x42, 0xb6,
+	0x2c, 0x90, 0x9d, 0x46, 0x4b, 0x1a, 0x32, 0x3b, 0x8c, 0xc1, 0xc4, 0x09, 0xda, 0x0a, 0x02, 0xb4,
+	0x96, 0x72, 0x42, 0xa7, 0x4e, 0x90, 0x36, 0xcf, 0x0f, 0x24, 0x08, 0x07, 0x01, 0xfa, 0xc6, 0x80,
+	0x13, 0xa3, 0x45, 0x88, 0xde, 0x4f, 0xa5, 0x77, 0xe4, 0x51, 0x2f, 0x6c, 0xbe, 0xa1, 0x94, 0x42,
+	0x75, 0x4e, 0xa0, 0x3a, 0x85, 0x16, 0x07, 0xa8, 0xec, 0x16, 0x21, 0xa2, 0x94, 0xd1, 0xb7, 0x1a,
+	0xbc, 0x78, 0xf9, 0x49, 0x09, 0x6f, 0x64, 0xc7, 0x2a, 0x6c, 0xbe, 0xa1, 0xd4, 0x98, 0xdc, 0x45,
+	0xf0, 0x1a, 0xfb, 0xeb, 0x72, 0x41, 0x4b, 0x72, 0xb7, 0x5b, 0xad, 0xa5, 0xc9, 0xdd, 0x60, 0x7f,
+	0x2a, 0xac, 0xa7, 0xe4, 0x1e, 0x93, 0x3b, 0xaf, 0xc5, 0x51, 0x08, 0xf3, 0x6a, 0xf6, 0xa3, 0xf7,
+	0x26, 0x6b, 0x4b, 0x36, 0x84, 0xc2, 0xa5, 0x43, 0x99, 0x07, 0x53, 0xde, 0x3a, 0x29, 0x8c, 0x9a,
+	0x28, 0xa7, 0x8c, 0xf2, 0x7e, 0xa5, 0xfa, 0xfc, 0x55, 0xd1, 0x78, 0xf1, 0xaa, 0x68, 0xfc, 0xf5,
+	0xaa, 0x68, 0x3c, 0x7d, 0x5d, 0x9c, 0x7a, 0xf1, 0xba, 0x38, 0xf5, 0xfb, 0xeb, 0xe2, 0xd4, 0xfd,
+	0x35, 0xed, 0xed, 0x15, 0xec, 0xeb, 0xd4, 0x55, 0x1f, 0xbc, 0x6b, 0xf7, 0xed, 0xf8, 0xbf, 0xbf,
+	0x78, 0x85, 0x1b, 0x73, 0xe2, 0x45, 0xbd, 0xfa, 0xef, 0x00, 0x8a, 0x0e, 0xc6, 0x34, 0xb9, 0x11,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -850,6 +1269,10 @@ type RestQueryClient interface {
 	QueryApp(ctx context.Context, in *RestQueryAppRequest, opts ...grpc.CallOption) (*RestQueryAppResponse, error)
 	// query app info
 	QueryAppFeeGrant(ctx context.Context, in *RestQueryAppFeeGrantRequest, opts ...grpc.CallOption) (*RestQueryAppFeeGrantResponse, error)
+	// query app by one of its domains
+	QueryAppByDomain(ctx context.Context, in *RestQueryAppByDomainRequest, opts ...grpc.CallOption) (*RestQueryAppByDomainResponse, error)
+	// query the nfts owned by a mises id, grouped by class
+	QueryNFT(ctx context.Context, in *RestQueryNFTRequest, opts ...grpc.CallOption) (*RestQueryNFTResponse, error)
 	// query a tx result
 	QueryTx(ctx context.Context, in *RestQueryTxRequest, opts ...grpc.CallOption) (*RestTxResponse, error)
 }
@@ -907,6 +1330,24 @@ func (c *restQueryClient) QueryAppFeeGrant(ctx context.Context, in *RestQueryApp
 	return out, nil
 }
 
+func (c *restQueryClient) QueryAppByDomain(ctx context.Context, in *RestQueryAppByDomainRequest, opts ...grpc.CallOption) (*RestQueryAppByDomainResponse, error) {
+	out := new(RestQueryAppByDomainResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.RestQuery/QueryAppByDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restQueryClient) QueryNFT(ctx context.Context, in *RestQueryNFTRequest, opts ...grpc.CallOption) (*RestQueryNFTResponse, error) {
+	out := new(RestQueryNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.RestQuery/QueryNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *restQueryClient) QueryTx(ctx context.Context, in *RestQueryTxRequest, opts ...grpc.CallOption) (*RestTxResponse, error) {
 	out := new(RestTxResponse)
 	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.RestQuery/QueryTx", in, out, opts...)
@@ -928,6 +1369,10 @@ type RestQueryServer interface {
 	QueryApp(context.Context, *RestQueryAppRequest) (*RestQueryAppResponse, error)
 	// query app info
 	QueryAppFeeGrant(context.Context, *RestQueryAppFeeGrantRequest) (*RestQueryAppFeeGrantResponse, error)
+	// query app by one of its domains
+	QueryAppByDomain(context.Context, *RestQueryAppByDomainRequest) (*RestQueryAppByDomainResponse, error)
+	// query the nfts owned by a mises id, grouped by class
+	QueryNFT(context.Context, *RestQueryNFTRequest) (*RestQueryNFTResponse, error)
 	// query a tx result
 	QueryTx(context.Context, *RestQueryTxRequest) (*RestTxResponse, error)
 }
@@ -951,6 +1396,12 @@ func (*UnimplementedRestQueryServer) QueryApp(ctx context.Context, req *RestQuer
 func (*UnimplementedRestQueryServer) QueryAppFeeGrant(ctx context.Context, req *RestQueryAppFeeGrantRequest) (*RestQueryAppFeeGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAppFeeGrant not implemented")
 }
+func (*UnimplementedRestQueryServer) QueryAppByDomain(ctx context.Context, req *RestQueryAppByDomainRequest) (*RestQueryAppByDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAppByDomain not implemented")
+}
+func (*UnimplementedRestQueryServer) QueryNFT(ctx context.Context, req *RestQueryNFTRequest) (*RestQueryNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNFT not implemented")
+}
 func (*UnimplementedRestQueryServer) QueryTx(ctx context.Context, req *RestQueryTxRequest) (*RestTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTx not implemented")
 }
@@ -1049,6 +1500,42 @@ func _RestQuery_QueryAppFeeGrant_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RestQuery_QueryAppByDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestQueryAppByDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestQueryServer).QueryAppByDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.RestQuery/QueryAppByDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestQueryServer).QueryAppByDomain(ctx, req.(*RestQueryAppByDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestQuery_QueryNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestQueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestQueryServer).QueryNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.RestQuery/QueryNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestQueryServer).QueryNFT(ctx, req.(*RestQueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RestQuery_QueryTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RestQueryTxRequest)
 	if err := dec(in); err != nil {
@@ -1091,6 +1578,14 @@ var _RestQuery_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAppFeeGrant",
 			Handler:    _RestQuery_QueryAppFeeGrant_Handler,
 		},
+		{
+			MethodName: "QueryAppByDomain",
+			Handler:    _RestQuery_QueryAppByDomain_Handler,
+		},
+		{
+			MethodName: "QueryNFT",
+			Handler:    _RestQuery_QueryNFT_Handler,
+		},
 		{
 			MethodName: "QueryTx",
 			Handler:    _RestQuery_QueryTx_Handler,
@@ -1267,6 +1762,18 @@ func (m *RestQueryUserRelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.FollowedSince != 0 {
+		i = encodeVarintRestQuery(dAtA, i, uint64(m.FollowedSince))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Sort) > 0 {
+		i -= len(m.Sort)
+		copy(dAtA[i:], m.Sort)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Sort)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1316,6 +1823,25 @@ func (m *MisesID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RelTypeBits != 0 {
+		i = encodeVarintRestQuery(dAtA, i, uint64(m.RelTypeBits))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.RelType) > 0 {
 		i -= len(m.RelType)
 		copy(dAtA[i:], m.RelType)
@@ -1432,6 +1958,39 @@ func (m *RestQueryAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VerificationReason) > 0 {
+		i -= len(m.VerificationReason)
+		copy(dAtA[i:], m.VerificationReason)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.VerificationReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Version != 0 {
 		i = encodeVarintRestQuery(dAtA, i, uint64(m.Version))
 		i--
@@ -1452,7 +2011,7 @@ func (m *RestQueryAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RestQueryTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *RestQueryAppByDomainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1462,27 +2021,27 @@ func (m *RestQueryTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestQueryTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestQueryAppByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestQueryTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestQueryAppByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Txhash)))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Domain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *RestQueryAppByDomainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1492,24 +2051,19 @@ func (m *RestTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestQueryAppByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestQueryAppByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		i = encodeVarintRestQuery(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TxResponse != nil {
+	if m.App != nil {
 		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.App.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1517,12 +2071,19 @@ func (m *RestTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRestQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MisesAppid) > 0 {
+		i -= len(m.MisesAppid)
+		copy(dAtA[i:], m.MisesAppid)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesAppid)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestQueryAppFeeGrantRequest) Marshal() (dAtA []byte, err error) {
+func (m *RestQueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1532,34 +2093,46 @@ func (m *RestQueryAppFeeGrantRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestQueryAppFeeGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestQueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestQueryAppFeeGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestQueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MisesUid) > 0 {
-		i -= len(m.MisesUid)
-		copy(dAtA[i:], m.MisesUid)
-		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesUid)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRestQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MisesAppid) > 0 {
-		i -= len(m.MisesAppid)
-		copy(dAtA[i:], m.MisesAppid)
-		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesAppid)))
+	if len(m.MisesId) > 0 {
+		i -= len(m.MisesId)
+		copy(dAtA[i:], m.MisesId)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AppFeeGrant) Marshal() (dAtA []byte, err error) {
+func (m *NFTsOfClass) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1569,37 +2142,63 @@ func (m *AppFeeGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppFeeGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *NFTsOfClass) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppFeeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NFTsOfClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expiration != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err8 != nil {
-			return 0, err8
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= n8
-		i = encodeVarintRestQuery(dAtA, i, uint64(n8))
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
-	if err9 != nil {
-		return 0, err9
+	return len(dAtA) - i, nil
+}
+
+func (m *RestQueryNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= n9
-	i = encodeVarintRestQuery(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x12
-	if m.SpendLimit != nil {
+	return dAtA[:n], nil
+}
+
+func (m *RestQueryNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestQueryNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1607,12 +2206,56 @@ func (m *AppFeeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRestQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestQueryTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestQueryTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestQueryTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.Txhash)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestQueryAppFeeGrantResponse) Marshal() (dAtA []byte, err error) {
+func (m *RestTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1622,19 +2265,24 @@ func (m *RestQueryAppFeeGrantResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestQueryAppFeeGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestTxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestQueryAppFeeGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Grant != nil {
+	if m.Code != 0 {
+		i = encodeVarintRestQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxResponse != nil {
 		{
-			size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1647,50 +2295,243 @@ func (m *RestQueryAppFeeGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRestQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRestQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *RestQueryDidRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MisesId)
-	if l > 0 {
-		n += 1 + l + sovRestQuery(uint64(l))
+func (m *RestQueryAppFeeGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RestQueryDidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DidRegistry != nil {
-		l = m.DidRegistry.Size()
-		n += 1 + l + sovRestQuery(uint64(l))
-	}
-	return n
+func (m *RestQueryAppFeeGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestQueryUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RestQueryAppFeeGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MisesUid)
+	if len(m.MisesUid) > 0 {
+		i -= len(m.MisesUid)
+		copy(dAtA[i:], m.MisesUid)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MisesAppid) > 0 {
+		i -= len(m.MisesAppid)
+		copy(dAtA[i:], m.MisesAppid)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.MisesAppid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppFeeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppFeeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppFeeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintRestQuery(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PeriodReset != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PeriodReset):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintRestQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRestQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowanceType) > 0 {
+		i -= len(m.AllowanceType)
+		copy(dAtA[i:], m.AllowanceType)
+		i = encodeVarintRestQuery(dAtA, i, uint64(len(m.AllowanceType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiration != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintRestQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintRestQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRestQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestQueryAppFeeGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestQueryAppFeeGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestQueryAppFeeGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grant != nil {
+		{
+			size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRestQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRestQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRestQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RestQueryDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MisesId)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RestQueryDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidRegistry != nil {
+		l = m.DidRegistry.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RestQueryUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MisesUid)
 	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
@@ -1735,6 +2576,13 @@ func (m *RestQueryUserRelationRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
+	l = len(m.Sort)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	if m.FollowedSince != 0 {
+		n += 1 + sovRestQuery(uint64(m.FollowedSince))
+	}
 	return n
 }
 
@@ -1752,6 +2600,15 @@ func (m *MisesID) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
+	if m.RelTypeBits != 0 {
+		n += 1 + sovRestQuery(uint64(m.RelTypeBits))
+	}
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1800,98 +2657,236 @@ func (m *RestQueryAppResponse) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovRestQuery(uint64(m.Version))
 	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.VerificationReason)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RestQueryTxRequest) Size() (n int) {
+func (m *RestQueryAppByDomainRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Txhash)
+	l = len(m.Domain)
 	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RestTxResponse) Size() (n int) {
+func (m *RestQueryAppByDomainResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
+	l = len(m.MisesAppid)
+	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
-	if m.Code != 0 {
-		n += 1 + sovRestQuery(uint64(m.Code))
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RestQueryAppFeeGrantRequest) Size() (n int) {
+func (m *RestQueryNFTRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MisesAppid)
+	l = len(m.MisesId)
 	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
-	l = len(m.MisesUid)
+	l = len(m.ClassId)
 	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *AppFeeGrant) Size() (n int) {
+func (m *NFTsOfClass) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SpendLimit != nil {
-		l = m.SpendLimit.Size()
+	l = len(m.ClassId)
+	if l > 0 {
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovRestQuery(uint64(l))
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovRestQuery(uint64(l))
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *RestQueryAppFeeGrantResponse) Size() (n int) {
+func (m *RestQueryNFTResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Grant != nil {
-		l = m.Grant.Size()
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovRestQuery(uint64(l))
 	}
 	return n
 }
 
-func sovRestQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRestQuery(x uint64) (n int) {
-	return sovRestQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *RestQueryTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	return n
 }
-func (m *RestQueryDidRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *RestTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovRestQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *RestQueryAppFeeGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MisesAppid)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	l = len(m.MisesUid)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AppFeeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovRestQuery(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	l = len(m.AllowanceType)
+	if l > 0 {
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
+	if m.PeriodReset != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PeriodReset)
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovRestQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RestQueryAppFeeGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Grant != nil {
+		l = m.Grant.Size()
+		n += 1 + l + sovRestQuery(uint64(l))
+	}
+	return n
+}
+
+func sovRestQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRestQuery(x uint64) (n int) {
+	return sovRestQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RestQueryDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2030,7 +3025,718 @@ func (m *RestQueryDidResponse) Unmarshal(dAtA []byte) error {
 			if m.DidRegistry == nil {
 				m.DidRegistry = &DidRegistry{}
 			}
-			if err := m.DidRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DidRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestQueryUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestQueryUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestQueryUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisesUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestQueryUserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestQueryUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubInfo == nil {
+				m.PubInfo = &PublicUserInfo{}
+			}
+			if err := m.PubInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriInfo == nil {
+				m.PriInfo = &PrivateUserInfo{}
+			}
+			if err := m.PriInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestQueryUserRelationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestQueryUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisesUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowedSince", wireType)
+			}
+			m.FollowedSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FollowedSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MisesID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MisesID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MisesID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelTypeBits", wireType)
+			}
+			m.RelTypeBits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelTypeBits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, RelationFlag{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestQueryUserRelationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestQueryUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisesList = append(m.MisesList, &MisesID{})
+			if err := m.MisesList[len(m.MisesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2055,7 +3761,7 @@ func (m *RestQueryDidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestQueryUserRequest) Unmarshal(dAtA []byte) error {
+func (m *RestQueryAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2078,15 +3784,15 @@ func (m *RestQueryUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestQueryAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestQueryAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MisesUid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesAppid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2114,7 +3820,7 @@ func (m *RestQueryUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MisesUid = string(dAtA[iNdEx:postIndex])
+			m.MisesAppid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2137,7 +3843,7 @@ func (m *RestQueryUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
+func (m *RestQueryAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +3866,10 @@ func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestQueryAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestQueryAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2196,17 +3902,17 @@ func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PubInfo == nil {
-				m.PubInfo = &PublicUserInfo{}
+				m.PubInfo = &PublicAppInfo{}
 			}
 			if err := m.PubInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2216,33 +3922,16 @@ func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRestQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRestQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PriInfo == nil {
-				m.PriInfo = &PrivateUserInfo{}
-			}
-			if err := m.PriInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2252,64 +3941,47 @@ func (m *RestQueryUserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRestQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Verified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationReason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRestQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRestQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryUserRelationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryUserRelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.VerificationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MisesUid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2337,11 +4009,11 @@ func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MisesUid = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2369,13 +4041,63 @@ func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filter = string(dAtA[iNdEx:postIndex])
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRestQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestQueryAppByDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRestQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestQueryAppByDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestQueryAppByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2385,27 +4107,23 @@ func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRestQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRestQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2428,7 +4146,7 @@ func (m *RestQueryUserRelationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MisesID) Unmarshal(dAtA []byte) error {
+func (m *RestQueryAppByDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,15 +4169,15 @@ func (m *MisesID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MisesID: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestQueryAppByDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MisesID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestQueryAppByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MisesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesAppid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2487,13 +4205,13 @@ func (m *MisesID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MisesId = string(dAtA[iNdEx:postIndex])
+			m.MisesAppid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2503,23 +4221,27 @@ func (m *MisesID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRestQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRestQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelType = string(dAtA[iNdEx:postIndex])
+			if m.App == nil {
+				m.App = &RestQueryAppResponse{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2542,7 +4264,7 @@ func (m *MisesID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
+func (m *RestQueryNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2565,17 +4287,17 @@ func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryUserRelationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestQueryNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestQueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MisesList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MisesId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2585,27 +4307,57 @@ func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRestQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRestQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MisesList = append(m.MisesList, &MisesID{})
-			if err := m.MisesList[len(m.MisesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MisesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2635,7 +4387,7 @@ func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2662,7 +4414,7 @@ func (m *RestQueryUserRelationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestQueryAppRequest) Unmarshal(dAtA []byte) error {
+func (m *NFTsOfClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2685,15 +4437,15 @@ func (m *RestQueryAppRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFTsOfClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFTsOfClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MisesAppid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2721,7 +4473,41 @@ func (m *RestQueryAppRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MisesAppid = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, nft.NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2744,7 +4530,7 @@ func (m *RestQueryAppRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestQueryAppResponse) Unmarshal(dAtA []byte) error {
+func (m *RestQueryNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2767,15 +4553,15 @@ func (m *RestQueryAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestQueryAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestQueryNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestQueryAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestQueryNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2802,18 +4588,16 @@ func (m *RestQueryAppResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubInfo == nil {
-				m.PubInfo = &PublicAppInfo{}
-			}
-			if err := m.PubInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Classes = append(m.Classes, NFTsOfClass{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRestQuery
@@ -2823,11 +4607,28 @@ func (m *RestQueryAppResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRestQuery(dAtA[iNdEx:])
@@ -3284,6 +5085,208 @@ func (m *AppFeeGrant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, types.Coin{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodReset == nil {
+				m.PeriodReset = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRestQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRestQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRestQuery(dAtA[iNdEx:])
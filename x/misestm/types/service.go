@@ -23,15 +23,23 @@ func RegisterBaseAppSimulateFn(
 }
 
 const (
-	InvalidID            = ^uint64(0)
-	RelTypeBitFollow     = uint64(1)
-	RelTypeBitBlock      = uint64(2)
-	RelTypeBitReferredBy = uint64(4)
+	InvalidID             = ^uint64(0)
+	RelTypeBitFollow      = uint64(1)
+	RelTypeBitBlock       = uint64(2)
+	RelTypeBitReferredBy  = uint64(4)
+	RelTypeBitMute        = uint64(8)
+	RelTypeBitCloseFriend = uint64(16)
+	RelTypeBitSubscribe   = uint64(32)
 
 	DIDPrefixForUser = "did:mises:"
 	DIDPrefixForApp  = "did:misesapp:"
 	DIDTypeUser      = uint64(1)
 	DIDTypeApp       = uint64(2)
+
+	UserRelationSortFollowedSince = "followed_since"
+
+	AppFeeGrantTypeBasic    = "basic"
+	AppFeeGrantTypePeriodic = "periodic"
 )
 
 type AppMgr interface {
@@ -45,7 +53,7 @@ type UserMgr interface {
 
 	GetUserAccount(ctx sdk.Context, did string) (*MisesAccount, error)
 	GetUserRelation(ctx sdk.Context, didFrom string, didTo string) (*UserRelation, error)
-	GetUserRelations(ctx sdk.Context, relType uint64, didFrom string, lastDidTo string, limit int) ([]*UserRelation, error)
+	GetUserRelations(ctx sdk.Context, relType uint64, didFrom string, lastKey string, limit int, sort string, followedSince int64) ([]*UserRelation, error)
 }
 
 func CheckDid(did string, didType uint64) (string, bool) {
@@ -8,22 +8,29 @@ import (
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -151,6 +158,11 @@ type MsgUpdateUserRelation struct {
 	IsBlocking   bool   `protobuf:"varint,5,opt,name=isBlocking,proto3" json:"isBlocking,omitempty"`
 	IsReferredBy bool   `protobuf:"varint,6,opt,name=isReferredBy,proto3" json:"isReferredBy,omitempty"`
 	Version      uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	RelType      uint64 `protobuf:"varint,8,opt,name=relType,proto3" json:"relType,omitempty"`
+	// relation type bits set by this message that expire
+	ExpiringRelType uint64     `protobuf:"varint,9,opt,name=expiringRelType,proto3" json:"expiringRelType,omitempty"`
+	ExpireHeight    int64      `protobuf:"varint,10,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime      *time.Time `protobuf:"bytes,11,opt,name=expireTime,proto3,stdtime" json:"expireTime,omitempty"`
 }
 
 func (m *MsgUpdateUserRelation) Reset()         { *m = MsgUpdateUserRelation{} }
@@ -235,6 +247,34 @@ func (m *MsgUpdateUserRelation) GetVersion() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateUserRelation) GetRelType() uint64 {
+	if m != nil {
+		return m.RelType
+	}
+	return 0
+}
+
+func (m *MsgUpdateUserRelation) GetExpiringRelType() uint64 {
+	if m != nil {
+		return m.ExpiringRelType
+	}
+	return 0
+}
+
+func (m *MsgUpdateUserRelation) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateUserRelation) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 type MsgUpdateUserRelationResponse struct {
 }
 
@@ -414,6 +454,10 @@ type MsgCreateDidRegistry struct {
 	PkeyType      string `protobuf:"bytes,4,opt,name=pkeyType,proto3" json:"pkeyType,omitempty"`
 	PkeyMultibase string `protobuf:"bytes,5,opt,name=pkeyMultibase,proto3" json:"pkeyMultibase,omitempty"`
 	Version       uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// app DID paying for the creation of a user DID, the creator must administer it
+	Sponsor string `protobuf:"bytes,7,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// signature of the user key over SponsoredDidSignBytes, required with a sponsor
+	Signature []byte `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *MsgCreateDidRegistry) Reset()         { *m = MsgCreateDidRegistry{} }
@@ -491,6 +535,20 @@ func (m *MsgCreateDidRegistry) GetVersion() uint64 {
 	return 0
 }
 
+func (m *MsgCreateDidRegistry) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgCreateDidRegistry) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type MsgCreateDidRegistryResponse struct {
 }
 
@@ -527,27 +585,27 @@ func (m *MsgCreateDidRegistryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateDidRegistryResponse proto.InternalMessageInfo
 
-// MsgNewDenom defines an SDK message for creating a new denom.
-type MsgNewDenom struct {
-	Id        string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	DenomMeta *types.Metadata                        `protobuf:"bytes,3,opt,name=denom_meta,json=denomMeta,proto3" json:"denom_meta,omitempty"`
-	Sender    string                                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient string                                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+type MsgCreateUserList struct {
+	Creator     string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Owner       string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  UserListVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=misesid.misestm.v1beta1.UserListVisibility" json:"visibility,omitempty"`
+	Members     []string           `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (m *MsgNewDenom) Reset()         { *m = MsgNewDenom{} }
-func (m *MsgNewDenom) String() string { return proto.CompactTextString(m) }
-func (*MsgNewDenom) ProtoMessage()    {}
-func (*MsgNewDenom) Descriptor() ([]byte, []int) {
+func (m *MsgCreateUserList) Reset()         { *m = MsgCreateUserList{} }
+func (m *MsgCreateUserList) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateUserList) ProtoMessage()    {}
+func (*MsgCreateUserList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{8}
 }
-func (m *MsgNewDenom) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateUserList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgNewDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateUserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgNewDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateUserList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -557,62 +615,76 @@ func (m *MsgNewDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgNewDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgNewDenom.Merge(m, src)
+func (m *MsgCreateUserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateUserList.Merge(m, src)
 }
-func (m *MsgNewDenom) XXX_Size() int {
+func (m *MsgCreateUserList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgNewDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgNewDenom.DiscardUnknown(m)
+func (m *MsgCreateUserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateUserList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgNewDenom proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateUserList proto.InternalMessageInfo
 
-func (m *MsgNewDenom) GetId() string {
+func (m *MsgCreateUserList) GetCreator() string {
 	if m != nil {
-		return m.Id
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgNewDenom) GetDenomMeta() *types.Metadata {
+func (m *MsgCreateUserList) GetOwner() string {
 	if m != nil {
-		return m.DenomMeta
+		return m.Owner
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgNewDenom) GetSender() string {
+func (m *MsgCreateUserList) GetName() string {
 	if m != nil {
-		return m.Sender
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgNewDenom) GetRecipient() string {
+func (m *MsgCreateUserList) GetDescription() string {
 	if m != nil {
-		return m.Recipient
+		return m.Description
 	}
 	return ""
 }
 
-// MsgNewDenomResponse defines the MsgNewDenom response type.
-type MsgNewDenomResponse struct {
+func (m *MsgCreateUserList) GetVisibility() UserListVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return UserListVisibilityPublic
 }
 
-func (m *MsgNewDenomResponse) Reset()         { *m = MsgNewDenomResponse{} }
-func (m *MsgNewDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgNewDenomResponse) ProtoMessage()    {}
-func (*MsgNewDenomResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateUserList) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type MsgCreateUserListResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateUserListResponse) Reset()         { *m = MsgCreateUserListResponse{} }
+func (m *MsgCreateUserListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateUserListResponse) ProtoMessage()    {}
+func (*MsgCreateUserListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{9}
 }
-func (m *MsgNewDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateUserListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgNewDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateUserListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgNewDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateUserListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -622,41 +694,44 @@ func (m *MsgNewDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgNewDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgNewDenomResponse.Merge(m, src)
+func (m *MsgCreateUserListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateUserListResponse.Merge(m, src)
 }
-func (m *MsgNewDenomResponse) XXX_Size() int {
+func (m *MsgCreateUserListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgNewDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgNewDenomResponse.DiscardUnknown(m)
+func (m *MsgCreateUserListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateUserListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgNewDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateUserListResponse proto.InternalMessageInfo
 
-// MsgNewNFTClass defines an SDK message for creating a new NFTClass.
-type MsgNewNFTClass struct {
-	Id     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Uri    string      `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
-	Schema string      `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	Symbol string      `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Data   *types1.Any `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	Sender string      `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+func (m *MsgCreateUserListResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgNewNFTClass) Reset()         { *m = MsgNewNFTClass{} }
-func (m *MsgNewNFTClass) String() string { return proto.CompactTextString(m) }
-func (*MsgNewNFTClass) ProtoMessage()    {}
-func (*MsgNewNFTClass) Descriptor() ([]byte, []int) {
+type MsgRenameUserList struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgRenameUserList) Reset()         { *m = MsgRenameUserList{} }
+func (m *MsgRenameUserList) String() string { return proto.CompactTextString(m) }
+func (*MsgRenameUserList) ProtoMessage()    {}
+func (*MsgRenameUserList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{10}
 }
-func (m *MsgNewNFTClass) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenameUserList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgNewNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenameUserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgNewNFTClass.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenameUserList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -666,83 +741,61 @@ func (m *MsgNewNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgNewNFTClass) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgNewNFTClass.Merge(m, src)
+func (m *MsgRenameUserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenameUserList.Merge(m, src)
 }
-func (m *MsgNewNFTClass) XXX_Size() int {
+func (m *MsgRenameUserList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgNewNFTClass) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgNewNFTClass.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgNewNFTClass proto.InternalMessageInfo
-
-func (m *MsgNewNFTClass) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *MsgRenameUserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenameUserList.DiscardUnknown(m)
 }
 
-func (m *MsgNewNFTClass) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_MsgRenameUserList proto.InternalMessageInfo
 
-func (m *MsgNewNFTClass) GetUri() string {
+func (m *MsgRenameUserList) GetCreator() string {
 	if m != nil {
-		return m.Uri
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgNewNFTClass) GetSchema() string {
+func (m *MsgRenameUserList) GetId() uint64 {
 	if m != nil {
-		return m.Schema
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgNewNFTClass) GetSymbol() string {
+func (m *MsgRenameUserList) GetName() string {
 	if m != nil {
-		return m.Symbol
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgNewNFTClass) GetData() *types1.Any {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (m *MsgNewNFTClass) GetSender() string {
+func (m *MsgRenameUserList) GetVersion() uint64 {
 	if m != nil {
-		return m.Sender
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-// MsgNewNFTClassResponse defines the MsgNewNFTClass response type.
-type MsgNewNFTClassResponse struct {
+type MsgRenameUserListResponse struct {
 }
 
-func (m *MsgNewNFTClassResponse) Reset()         { *m = MsgNewNFTClassResponse{} }
-func (m *MsgNewNFTClassResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgNewNFTClassResponse) ProtoMessage()    {}
-func (*MsgNewNFTClassResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRenameUserListResponse) Reset()         { *m = MsgRenameUserListResponse{} }
+func (m *MsgRenameUserListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenameUserListResponse) ProtoMessage()    {}
+func (*MsgRenameUserListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{11}
 }
-func (m *MsgNewNFTClassResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenameUserListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgNewNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenameUserListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgNewNFTClassResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenameUserListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -752,40 +805,37 @@ func (m *MsgNewNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgNewNFTClassResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgNewNFTClassResponse.Merge(m, src)
+func (m *MsgRenameUserListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenameUserListResponse.Merge(m, src)
 }
-func (m *MsgNewNFTClassResponse) XXX_Size() int {
+func (m *MsgRenameUserListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgNewNFTClassResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgNewNFTClassResponse.DiscardUnknown(m)
+func (m *MsgRenameUserListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenameUserListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgNewNFTClassResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenameUserListResponse proto.InternalMessageInfo
 
-// MsgUpdateNFTClass defines an SDK message for editing a nft class.
-type MsgUpdateNFTClass struct {
-	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClassId string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	Name    string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Uri     string      `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data    *types1.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	Sender  string      `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+type MsgAddUserListMembers struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Version uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *MsgUpdateNFTClass) Reset()         { *m = MsgUpdateNFTClass{} }
-func (m *MsgUpdateNFTClass) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateNFTClass) ProtoMessage()    {}
-func (*MsgUpdateNFTClass) Descriptor() ([]byte, []int) {
+func (m *MsgAddUserListMembers) Reset()         { *m = MsgAddUserListMembers{} }
+func (m *MsgAddUserListMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserListMembers) ProtoMessage()    {}
+func (*MsgAddUserListMembers) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{12}
 }
-func (m *MsgUpdateNFTClass) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserListMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserListMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateNFTClass.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserListMembers.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -795,76 +845,61 @@ func (m *MsgUpdateNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateNFTClass) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateNFTClass.Merge(m, src)
+func (m *MsgAddUserListMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserListMembers.Merge(m, src)
 }
-func (m *MsgUpdateNFTClass) XXX_Size() int {
+func (m *MsgAddUserListMembers) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateNFTClass) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateNFTClass.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateNFTClass proto.InternalMessageInfo
-
-func (m *MsgUpdateNFTClass) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *MsgAddUserListMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserListMembers.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateNFTClass) GetClassId() string {
-	if m != nil {
-		return m.ClassId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgAddUserListMembers proto.InternalMessageInfo
 
-func (m *MsgUpdateNFTClass) GetName() string {
+func (m *MsgAddUserListMembers) GetCreator() string {
 	if m != nil {
-		return m.Name
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateNFTClass) GetUri() string {
+func (m *MsgAddUserListMembers) GetId() uint64 {
 	if m != nil {
-		return m.Uri
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateNFTClass) GetData() *types1.Any {
+func (m *MsgAddUserListMembers) GetMembers() []string {
 	if m != nil {
-		return m.Data
+		return m.Members
 	}
 	return nil
 }
 
-func (m *MsgUpdateNFTClass) GetSender() string {
+func (m *MsgAddUserListMembers) GetVersion() uint64 {
 	if m != nil {
-		return m.Sender
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-// MsgUpdateNFTClassResponse defines the MsgUpdateNFTClass response type.
-type MsgUpdateNFTClassResponse struct {
+type MsgAddUserListMembersResponse struct {
 }
 
-func (m *MsgUpdateNFTClassResponse) Reset()         { *m = MsgUpdateNFTClassResponse{} }
-func (m *MsgUpdateNFTClassResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateNFTClassResponse) ProtoMessage()    {}
-func (*MsgUpdateNFTClassResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAddUserListMembersResponse) Reset()         { *m = MsgAddUserListMembersResponse{} }
+func (m *MsgAddUserListMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserListMembersResponse) ProtoMessage()    {}
+func (*MsgAddUserListMembersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{13}
 }
-func (m *MsgUpdateNFTClassResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserListMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserListMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateNFTClassResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserListMembersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -874,41 +909,37 @@ func (m *MsgUpdateNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateNFTClassResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateNFTClassResponse.Merge(m, src)
+func (m *MsgAddUserListMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserListMembersResponse.Merge(m, src)
 }
-func (m *MsgUpdateNFTClassResponse) XXX_Size() int {
+func (m *MsgAddUserListMembersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateNFTClassResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateNFTClassResponse.DiscardUnknown(m)
+func (m *MsgAddUserListMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserListMembersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateNFTClassResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddUserListMembersResponse proto.InternalMessageInfo
 
-// MsgMintNFT defines an SDK message for creating a new NFT.
-type MsgMintNFT struct {
-	Id        string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClassId   string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	Name      string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Uri       string      `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data      *types1.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	Sender    string      `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient string      `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+type MsgRemoveUserListMembers struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Version uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
-func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgMintNFT) ProtoMessage()    {}
-func (*MsgMintNFT) Descriptor() ([]byte, []int) {
+func (m *MsgRemoveUserListMembers) Reset()         { *m = MsgRemoveUserListMembers{} }
+func (m *MsgRemoveUserListMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUserListMembers) ProtoMessage()    {}
+func (*MsgRemoveUserListMembers) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4b1477772a91a3, []int{14}
 }
-func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveUserListMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveUserListMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveUserListMembers.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,83 +949,157 @@ func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgMintNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintNFT.Merge(m, src)
+func (m *MsgRemoveUserListMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserListMembers.Merge(m, src)
 }
-func (m *MsgMintNFT) XXX_Size() int {
+func (m *MsgRemoveUserListMembers) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintNFT.DiscardUnknown(m)
+func (m *MsgRemoveUserListMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserListMembers.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveUserListMembers proto.InternalMessageInfo
 
-func (m *MsgMintNFT) GetId() string {
+func (m *MsgRemoveUserListMembers) GetCreator() string {
 	if m != nil {
-		return m.Id
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgMintNFT) GetClassId() string {
+func (m *MsgRemoveUserListMembers) GetId() uint64 {
 	if m != nil {
-		return m.ClassId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgMintNFT) GetName() string {
+func (m *MsgRemoveUserListMembers) GetMembers() []string {
 	if m != nil {
-		return m.Name
+		return m.Members
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgMintNFT) GetUri() string {
+func (m *MsgRemoveUserListMembers) GetVersion() uint64 {
 	if m != nil {
-		return m.Uri
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgMintNFT) GetData() *types1.Any {
-	if m != nil {
-		return m.Data
+type MsgRemoveUserListMembersResponse struct {
+}
+
+func (m *MsgRemoveUserListMembersResponse) Reset()         { *m = MsgRemoveUserListMembersResponse{} }
+func (m *MsgRemoveUserListMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUserListMembersResponse) ProtoMessage()    {}
+func (*MsgRemoveUserListMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{15}
+}
+func (m *MsgRemoveUserListMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveUserListMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveUserListMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgRemoveUserListMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserListMembersResponse.Merge(m, src)
+}
+func (m *MsgRemoveUserListMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveUserListMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserListMembersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMintNFT) GetSender() string {
+var xxx_messageInfo_MsgRemoveUserListMembersResponse proto.InternalMessageInfo
+
+type MsgDeleteUserList struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgDeleteUserList) Reset()         { *m = MsgDeleteUserList{} }
+func (m *MsgDeleteUserList) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteUserList) ProtoMessage()    {}
+func (*MsgDeleteUserList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{16}
+}
+func (m *MsgDeleteUserList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteUserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteUserList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteUserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteUserList.Merge(m, src)
+}
+func (m *MsgDeleteUserList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteUserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteUserList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteUserList proto.InternalMessageInfo
+
+func (m *MsgDeleteUserList) GetCreator() string {
 	if m != nil {
-		return m.Sender
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgMintNFT) GetRecipient() string {
+func (m *MsgDeleteUserList) GetId() uint64 {
 	if m != nil {
-		return m.Recipient
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-// MsgMintNFTResponse defines the Msg/MintNFT response type.
-type MsgMintNFTResponse struct {
+func (m *MsgDeleteUserList) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
-func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMintNFTResponse) ProtoMessage()    {}
-func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4b1477772a91a3, []int{15}
+type MsgDeleteUserListResponse struct {
 }
-func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgDeleteUserListResponse) Reset()         { *m = MsgDeleteUserListResponse{} }
+func (m *MsgDeleteUserListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteUserListResponse) ProtoMessage()    {}
+func (*MsgDeleteUserListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{17}
+}
+func (m *MsgDeleteUserListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteUserListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteUserListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,39 +1109,38 @@ func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgMintNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintNFTResponse.Merge(m, src)
+func (m *MsgDeleteUserListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteUserListResponse.Merge(m, src)
 }
-func (m *MsgMintNFTResponse) XXX_Size() int {
+func (m *MsgDeleteUserListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintNFTResponse.DiscardUnknown(m)
+func (m *MsgDeleteUserListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteUserListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteUserListResponse proto.InternalMessageInfo
 
-// MsgUpdateNFT defines an SDK message for update a NFT.
-type MsgUpdateNFT struct {
-	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClassId string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	Uri     string      `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data    *types1.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	Sender  string      `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+type MsgCreateCommunity struct {
+	Creator     string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Owner       string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string              `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	JoinPolicy  CommunityJoinPolicy `protobuf:"varint,5,opt,name=joinPolicy,proto3,enum=misesid.misestm.v1beta1.CommunityJoinPolicy" json:"joinPolicy,omitempty"`
 }
 
-func (m *MsgUpdateNFT) Reset()         { *m = MsgUpdateNFT{} }
-func (m *MsgUpdateNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateNFT) ProtoMessage()    {}
-func (*MsgUpdateNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4b1477772a91a3, []int{16}
+func (m *MsgCreateCommunity) Reset()         { *m = MsgCreateCommunity{} }
+func (m *MsgCreateCommunity) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCommunity) ProtoMessage()    {}
+func (*MsgCreateCommunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{18}
 }
-func (m *MsgUpdateNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateCommunity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateCommunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateCommunity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1046,69 +1150,69 @@ func (m *MsgUpdateNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateNFT.Merge(m, src)
+func (m *MsgCreateCommunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCommunity.Merge(m, src)
 }
-func (m *MsgUpdateNFT) XXX_Size() int {
+func (m *MsgCreateCommunity) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateNFT.DiscardUnknown(m)
+func (m *MsgCreateCommunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCommunity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateCommunity proto.InternalMessageInfo
 
-func (m *MsgUpdateNFT) GetId() string {
+func (m *MsgCreateCommunity) GetCreator() string {
 	if m != nil {
-		return m.Id
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateNFT) GetClassId() string {
+func (m *MsgCreateCommunity) GetOwner() string {
 	if m != nil {
-		return m.ClassId
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgUpdateNFT) GetUri() string {
+func (m *MsgCreateCommunity) GetName() string {
 	if m != nil {
-		return m.Uri
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgUpdateNFT) GetData() *types1.Any {
+func (m *MsgCreateCommunity) GetDescription() string {
 	if m != nil {
-		return m.Data
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgUpdateNFT) GetSender() string {
+func (m *MsgCreateCommunity) GetJoinPolicy() CommunityJoinPolicy {
 	if m != nil {
-		return m.Sender
+		return m.JoinPolicy
 	}
-	return ""
+	return CommunityJoinPolicyOpen
 }
 
-// MsgUpdateNFTResponse defines the MsgUpdateNFT response type.
-type MsgUpdateNFTResponse struct {
+type MsgCreateCommunityResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateNFTResponse) Reset()         { *m = MsgUpdateNFTResponse{} }
-func (m *MsgUpdateNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateNFTResponse) ProtoMessage()    {}
-func (*MsgUpdateNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4b1477772a91a3, []int{17}
+func (m *MsgCreateCommunityResponse) Reset()         { *m = MsgCreateCommunityResponse{} }
+func (m *MsgCreateCommunityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCommunityResponse) ProtoMessage()    {}
+func (*MsgCreateCommunityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{19}
 }
-func (m *MsgUpdateNFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateCommunityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateCommunityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateCommunityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1118,37 +1222,43 @@ func (m *MsgUpdateNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateNFTResponse.Merge(m, src)
+func (m *MsgCreateCommunityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCommunityResponse.Merge(m, src)
 }
-func (m *MsgUpdateNFTResponse) XXX_Size() int {
+func (m *MsgCreateCommunityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateNFTResponse.DiscardUnknown(m)
+func (m *MsgCreateCommunityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCommunityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateCommunityResponse proto.InternalMessageInfo
 
-// MsgBurnNFT defines an SDK message for burning a NFT.
-type MsgBurnNFT struct {
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	Sender  string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+func (m *MsgCreateCommunityResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
-func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnNFT) ProtoMessage()    {}
-func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4b1477772a91a3, []int{18}
+type MsgJoinCommunity struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CommunityId uint64 `protobuf:"varint,2,opt,name=communityId,proto3" json:"communityId,omitempty"`
+	Member      string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
 }
-func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgJoinCommunity) Reset()         { *m = MsgJoinCommunity{} }
+func (m *MsgJoinCommunity) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinCommunity) ProtoMessage()    {}
+func (*MsgJoinCommunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{20}
+}
+func (m *MsgJoinCommunity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinCommunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinCommunity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1158,55 +1268,54 @@ func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnNFT.Merge(m, src)
+func (m *MsgJoinCommunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinCommunity.Merge(m, src)
 }
-func (m *MsgBurnNFT) XXX_Size() int {
+func (m *MsgJoinCommunity) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnNFT.DiscardUnknown(m)
+func (m *MsgJoinCommunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinCommunity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgJoinCommunity proto.InternalMessageInfo
 
-func (m *MsgBurnNFT) GetId() string {
+func (m *MsgJoinCommunity) GetCreator() string {
 	if m != nil {
-		return m.Id
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgBurnNFT) GetClassId() string {
+func (m *MsgJoinCommunity) GetCommunityId() uint64 {
 	if m != nil {
-		return m.ClassId
+		return m.CommunityId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgBurnNFT) GetSender() string {
+func (m *MsgJoinCommunity) GetMember() string {
 	if m != nil {
-		return m.Sender
+		return m.Member
 	}
 	return ""
 }
 
-// MsgBurnNFTResponse defines the Msg/BurnNFT response type.
-type MsgBurnNFTResponse struct {
+type MsgJoinCommunityResponse struct {
 }
 
-func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
-func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnNFTResponse) ProtoMessage()    {}
-func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4b1477772a91a3, []int{19}
+func (m *MsgJoinCommunityResponse) Reset()         { *m = MsgJoinCommunityResponse{} }
+func (m *MsgJoinCommunityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinCommunityResponse) ProtoMessage()    {}
+func (*MsgJoinCommunityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{21}
 }
-func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgJoinCommunityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinCommunityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinCommunityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1216,2061 +1325,15244 @@ func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnNFTResponse.Merge(m, src)
+func (m *MsgJoinCommunityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinCommunityResponse.Merge(m, src)
 }
-func (m *MsgBurnNFTResponse) XXX_Size() int {
+func (m *MsgJoinCommunityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnNFTResponse.DiscardUnknown(m)
+func (m *MsgJoinCommunityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinCommunityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgJoinCommunityResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateUserInfo)(nil), "misesid.misestm.v1beta1.MsgUpdateUserInfo")
-	proto.RegisterType((*MsgUpdateUserInfoResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateUserInfoResponse")
-	proto.RegisterType((*MsgUpdateUserRelation)(nil), "misesid.misestm.v1beta1.MsgUpdateUserRelation")
-	proto.RegisterType((*MsgUpdateUserRelationResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateUserRelationResponse")
-	proto.RegisterType((*MsgUpdateAppInfo)(nil), "misesid.misestm.v1beta1.MsgUpdateAppInfo")
-	proto.RegisterType((*MsgUpdateAppInfoResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateAppInfoResponse")
-	proto.RegisterType((*MsgCreateDidRegistry)(nil), "misesid.misestm.v1beta1.MsgCreateDidRegistry")
-	proto.RegisterType((*MsgCreateDidRegistryResponse)(nil), "misesid.misestm.v1beta1.MsgCreateDidRegistryResponse")
-	proto.RegisterType((*MsgNewDenom)(nil), "misesid.misestm.v1beta1.MsgNewDenom")
-	proto.RegisterType((*MsgNewDenomResponse)(nil), "misesid.misestm.v1beta1.MsgNewDenomResponse")
-	proto.RegisterType((*MsgNewNFTClass)(nil), "misesid.misestm.v1beta1.MsgNewNFTClass")
-	proto.RegisterType((*MsgNewNFTClassResponse)(nil), "misesid.misestm.v1beta1.MsgNewNFTClassResponse")
-	proto.RegisterType((*MsgUpdateNFTClass)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTClass")
-	proto.RegisterType((*MsgUpdateNFTClassResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTClassResponse")
-	proto.RegisterType((*MsgMintNFT)(nil), "misesid.misestm.v1beta1.MsgMintNFT")
-	proto.RegisterType((*MsgMintNFTResponse)(nil), "misesid.misestm.v1beta1.MsgMintNFTResponse")
-	proto.RegisterType((*MsgUpdateNFT)(nil), "misesid.misestm.v1beta1.MsgUpdateNFT")
-	proto.RegisterType((*MsgUpdateNFTResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTResponse")
-	proto.RegisterType((*MsgBurnNFT)(nil), "misesid.misestm.v1beta1.MsgBurnNFT")
-	proto.RegisterType((*MsgBurnNFTResponse)(nil), "misesid.misestm.v1beta1.MsgBurnNFTResponse")
+// MsgApproveCommunityMember approves a pending join request, or invites a DID
+// which has not asked to join yet
+type MsgApproveCommunityMember struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CommunityId uint64 `protobuf:"varint,2,opt,name=communityId,proto3" json:"communityId,omitempty"`
+	Operator    string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Member      string `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
 }
 
-func init() { proto.RegisterFile("misestm/v1beta1/tx.proto", fileDescriptor_5f4b1477772a91a3) }
+func (m *MsgApproveCommunityMember) Reset()         { *m = MsgApproveCommunityMember{} }
+func (m *MsgApproveCommunityMember) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCommunityMember) ProtoMessage()    {}
+func (*MsgApproveCommunityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{22}
+}
+func (m *MsgApproveCommunityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCommunityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCommunityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveCommunityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCommunityMember.Merge(m, src)
+}
+func (m *MsgApproveCommunityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCommunityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCommunityMember.DiscardUnknown(m)
+}
 
-var fileDescriptor_5f4b1477772a91a3 = []byte{
-	// 1135 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0x1c, 0x45,
-	0x10, 0xf6, 0xd8, 0xfb, 0x5b, 0x4e, 0xa2, 0xa4, 0xd9, 0x84, 0xf5, 0x90, 0xac, 0xcd, 0x12, 0x88,
-	0x81, 0x78, 0x46, 0x36, 0x82, 0x03, 0xe2, 0x92, 0xb5, 0xb5, 0x92, 0x0f, 0x6b, 0xa1, 0x91, 0x7d,
-	0x01, 0x09, 0x6b, 0x76, 0xa7, 0x3d, 0x6e, 0x79, 0x66, 0x7a, 0x34, 0x3d, 0xe3, 0x64, 0x25, 0x1e,
-	0x02, 0x89, 0x17, 0xe0, 0x01, 0xb8, 0xc3, 0x89, 0x0b, 0x97, 0x1c, 0x73, 0x04, 0x0e, 0x16, 0xd8,
-	0x17, 0x1e, 0x03, 0x75, 0x4f, 0x77, 0xef, 0xec, 0x8f, 0x37, 0xbb, 0x37, 0x4e, 0xee, 0xaa, 0xfa,
-	0xa6, 0xaa, 0xbe, 0xaa, 0xea, 0xda, 0x36, 0x34, 0x43, 0xc2, 0x30, 0x4b, 0x43, 0xfb, 0x72, 0xb7,
-	0x8f, 0x53, 0x77, 0xd7, 0x4e, 0x5f, 0x59, 0x71, 0x42, 0x53, 0x8a, 0xde, 0x15, 0x16, 0xe2, 0x59,
-	0x12, 0x61, 0x49, 0x84, 0xd9, 0xf0, 0xa9, 0x4f, 0x05, 0xc6, 0xe6, 0xa7, 0x1c, 0x6e, 0x6e, 0xf8,
-	0x94, 0xfa, 0x01, 0xb6, 0x85, 0xd4, 0xcf, 0xce, 0x6c, 0x37, 0x1a, 0x4a, 0x53, 0x6b, 0x40, 0x59,
-	0x48, 0x99, 0xdd, 0x77, 0xa3, 0x0b, 0x1d, 0x87, 0x0b, 0xca, 0x3e, 0x99, 0xc3, 0x09, 0xc3, 0xc9,
-	0x61, 0x74, 0xa6, 0x5c, 0xb7, 0x67, 0xd9, 0x1d, 0x1c, 0xb8, 0x29, 0xa1, 0x91, 0xc4, 0x3c, 0x99,
-	0xc4, 0xbc, 0x88, 0xe3, 0x82, 0x8b, 0xf7, 0x27, 0xcd, 0x07, 0xc4, 0x73, 0xb0, 0x4f, 0x58, 0x9a,
-	0xc8, 0x2c, 0xdb, 0xd7, 0x06, 0x3c, 0xe8, 0x31, 0xff, 0x24, 0xf6, 0xdc, 0x14, 0xab, 0x0c, 0x50,
-	0x13, 0xaa, 0x83, 0x04, 0xbb, 0x29, 0x4d, 0x9a, 0xc6, 0x96, 0xb1, 0x5d, 0x77, 0x94, 0x88, 0xee,
-	0xc3, 0x5a, 0x46, 0xbc, 0xe6, 0xaa, 0xd0, 0xf2, 0x23, 0xea, 0x40, 0x2d, 0xce, 0xfa, 0xa7, 0x24,
-	0x3a, 0xa3, 0xcd, 0xb5, 0x2d, 0x63, 0x7b, 0x7d, 0xef, 0x99, 0x75, 0x4b, 0x11, 0xad, 0xaf, 0xb3,
-	0x7e, 0x40, 0x06, 0x2a, 0x8c, 0x53, 0x8d, 0xb3, 0xbe, 0x88, 0xb7, 0x0f, 0xb5, 0x38, 0x21, 0xb9,
-	0x8f, 0x92, 0xf0, 0xb1, 0x7d, 0xbb, 0x8f, 0x84, 0x5c, 0x16, 0x72, 0x75, 0xaa, 0x71, 0x42, 0x54,
-	0xd2, 0x97, 0x38, 0x61, 0x84, 0x46, 0xcd, 0xf2, 0x96, 0xb1, 0x5d, 0x72, 0x94, 0xd8, 0x7e, 0x0f,
-	0x36, 0xa6, 0x38, 0x3a, 0x98, 0xc5, 0x34, 0x62, 0xb8, 0xfd, 0x8f, 0x01, 0x0f, 0xc7, 0xac, 0xaa,
-	0xc6, 0x73, 0xaa, 0xd0, 0x84, 0x6a, 0x46, 0xbc, 0x6e, 0x42, 0x43, 0x59, 0x09, 0x25, 0xa2, 0x06,
-	0x94, 0x33, 0xe2, 0x1d, 0xe7, 0xa5, 0xa8, 0x3b, 0xb9, 0x80, 0xb6, 0x60, 0x9d, 0xb0, 0x2e, 0x0d,
-	0x02, 0xfa, 0x92, 0x44, 0xbe, 0xa0, 0x58, 0x73, 0x8a, 0x2a, 0xd4, 0x02, 0x20, 0xac, 0x13, 0xd0,
-	0xc1, 0x05, 0x07, 0x94, 0x05, 0xa0, 0xa0, 0x41, 0x6d, 0xb8, 0x43, 0x98, 0x83, 0xcf, 0x70, 0x92,
-	0x60, 0xaf, 0x33, 0x6c, 0x56, 0x04, 0x62, 0x4c, 0x57, 0x2c, 0x40, 0x75, 0xbc, 0x00, 0x9b, 0xf0,
-	0x64, 0x26, 0x45, 0x5d, 0x84, 0x2b, 0x03, 0xee, 0x6b, 0x84, 0x1c, 0xa2, 0x39, 0xfc, 0x1b, 0x50,
-	0x76, 0xe3, 0x58, 0xcf, 0x41, 0x2e, 0x20, 0x04, 0xa5, 0xc8, 0x0d, 0xb1, 0xa4, 0x2e, 0xce, 0xdc,
-	0x87, 0x47, 0x43, 0x97, 0x44, 0xac, 0x59, 0xda, 0x5a, 0xe3, 0x3e, 0xa4, 0x88, 0x1e, 0x43, 0xdd,
-	0xc3, 0x97, 0x38, 0xa0, 0x31, 0x4e, 0x04, 0xe1, 0xba, 0x33, 0x52, 0xa0, 0x0d, 0xa8, 0x9d, 0xd3,
-	0x10, 0x9f, 0x66, 0x49, 0x20, 0xb8, 0xd6, 0x9d, 0x2a, 0x97, 0x4f, 0x92, 0x80, 0x9b, 0xc8, 0x80,
-	0x46, 0xc2, 0x54, 0xcd, 0x4d, 0x5c, 0xe6, 0xa6, 0x42, 0x05, 0x6a, 0xe3, 0x15, 0x30, 0xa1, 0x39,
-	0xc9, 0x4f, 0x93, 0xff, 0xd5, 0x80, 0x46, 0x8f, 0xf9, 0xfb, 0x9c, 0x1c, 0x2e, 0x5c, 0x91, 0xf9,
-	0xd7, 0xc0, 0x1b, 0x5d, 0x03, 0x8f, 0x78, 0x1c, 0x1b, 0x5f, 0xe0, 0xe1, 0x01, 0xf1, 0x24, 0x7f,
-	0x25, 0x22, 0x13, 0x6a, 0xfc, 0x78, 0x3c, 0x8c, 0xb1, 0xe8, 0x7c, 0xdd, 0xd1, 0x32, 0x7a, 0x0a,
-	0x77, 0xf9, 0xb9, 0x97, 0x05, 0x29, 0xe9, 0xbb, 0x0c, 0xcb, 0x42, 0x8c, 0x2b, 0x8b, 0xb4, 0x2a,
-	0xe3, 0xb4, 0x5a, 0xf0, 0x78, 0x56, 0xe6, 0x9a, 0xda, 0x9f, 0x06, 0xac, 0xf7, 0x98, 0x7f, 0x84,
-	0x5f, 0x1e, 0xe0, 0x88, 0x86, 0xe8, 0x1e, 0xac, 0x12, 0x4f, 0x92, 0x59, 0x25, 0x1e, 0xea, 0x42,
-	0xc5, 0x0d, 0x69, 0x16, 0xa5, 0x39, 0x95, 0x8e, 0xf5, 0xfa, 0x6a, 0x73, 0xe5, 0xaf, 0xab, 0xcd,
-	0x8f, 0x7c, 0x92, 0x9e, 0x67, 0x7d, 0x6b, 0x40, 0x43, 0x5b, 0xee, 0xb1, 0xfc, 0xcf, 0x0e, 0xf3,
-	0x2e, 0xec, 0x74, 0x18, 0x63, 0x66, 0x1d, 0x46, 0xa9, 0x23, 0xbf, 0x46, 0x5f, 0x01, 0x78, 0x3c,
-	0xc0, 0x69, 0x88, 0x53, 0x57, 0xae, 0x81, 0x27, 0x56, 0xfe, 0x89, 0x25, 0x96, 0x9e, 0xba, 0xbe,
-	0x3d, 0x9c, 0xba, 0x9e, 0x9b, 0xba, 0xbc, 0xd9, 0x11, 0x0d, 0xb9, 0x88, 0x1e, 0x41, 0x85, 0xe1,
-	0xc8, 0xc3, 0x89, 0xac, 0x8f, 0x94, 0xf8, 0x88, 0x24, 0x78, 0x40, 0x62, 0x82, 0xa3, 0x54, 0x8d,
-	0x88, 0x56, 0xb4, 0x1f, 0xc2, 0x3b, 0x05, 0x6a, 0x9a, 0xf2, 0x6f, 0x06, 0xdc, 0xcb, 0xf5, 0x47,
-	0xdd, 0xe3, 0xfd, 0xc0, 0x65, 0x6c, 0x8a, 0xb5, 0x1a, 0xd4, 0xd5, 0xc2, 0xa0, 0xf2, 0xc5, 0x96,
-	0x10, 0xd9, 0x3b, 0x7e, 0x14, 0x59, 0x0d, 0xce, 0x71, 0xe8, 0xea, 0xac, 0x84, 0x24, 0xf4, 0xc3,
-	0xb0, 0x4f, 0x03, 0x99, 0x92, 0x94, 0xd0, 0x36, 0x94, 0x38, 0x31, 0xd1, 0xa2, 0xf5, 0xbd, 0x86,
-	0x95, 0xff, 0x34, 0x58, 0xea, 0xa7, 0xc1, 0x7a, 0x11, 0x0d, 0x1d, 0x81, 0x28, 0xf0, 0xad, 0x16,
-	0xf9, 0x7e, 0x59, 0xfa, 0xf7, 0xa7, 0x4d, 0xa3, 0xdd, 0x84, 0x47, 0xe3, 0xf9, 0x6b, 0x6a, 0x3f,
-	0x17, 0x97, 0xf5, 0xad, 0xec, 0x36, 0xa0, 0x36, 0xe0, 0x86, 0x53, 0x3d, 0xa0, 0x55, 0x21, 0x1f,
-	0xce, 0xbe, 0xa1, 0x92, 0x78, 0x69, 0x44, 0x5c, 0x11, 0x29, 0x2f, 0x41, 0xa4, 0x32, 0x83, 0x48,
-	0x71, 0xed, 0x4e, 0x71, 0xf9, 0xdd, 0x00, 0xe8, 0x31, 0xbf, 0x47, 0xa2, 0xf4, 0xa8, 0x7b, 0xfc,
-	0x3f, 0x24, 0x31, 0x3e, 0x7d, 0xd5, 0x89, 0xe9, 0x93, 0x14, 0x1b, 0x80, 0x46, 0x24, 0x34, 0xb7,
-	0x1f, 0x0d, 0xb8, 0x53, 0x64, 0xbe, 0x0c, 0xbb, 0xe9, 0x39, 0x54, 0x4c, 0x4a, 0x4b, 0x30, 0x29,
-	0xcf, 0x68, 0xc7, 0x23, 0xb1, 0xe5, 0x74, 0x52, 0x3a, 0xdb, 0x13, 0xd1, 0x88, 0x4e, 0x96, 0x44,
-	0x4b, 0xa6, 0x3a, 0x0a, 0xb7, 0x36, 0x23, 0x5c, 0x5e, 0x1a, 0xe9, 0x56, 0x05, 0xdb, 0xfb, 0xa5,
-	0x06, 0x6b, 0x3d, 0xe6, 0xa3, 0xef, 0xa0, 0xa6, 0x97, 0xd2, 0xd3, 0x5b, 0x7f, 0xeb, 0x0b, 0xf7,
-	0xdb, 0x7c, 0xbe, 0x08, 0x4a, 0xc5, 0x41, 0x3e, 0xac, 0x17, 0x37, 0xc0, 0xb3, 0xb7, 0x7c, 0xac,
-	0x80, 0xa6, 0xbd, 0x20, 0x50, 0x07, 0x8a, 0xe1, 0xde, 0xc4, 0x7d, 0xfc, 0x64, 0x9e, 0x8b, 0x71,
-	0xac, 0xb9, 0xb7, 0x38, 0x56, 0x47, 0xfc, 0x16, 0xaa, 0xea, 0xd6, 0x7c, 0x30, 0xef, 0x73, 0x09,
-	0x32, 0x3f, 0x5d, 0x00, 0xa4, 0x9d, 0xbb, 0x50, 0x1f, 0x8d, 0xed, 0x87, 0x0b, 0x65, 0x67, 0xee,
-	0x2c, 0x04, 0x2b, 0xe6, 0xaf, 0x86, 0x6d, 0x6e, 0xfe, 0x12, 0x34, 0x3f, 0xff, 0x89, 0xf9, 0x1a,
-	0xb5, 0x43, 0xbf, 0x65, 0x17, 0x68, 0x87, 0xc2, 0x2e, 0xd2, 0x8e, 0xc9, 0xf7, 0x23, 0xfa, 0x1e,
-	0xd0, 0x8c, 0xb7, 0xa3, 0xb5, 0x98, 0x27, 0x85, 0x37, 0xbf, 0x58, 0x0e, 0xaf, 0xa3, 0x87, 0x70,
-	0x77, 0xfc, 0xd1, 0xf6, 0xf1, 0xdb, 0x1d, 0x49, 0xa8, 0xb9, 0xbb, 0x30, 0x54, 0x87, 0x1b, 0xc2,
-	0x83, 0xe9, 0x67, 0xd2, 0xdc, 0xfe, 0x4f, 0xc1, 0xcd, 0xcf, 0x97, 0x82, 0xab, 0xd0, 0x9d, 0xee,
-	0xeb, 0xeb, 0x96, 0xf1, 0xe6, 0xba, 0x65, 0xfc, 0x7d, 0xdd, 0x32, 0x7e, 0xb8, 0x69, 0xad, 0xbc,
-	0xb9, 0x69, 0xad, 0xfc, 0x71, 0xd3, 0x5a, 0xf9, 0xe6, 0x79, 0xe1, 0xb1, 0x22, 0x5c, 0xee, 0x10,
-	0x4f, 0x1e, 0xd2, 0xd0, 0x7e, 0x65, 0xab, 0xff, 0x82, 0xc4, 0xb3, 0xa5, 0x5f, 0x11, 0x8b, 0xf3,
-	0xb3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa4, 0xf7, 0x5f, 0x19, 0x04, 0x0e, 0x00, 0x00,
+var xxx_messageInfo_MsgApproveCommunityMember proto.InternalMessageInfo
+
+func (m *MsgApproveCommunityMember) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (this *MsgNewNFTClass) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgApproveCommunityMember) GetCommunityId() uint64 {
+	if m != nil {
+		return m.CommunityId
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgNewNFTClass)
-	if !ok {
-		that2, ok := that.(MsgNewNFTClass)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MsgApproveCommunityMember) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *MsgApproveCommunityMember) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Uri != that1.Uri {
-		return false
-	}
-	if this.Schema != that1.Schema {
-		return false
-	}
-	if this.Symbol != that1.Symbol {
-		return false
-	}
-	if !this.Data.Equal(that1.Data) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
+	return ""
 }
-func (this *MsgUpdateNFTClass) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgUpdateNFTClass)
-	if !ok {
-		that2, ok := that.(MsgUpdateNFTClass)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ClassId != that1.ClassId {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Uri != that1.Uri {
-		return false
-	}
-	if !this.Data.Equal(that1.Data) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
+type MsgApproveCommunityMemberResponse struct {
 }
-func (this *MsgMintNFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgMintNFT)
-	if !ok {
-		that2, ok := that.(MsgMintNFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgApproveCommunityMemberResponse) Reset()         { *m = MsgApproveCommunityMemberResponse{} }
+func (m *MsgApproveCommunityMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCommunityMemberResponse) ProtoMessage()    {}
+func (*MsgApproveCommunityMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{23}
+}
+func (m *MsgApproveCommunityMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCommunityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCommunityMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ClassId != that1.ClassId {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Uri != that1.Uri {
-		return false
-	}
-	if !this.Data.Equal(that1.Data) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.Recipient != that1.Recipient {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateNFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgApproveCommunityMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCommunityMemberResponse.Merge(m, src)
+}
+func (m *MsgApproveCommunityMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCommunityMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCommunityMemberResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateNFT)
-	if !ok {
-		that2, ok := that.(MsgUpdateNFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ClassId != that1.ClassId {
-		return false
-	}
-	if this.Uri != that1.Uri {
-		return false
-	}
-	if !this.Data.Equal(that1.Data) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
+var xxx_messageInfo_MsgApproveCommunityMemberResponse proto.InternalMessageInfo
+
+// MsgKickCommunityMember removes a member, join request or invitation,
+// a member can kick itself to leave the community
+type MsgKickCommunityMember struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CommunityId uint64 `protobuf:"varint,2,opt,name=communityId,proto3" json:"communityId,omitempty"`
+	Operator    string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Member      string `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
 }
-func (this *MsgBurnNFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgBurnNFT)
-	if !ok {
-		that2, ok := that.(MsgBurnNFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgKickCommunityMember) Reset()         { *m = MsgKickCommunityMember{} }
+func (m *MsgKickCommunityMember) String() string { return proto.CompactTextString(m) }
+func (*MsgKickCommunityMember) ProtoMessage()    {}
+func (*MsgKickCommunityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{24}
+}
+func (m *MsgKickCommunityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgKickCommunityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgKickCommunityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ClassId != that1.ClassId {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// NewDenom defines a method for create a new denom.
-	NewDenom(ctx context.Context, in *MsgNewDenom, opts ...grpc.CallOption) (*MsgNewDenomResponse, error)
-	NewNFTClass(ctx context.Context, in *MsgNewNFTClass, opts ...grpc.CallOption) (*MsgNewNFTClassResponse, error)
-	UpdateNFTClass(ctx context.Context, in *MsgUpdateNFTClass, opts ...grpc.CallOption) (*MsgUpdateNFTClassResponse, error)
-	// MintNFT defines a method for mint a new nft
-	MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error)
-	// UpdateNFT defines a method for editing a nft.
-	UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error)
-	// BurnNFT defines a method for burning a nft.
-	BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error)
-	UpdateUserInfo(ctx context.Context, in *MsgUpdateUserInfo, opts ...grpc.CallOption) (*MsgUpdateUserInfoResponse, error)
-	UpdateUserRelation(ctx context.Context, in *MsgUpdateUserRelation, opts ...grpc.CallOption) (*MsgUpdateUserRelationResponse, error)
-	UpdateAppInfo(ctx context.Context, in *MsgUpdateAppInfo, opts ...grpc.CallOption) (*MsgUpdateAppInfoResponse, error)
-	CreateDidRegistry(ctx context.Context, in *MsgCreateDidRegistry, opts ...grpc.CallOption) (*MsgCreateDidRegistryResponse, error)
+func (m *MsgKickCommunityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgKickCommunityMember.Merge(m, src)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgKickCommunityMember) XXX_Size() int {
+	return m.Size()
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgKickCommunityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgKickCommunityMember.DiscardUnknown(m)
 }
 
-func (c *msgClient) NewDenom(ctx context.Context, in *MsgNewDenom, opts ...grpc.CallOption) (*MsgNewDenomResponse, error) {
-	out := new(MsgNewDenomResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/NewDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgKickCommunityMember proto.InternalMessageInfo
+
+func (m *MsgKickCommunityMember) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) NewNFTClass(ctx context.Context, in *MsgNewNFTClass, opts ...grpc.CallOption) (*MsgNewNFTClassResponse, error) {
-	out := new(MsgNewNFTClassResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/NewNFTClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgKickCommunityMember) GetCommunityId() uint64 {
+	if m != nil {
+		return m.CommunityId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateNFTClass(ctx context.Context, in *MsgUpdateNFTClass, opts ...grpc.CallOption) (*MsgUpdateNFTClassResponse, error) {
-	out := new(MsgUpdateNFTClassResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateNFTClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgKickCommunityMember) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error) {
-	out := new(MsgMintNFTResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/MintNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgKickCommunityMember) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error) {
-	out := new(MsgUpdateNFTResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgKickCommunityMemberResponse struct {
 }
 
-func (c *msgClient) BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error) {
-	out := new(MsgBurnNFTResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/BurnNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgKickCommunityMemberResponse) Reset()         { *m = MsgKickCommunityMemberResponse{} }
+func (m *MsgKickCommunityMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgKickCommunityMemberResponse) ProtoMessage()    {}
+func (*MsgKickCommunityMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{25}
+}
+func (m *MsgKickCommunityMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgKickCommunityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgKickCommunityMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgKickCommunityMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgKickCommunityMemberResponse.Merge(m, src)
+}
+func (m *MsgKickCommunityMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgKickCommunityMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgKickCommunityMemberResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateUserInfo(ctx context.Context, in *MsgUpdateUserInfo, opts ...grpc.CallOption) (*MsgUpdateUserInfoResponse, error) {
-	out := new(MsgUpdateUserInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateUserInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgKickCommunityMemberResponse proto.InternalMessageInfo
+
+// MsgChangeCommunityRole changes the role of a member, changing the role to
+// owner transfers the ownership and the previous owner becomes a moderator
+type MsgChangeCommunityRole struct {
+	Creator     string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CommunityId uint64        `protobuf:"varint,2,opt,name=communityId,proto3" json:"communityId,omitempty"`
+	Operator    string        `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Member      string        `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
+	Role        CommunityRole `protobuf:"varint,5,opt,name=role,proto3,enum=misesid.misestm.v1beta1.CommunityRole" json:"role,omitempty"`
+}
+
+func (m *MsgChangeCommunityRole) Reset()         { *m = MsgChangeCommunityRole{} }
+func (m *MsgChangeCommunityRole) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeCommunityRole) ProtoMessage()    {}
+func (*MsgChangeCommunityRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{26}
+}
+func (m *MsgChangeCommunityRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeCommunityRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeCommunityRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgChangeCommunityRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeCommunityRole.Merge(m, src)
+}
+func (m *MsgChangeCommunityRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeCommunityRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeCommunityRole.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateUserRelation(ctx context.Context, in *MsgUpdateUserRelation, opts ...grpc.CallOption) (*MsgUpdateUserRelationResponse, error) {
-	out := new(MsgUpdateUserRelationResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateUserRelation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeCommunityRole proto.InternalMessageInfo
+
+func (m *MsgChangeCommunityRole) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateAppInfo(ctx context.Context, in *MsgUpdateAppInfo, opts ...grpc.CallOption) (*MsgUpdateAppInfoResponse, error) {
-	out := new(MsgUpdateAppInfoResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateAppInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeCommunityRole) GetCommunityId() uint64 {
+	if m != nil {
+		return m.CommunityId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateDidRegistry(ctx context.Context, in *MsgCreateDidRegistry, opts ...grpc.CallOption) (*MsgCreateDidRegistryResponse, error) {
-	out := new(MsgCreateDidRegistryResponse)
-	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/CreateDidRegistry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeCommunityRole) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// NewDenom defines a method for create a new denom.
-	NewDenom(context.Context, *MsgNewDenom) (*MsgNewDenomResponse, error)
-	NewNFTClass(context.Context, *MsgNewNFTClass) (*MsgNewNFTClassResponse, error)
-	UpdateNFTClass(context.Context, *MsgUpdateNFTClass) (*MsgUpdateNFTClassResponse, error)
-	// MintNFT defines a method for mint a new nft
-	MintNFT(context.Context, *MsgMintNFT) (*MsgMintNFTResponse, error)
-	// UpdateNFT defines a method for editing a nft.
-	UpdateNFT(context.Context, *MsgUpdateNFT) (*MsgUpdateNFTResponse, error)
-	// BurnNFT defines a method for burning a nft.
-	BurnNFT(context.Context, *MsgBurnNFT) (*MsgBurnNFTResponse, error)
-	UpdateUserInfo(context.Context, *MsgUpdateUserInfo) (*MsgUpdateUserInfoResponse, error)
-	UpdateUserRelation(context.Context, *MsgUpdateUserRelation) (*MsgUpdateUserRelationResponse, error)
-	UpdateAppInfo(context.Context, *MsgUpdateAppInfo) (*MsgUpdateAppInfoResponse, error)
-	CreateDidRegistry(context.Context, *MsgCreateDidRegistry) (*MsgCreateDidRegistryResponse, error)
+func (m *MsgChangeCommunityRole) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgChangeCommunityRole) GetRole() CommunityRole {
+	if m != nil {
+		return m.Role
+	}
+	return CommunityRoleMember
 }
 
-func (*UnimplementedMsgServer) NewDenom(ctx context.Context, req *MsgNewDenom) (*MsgNewDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewDenom not implemented")
+type MsgChangeCommunityRoleResponse struct {
 }
-func (*UnimplementedMsgServer) NewNFTClass(ctx context.Context, req *MsgNewNFTClass) (*MsgNewNFTClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewNFTClass not implemented")
+
+func (m *MsgChangeCommunityRoleResponse) Reset()         { *m = MsgChangeCommunityRoleResponse{} }
+func (m *MsgChangeCommunityRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeCommunityRoleResponse) ProtoMessage()    {}
+func (*MsgChangeCommunityRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{27}
 }
-func (*UnimplementedMsgServer) UpdateNFTClass(ctx context.Context, req *MsgUpdateNFTClass) (*MsgUpdateNFTClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNFTClass not implemented")
+func (m *MsgChangeCommunityRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MintNFT(ctx context.Context, req *MsgMintNFT) (*MsgMintNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintNFT not implemented")
+func (m *MsgChangeCommunityRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeCommunityRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateNFT(ctx context.Context, req *MsgUpdateNFT) (*MsgUpdateNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNFT not implemented")
+func (m *MsgChangeCommunityRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeCommunityRoleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) BurnNFT(ctx context.Context, req *MsgBurnNFT) (*MsgBurnNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnNFT not implemented")
+func (m *MsgChangeCommunityRoleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateUserInfo(ctx context.Context, req *MsgUpdateUserInfo) (*MsgUpdateUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+func (m *MsgChangeCommunityRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeCommunityRoleResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateUserRelation(ctx context.Context, req *MsgUpdateUserRelation) (*MsgUpdateUserRelationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserRelation not implemented")
+
+var xxx_messageInfo_MsgChangeCommunityRoleResponse proto.InternalMessageInfo
+
+// MsgNewDenom defines an SDK message for creating a new denom.
+type MsgNewDenom struct {
+	Id        string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	DenomMeta *types.Metadata                        `protobuf:"bytes,3,opt,name=denom_meta,json=denomMeta,proto3" json:"denom_meta,omitempty"`
+	Sender    string                                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string                                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// app issuing the denom, the sender must administer it
+	Appid string `protobuf:"bytes,6,opt,name=appid,proto3" json:"appid,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateAppInfo(ctx context.Context, req *MsgUpdateAppInfo) (*MsgUpdateAppInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppInfo not implemented")
+
+func (m *MsgNewDenom) Reset()         { *m = MsgNewDenom{} }
+func (m *MsgNewDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgNewDenom) ProtoMessage()    {}
+func (*MsgNewDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{28}
 }
-func (*UnimplementedMsgServer) CreateDidRegistry(ctx context.Context, req *MsgCreateDidRegistry) (*MsgCreateDidRegistryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDidRegistry not implemented")
+func (m *MsgNewDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgNewDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNewDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewDenom.Merge(m, src)
+}
+func (m *MsgNewDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNewDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewDenom.DiscardUnknown(m)
 }
 
-func _Msg_NewDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewDenom)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/NewDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewDenom(ctx, req.(*MsgNewDenom))
+var xxx_messageInfo_MsgNewDenom proto.InternalMessageInfo
+
+func (m *MsgNewDenom) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_NewNFTClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewNFTClass)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewNFTClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/NewNFTClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewNFTClass(ctx, req.(*MsgNewNFTClass))
+func (m *MsgNewDenom) GetDenomMeta() *types.Metadata {
+	if m != nil {
+		return m.DenomMeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateNFTClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateNFTClass)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateNFTClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateNFTClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateNFTClass(ctx, req.(*MsgUpdateNFTClass))
+func (m *MsgNewDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/MintNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintNFT(ctx, req.(*MsgMintNFT))
+func (m *MsgNewDenom) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateNFT(ctx, in)
+func (m *MsgNewDenom) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateNFT(ctx, req.(*MsgUpdateNFT))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/BurnNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnNFT(ctx, req.(*MsgBurnNFT))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgNewDenomResponse defines the MsgNewDenom response type.
+type MsgNewDenomResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateUserInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateUserInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateUserInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateUserInfo(ctx, req.(*MsgUpdateUserInfo))
+func (m *MsgNewDenomResponse) Reset()         { *m = MsgNewDenomResponse{} }
+func (m *MsgNewDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNewDenomResponse) ProtoMessage()    {}
+func (*MsgNewDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{29}
+}
+func (m *MsgNewDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNewDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgNewDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewDenomResponse.Merge(m, src)
+}
+func (m *MsgNewDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNewDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewDenomResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateUserRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateUserRelation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateUserRelation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateUserRelation",
+var xxx_messageInfo_MsgNewDenomResponse proto.InternalMessageInfo
+
+func (m *MsgNewDenomResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateUserRelation(ctx, req.(*MsgUpdateUserRelation))
+	return ""
+}
+
+// MsgNewNFTClass defines an SDK message for creating a new NFTClass.
+type MsgNewNFTClass struct {
+	Id                 string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uri                string      `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Schema             string      `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	Symbol             string      `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Data               *types1.Any `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Sender             string      `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	RoyaltyRecipient   string      `protobuf:"bytes,8,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	RoyaltyBasisPoints uint32      `protobuf:"varint,9,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
+	Soulbound          bool        `protobuf:"varint,10,opt,name=soulbound,proto3" json:"soulbound,omitempty"`
+}
+
+func (m *MsgNewNFTClass) Reset()         { *m = MsgNewNFTClass{} }
+func (m *MsgNewNFTClass) String() string { return proto.CompactTextString(m) }
+func (*MsgNewNFTClass) ProtoMessage()    {}
+func (*MsgNewNFTClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{30}
+}
+func (m *MsgNewNFTClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNewNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewNFTClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgNewNFTClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewNFTClass.Merge(m, src)
+}
+func (m *MsgNewNFTClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNewNFTClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewNFTClass.DiscardUnknown(m)
 }
 
-func _Msg_UpdateAppInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAppInfo)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgNewNFTClass proto.InternalMessageInfo
+
+func (m *MsgNewNFTClass) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAppInfo(ctx, in)
+	return ""
+}
+
+func (m *MsgNewNFTClass) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateAppInfo",
+	return ""
+}
+
+func (m *MsgNewNFTClass) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAppInfo(ctx, req.(*MsgUpdateAppInfo))
+	return ""
+}
+
+func (m *MsgNewNFTClass) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateDidRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDidRegistry)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgNewNFTClass) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDidRegistry(ctx, in)
+	return ""
+}
+
+func (m *MsgNewNFTClass) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/misesid.misestm.v1beta1.Msg/CreateDidRegistry",
+	return nil
+}
+
+func (m *MsgNewNFTClass) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDidRegistry(ctx, req.(*MsgCreateDidRegistry))
+	return ""
+}
+
+func (m *MsgNewNFTClass) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "misesid.misestm.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NewDenom",
-			Handler:    _Msg_NewDenom_Handler,
-		},
-		{
-			MethodName: "NewNFTClass",
-			Handler:    _Msg_NewNFTClass_Handler,
-		},
-		{
-			MethodName: "UpdateNFTClass",
-			Handler:    _Msg_UpdateNFTClass_Handler,
-		},
-		{
-			MethodName: "MintNFT",
-			Handler:    _Msg_MintNFT_Handler,
-		},
-		{
-			MethodName: "UpdateNFT",
-			Handler:    _Msg_UpdateNFT_Handler,
-		},
-		{
-			MethodName: "BurnNFT",
-			Handler:    _Msg_BurnNFT_Handler,
-		},
-		{
-			MethodName: "UpdateUserInfo",
-			Handler:    _Msg_UpdateUserInfo_Handler,
-		},
-		{
-			MethodName: "UpdateUserRelation",
-			Handler:    _Msg_UpdateUserRelation_Handler,
-		},
-		{
-			MethodName: "UpdateAppInfo",
-			Handler:    _Msg_UpdateAppInfo_Handler,
-		},
-		{
-			MethodName: "CreateDidRegistry",
-			Handler:    _Msg_CreateDidRegistry_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "misestm/v1beta1/tx.proto",
+func (m *MsgNewNFTClass) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
 }
 
-func (m *MsgUpdateUserInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgNewNFTClass) GetSoulbound() bool {
+	if m != nil {
+		return m.Soulbound
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateUserInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgNewNFTClassResponse defines the MsgNewNFTClass response type.
+type MsgNewNFTClassResponse struct {
 }
 
-func (m *MsgUpdateUserInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PriInfo != nil {
-		{
-			size, err := m.PriInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PubInfo != nil {
-		{
-			size, err := m.PubInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgNewNFTClassResponse) Reset()         { *m = MsgNewNFTClassResponse{} }
+func (m *MsgNewNFTClassResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNewNFTClassResponse) ProtoMessage()    {}
+func (*MsgNewNFTClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{31}
+}
+func (m *MsgNewNFTClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNewNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewNFTClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateUserInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgNewNFTClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewNFTClassResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgNewNFTClassResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgNewNFTClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewNFTClassResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateUserRelation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgNewNFTClassResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateUserRelation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateNFTClass defines an SDK message for editing a nft class.
+type MsgUpdateNFTClass struct {
+	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Name    string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uri     string      `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Data    *types1.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Sender  string      `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgUpdateUserRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.IsReferredBy {
-		i--
-		if m.IsReferredBy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.IsBlocking {
-		i--
-		if m.IsBlocking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IsFollowing {
-		i--
-		if m.IsFollowing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateNFTClass) Reset()         { *m = MsgUpdateNFTClass{} }
+func (m *MsgUpdateNFTClass) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNFTClass) ProtoMessage()    {}
+func (*MsgUpdateNFTClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{32}
+}
+func (m *MsgUpdateNFTClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNFTClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNFTClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.UidTo) > 0 {
-		i -= len(m.UidTo)
-		copy(dAtA[i:], m.UidTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UidTo)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.UidFrom) > 0 {
-		i -= len(m.UidFrom)
-		copy(dAtA[i:], m.UidFrom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UidFrom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUpdateNFTClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNFTClass.Merge(m, src)
+}
+func (m *MsgUpdateNFTClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNFTClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNFTClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNFTClass proto.InternalMessageInfo
+
+func (m *MsgUpdateNFTClass) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateNFTClass) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateUserRelationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNFTClass) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateNFTClass) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
 }
 
-func (m *MsgUpdateUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateNFTClass) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *MsgUpdateAppInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNFTClass) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateAppInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateNFTClassResponse defines the MsgUpdateNFTClass response type.
+type MsgUpdateNFTClassResponse struct {
 }
 
-func (m *MsgUpdateAppInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.IconUrl) > 0 {
-		i -= len(m.IconUrl)
-		copy(dAtA[i:], m.IconUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IconUrl)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.HomeUrl) > 0 {
-		i -= len(m.HomeUrl)
-		copy(dAtA[i:], m.HomeUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HomeUrl)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Developer) > 0 {
-		i -= len(m.Developer)
-		copy(dAtA[i:], m.Developer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Domains) > 0 {
-		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Domains[iNdEx])
-			copy(dAtA[i:], m.Domains[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Domains[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgUpdateNFTClassResponse) Reset()         { *m = MsgUpdateNFTClassResponse{} }
+func (m *MsgUpdateNFTClassResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNFTClassResponse) ProtoMessage()    {}
+func (*MsgUpdateNFTClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{33}
+}
+func (m *MsgUpdateNFTClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNFTClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNFTClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Appid) > 0 {
-		i -= len(m.Appid)
-		copy(dAtA[i:], m.Appid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateAppInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateNFTClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNFTClassResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateNFTClassResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNFTClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNFTClassResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateNFTClassResponse proto.InternalMessageInfo
+
+// MsgMintNFT defines an SDK message for creating a new NFT.
+type MsgMintNFT struct {
+	Id        string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Name      string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uri       string      `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Data      *types1.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Sender    string      `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string      `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgCreateDidRegistry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
+func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgMintNFT) ProtoMessage()    {}
+func (*MsgMintNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{34}
+}
+func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateDidRegistry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMintNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintNFT.Merge(m, src)
+}
+func (m *MsgMintNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintNFT.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDidRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.PkeyMultibase) > 0 {
-		i -= len(m.PkeyMultibase)
-		copy(dAtA[i:], m.PkeyMultibase)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyMultibase)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PkeyType) > 0 {
-		i -= len(m.PkeyType)
-		copy(dAtA[i:], m.PkeyType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PkeyDid) > 0 {
-		i -= len(m.PkeyDid)
-		copy(dAtA[i:], m.PkeyDid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyDid)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgMintNFT proto.InternalMessageInfo
+
+func (m *MsgMintNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgMintNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgMintNFT) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateDidRegistryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMintNFT) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMintNFT) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *MsgCreateDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgMintNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgNewDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMintNFT) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgNewDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgMintNFTResponse defines the Msg/MintNFT response type.
+type MsgMintNFTResponse struct {
 }
 
-func (m *MsgNewDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DenomMeta != nil {
-		{
-			size, err := m.DenomMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
+func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintNFTResponse) ProtoMessage()    {}
+func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{35}
+}
+func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgNewDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgMintNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintNFTResponse.Merge(m, src)
 }
-
-func (m *MsgNewDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMintNFTResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgNewDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgMintNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintNFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewNFTClass) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgMintNFTResponse proto.InternalMessageInfo
 
-func (m *MsgNewNFTClass) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateNFT defines an SDK message for update a NFT.
+type MsgUpdateNFT struct {
+	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Uri     string      `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Data    *types1.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Sender  string      `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgNewNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateNFT) Reset()         { *m = MsgUpdateNFT{} }
+func (m *MsgUpdateNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNFT) ProtoMessage()    {}
+func (*MsgUpdateNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{36}
+}
+func (m *MsgUpdateNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNFT.Merge(m, src)
+}
+func (m *MsgUpdateNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNFT proto.InternalMessageInfo
+
+func (m *MsgUpdateNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgNewNFTClassResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgNewNFTClassResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateNFT) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
 }
 
-func (m *MsgNewNFTClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateNFT) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *MsgUpdateNFTClass) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateNFTClass) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateNFTResponse defines the MsgUpdateNFT response type.
+type MsgUpdateNFTResponse struct {
 }
 
-func (m *MsgUpdateNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateNFTResponse) Reset()         { *m = MsgUpdateNFTResponse{} }
+func (m *MsgUpdateNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNFTResponse) ProtoMessage()    {}
+func (*MsgUpdateNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{37}
+}
+func (m *MsgUpdateNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateNFTClassResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNFTResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateNFTClassResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateNFTResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateNFTClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateNFTResponse proto.InternalMessageInfo
 
-func (m *MsgMintNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBurnNFT defines an SDK message for burning a NFT.
+type MsgBurnNFT struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Sender  string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
+func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNFT) ProtoMessage()    {}
+func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{38}
+}
+func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgBurnNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNFT.Merge(m, src)
+}
+func (m *MsgBurnNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnNFT proto.InternalMessageInfo
+
+func (m *MsgBurnNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMintNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurnNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMintNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBurnNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgMintNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgBurnNFTResponse defines the Msg/BurnNFT response type.
+type MsgBurnNFTResponse struct {
 }
 
-func (m *MsgUpdateNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
+func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNFTResponse) ProtoMessage()    {}
+func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{39}
+}
+func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBurnNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNFTResponse.Merge(m, src)
+}
+func (m *MsgBurnNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBurnNFTResponse proto.InternalMessageInfo
+
+// MsgTransferNFT defines an SDK message for transferring a NFT, with a price
+// the recipient signs too and pays the sender and the class royalty.
+type MsgTransferNFT struct {
+	Id        string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   string                                   `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Sender    string                                   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string                                   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
 }
 
-func (m *MsgUpdateNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgTransferNFT) Reset()         { *m = MsgTransferNFT{} }
+func (m *MsgTransferNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNFT) ProtoMessage()    {}
+func (*MsgTransferNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{40}
+}
+func (m *MsgTransferNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNFT.Merge(m, src)
+}
+func (m *MsgTransferNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNFT proto.InternalMessageInfo
+
+func (m *MsgTransferNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgUpdateNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferNFT) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNFT) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgBurnNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferNFTResponse defines the Msg/TransferNFT response type.
+type MsgTransferNFTResponse struct {
+	Royalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=royalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"royalty"`
 }
 
-func (m *MsgBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgTransferNFTResponse) Reset()         { *m = MsgTransferNFTResponse{} }
+func (m *MsgTransferNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNFTResponse) ProtoMessage()    {}
+func (*MsgTransferNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{41}
+}
+func (m *MsgTransferNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNFTResponse.Merge(m, src)
+}
+func (m *MsgTransferNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferNFTResponse proto.InternalMessageInfo
+
+func (m *MsgTransferNFTResponse) GetRoyalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Royalty
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeNFT defines an SDK message for revoking a NFT of a soulbound class.
+type MsgRevokeNFT struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Sender  string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeNFT) Reset()         { *m = MsgRevokeNFT{} }
+func (m *MsgRevokeNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNFT) ProtoMessage()    {}
+func (*MsgRevokeNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{42}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRevokeNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateUserInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PubInfo != nil {
-		l = m.PubInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PriInfo != nil {
-		l = m.PriInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+func (m *MsgRevokeNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRevokeNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNFT.Merge(m, src)
+}
+func (m *MsgRevokeNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNFT.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateUserInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRevokeNFT proto.InternalMessageInfo
+
+func (m *MsgRevokeNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateUserRelation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UidFrom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UidTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsFollowing {
-		n += 2
-	}
-	if m.IsBlocking {
-		n += 2
-	}
-	if m.IsReferredBy {
-		n += 2
-	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+func (m *MsgRevokeNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateUserRelationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateAppInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Appid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Domains) > 0 {
-		for _, s := range m.Domains {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+// MsgRevokeNFTResponse defines the Msg/RevokeNFT response type.
+type MsgRevokeNFTResponse struct {
+}
+
+func (m *MsgRevokeNFTResponse) Reset()         { *m = MsgRevokeNFTResponse{} }
+func (m *MsgRevokeNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNFTResponse) ProtoMessage()    {}
+func (*MsgRevokeNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{43}
+}
+func (m *MsgRevokeNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Developer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HomeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IconUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
-	}
-	return n
+}
+func (m *MsgRevokeNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNFTResponse.Merge(m, src)
+}
+func (m *MsgRevokeNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateAppInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRevokeNFTResponse proto.InternalMessageInfo
+
+// MsgTransferDidNFT defines an SDK message for transferring a NFT between mises ids, with a price
+// the recipient signs too and pays the sender and the class royalty.
+type MsgTransferDidNFT struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// mises id of the owner, signs the message
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// mises id of the new owner
+	Recipient string                                   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+}
+
+func (m *MsgTransferDidNFT) Reset()         { *m = MsgTransferDidNFT{} }
+func (m *MsgTransferDidNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDidNFT) ProtoMessage()    {}
+func (*MsgTransferDidNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{44}
+}
+func (m *MsgTransferDidNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDidNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDidNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgTransferDidNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDidNFT.Merge(m, src)
+}
+func (m *MsgTransferDidNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDidNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDidNFT.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDidRegistry) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTransferDidNFT proto.InternalMessageInfo
+
+func (m *MsgTransferDidNFT) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferDidNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferDidNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = len(m.PkeyDid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferDidNFT) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = len(m.PkeyType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferDidNFT) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	l = len(m.PkeyMultibase)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgTransferDidNFTResponse defines the Msg/TransferDidNFT response type.
+type MsgTransferDidNFTResponse struct {
+	Royalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=royalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"royalty"`
+}
+
+func (m *MsgTransferDidNFTResponse) Reset()         { *m = MsgTransferDidNFTResponse{} }
+func (m *MsgTransferDidNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDidNFTResponse) ProtoMessage()    {}
+func (*MsgTransferDidNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{45}
+}
+func (m *MsgTransferDidNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDidNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDidNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+}
+func (m *MsgTransferDidNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDidNFTResponse.Merge(m, src)
+}
+func (m *MsgTransferDidNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDidNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDidNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDidNFTResponse proto.InternalMessageInfo
+
+func (m *MsgTransferDidNFTResponse) GetRoyalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Royalty
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCreateDidRegistryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSetAppFeeGrantTemplate creates or replaces a named fee grant template of an app
+type MsgSetAppFeeGrantTemplate struct {
+	Creator          string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid            string                                   `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name             string                                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Period           time.Duration                            `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	SpendLimit       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	Expiry           time.Duration                            `protobuf:"bytes,7,opt,name=expiry,proto3,stdduration" json:"expiry"`
+}
+
+func (m *MsgSetAppFeeGrantTemplate) Reset()         { *m = MsgSetAppFeeGrantTemplate{} }
+func (m *MsgSetAppFeeGrantTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppFeeGrantTemplate) ProtoMessage()    {}
+func (*MsgSetAppFeeGrantTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{46}
+}
+func (m *MsgSetAppFeeGrantTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppFeeGrantTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppFeeGrantTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSetAppFeeGrantTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppFeeGrantTemplate.Merge(m, src)
+}
+func (m *MsgSetAppFeeGrantTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppFeeGrantTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppFeeGrantTemplate.DiscardUnknown(m)
 }
 
-func (m *MsgNewDenom) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetAppFeeGrantTemplate proto.InternalMessageInfo
+
+func (m *MsgSetAppFeeGrantTemplate) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetAppFeeGrantTemplate) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DenomMeta != nil {
-		l = m.DenomMeta.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetAppFeeGrantTemplate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetAppFeeGrantTemplate) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgSetAppFeeGrantTemplate) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	return n
+	return nil
 }
 
-func (m *MsgNewDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAppFeeGrantTemplate) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgNewNFTClass) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAppFeeGrantTemplate) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+type MsgSetAppFeeGrantTemplateResponse struct {
+}
+
+func (m *MsgSetAppFeeGrantTemplateResponse) Reset()         { *m = MsgSetAppFeeGrantTemplateResponse{} }
+func (m *MsgSetAppFeeGrantTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppFeeGrantTemplateResponse) ProtoMessage()    {}
+func (*MsgSetAppFeeGrantTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{47}
+}
+func (m *MsgSetAppFeeGrantTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppFeeGrantTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppFeeGrantTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgSetAppFeeGrantTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppFeeGrantTemplateResponse.Merge(m, src)
+}
+func (m *MsgSetAppFeeGrantTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppFeeGrantTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppFeeGrantTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewNFTClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetAppFeeGrantTemplateResponse proto.InternalMessageInfo
+
+type MsgDeleteAppFeeGrantTemplate struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgUpdateNFTClass) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteAppFeeGrantTemplate) Reset()         { *m = MsgDeleteAppFeeGrantTemplate{} }
+func (m *MsgDeleteAppFeeGrantTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAppFeeGrantTemplate) ProtoMessage()    {}
+func (*MsgDeleteAppFeeGrantTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{48}
+}
+func (m *MsgDeleteAppFeeGrantTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAppFeeGrantTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAppFeeGrantTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeleteAppFeeGrantTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAppFeeGrantTemplate.Merge(m, src)
+}
+func (m *MsgDeleteAppFeeGrantTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAppFeeGrantTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAppFeeGrantTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAppFeeGrantTemplate proto.InternalMessageInfo
+
+func (m *MsgDeleteAppFeeGrantTemplate) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDeleteAppFeeGrantTemplate) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateNFTClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteAppFeeGrantTemplate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgMintNFT) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgDeleteAppFeeGrantTemplateResponse struct {
+}
+
+func (m *MsgDeleteAppFeeGrantTemplateResponse) Reset()         { *m = MsgDeleteAppFeeGrantTemplateResponse{} }
+func (m *MsgDeleteAppFeeGrantTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAppFeeGrantTemplateResponse) ProtoMessage()    {}
+func (*MsgDeleteAppFeeGrantTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{49}
+}
+func (m *MsgDeleteAppFeeGrantTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAppFeeGrantTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAppFeeGrantTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeleteAppFeeGrantTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAppFeeGrantTemplateResponse.Merge(m, src)
+}
+func (m *MsgDeleteAppFeeGrantTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAppFeeGrantTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAppFeeGrantTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAppFeeGrantTemplateResponse proto.InternalMessageInfo
+
+// MsgGrantAppFeeAllowances grants the allowance described by a template to users
+type MsgGrantAppFeeAllowances struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid    string   `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Template string   `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Grantees []string `protobuf:"bytes,4,rep,name=grantees,proto3" json:"grantees,omitempty"`
+}
+
+func (m *MsgGrantAppFeeAllowances) Reset()         { *m = MsgGrantAppFeeAllowances{} }
+func (m *MsgGrantAppFeeAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAppFeeAllowances) ProtoMessage()    {}
+func (*MsgGrantAppFeeAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{50}
+}
+func (m *MsgGrantAppFeeAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAppFeeAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAppFeeAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgGrantAppFeeAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAppFeeAllowances.Merge(m, src)
+}
+func (m *MsgGrantAppFeeAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAppFeeAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAppFeeAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAppFeeAllowances proto.InternalMessageInfo
+
+func (m *MsgGrantAppFeeAllowances) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgGrantAppFeeAllowances) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgGrantAppFeeAllowances) GetTemplate() string {
+	if m != nil {
+		return m.Template
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgGrantAppFeeAllowances) GetGrantees() []string {
+	if m != nil {
+		return m.Grantees
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+type MsgGrantAppFeeAllowancesResponse struct {
+}
+
+func (m *MsgGrantAppFeeAllowancesResponse) Reset()         { *m = MsgGrantAppFeeAllowancesResponse{} }
+func (m *MsgGrantAppFeeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAppFeeAllowancesResponse) ProtoMessage()    {}
+func (*MsgGrantAppFeeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{51}
+}
+func (m *MsgGrantAppFeeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAppFeeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAppFeeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgGrantAppFeeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAppFeeAllowancesResponse.Merge(m, src)
+}
+func (m *MsgGrantAppFeeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAppFeeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAppFeeAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAppFeeAllowancesResponse proto.InternalMessageInfo
+
+// MsgRenewAppFeeAllowances replaces the allowances of users with a fresh grant,
+// an empty template keeps the template each user was granted with
+type MsgRenewAppFeeAllowances struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid    string   `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Template string   `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Grantees []string `protobuf:"bytes,4,rep,name=grantees,proto3" json:"grantees,omitempty"`
+}
+
+func (m *MsgRenewAppFeeAllowances) Reset()         { *m = MsgRenewAppFeeAllowances{} }
+func (m *MsgRenewAppFeeAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewAppFeeAllowances) ProtoMessage()    {}
+func (*MsgRenewAppFeeAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{52}
+}
+func (m *MsgRenewAppFeeAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewAppFeeAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewAppFeeAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRenewAppFeeAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewAppFeeAllowances.Merge(m, src)
+}
+func (m *MsgRenewAppFeeAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewAppFeeAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewAppFeeAllowances.DiscardUnknown(m)
 }
 
-func (m *MsgMintNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRenewAppFeeAllowances proto.InternalMessageInfo
+
+func (m *MsgRenewAppFeeAllowances) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRenewAppFeeAllowances) GetAppid() string {
+	if m != nil {
+		return m.Appid
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRenewAppFeeAllowances) GetTemplate() string {
+	if m != nil {
+		return m.Template
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRenewAppFeeAllowances) GetGrantees() []string {
+	if m != nil {
+		return m.Grantees
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+type MsgRenewAppFeeAllowancesResponse struct {
+}
+
+func (m *MsgRenewAppFeeAllowancesResponse) Reset()         { *m = MsgRenewAppFeeAllowancesResponse{} }
+func (m *MsgRenewAppFeeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewAppFeeAllowancesResponse) ProtoMessage()    {}
+func (*MsgRenewAppFeeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{53}
+}
+func (m *MsgRenewAppFeeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewAppFeeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewAppFeeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewAppFeeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewAppFeeAllowancesResponse.Merge(m, src)
+}
+func (m *MsgRenewAppFeeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewAppFeeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewAppFeeAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewAppFeeAllowancesResponse proto.InternalMessageInfo
+
+type MsgRevokeAppFeeAllowances struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid    string   `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Grantees []string `protobuf:"bytes,3,rep,name=grantees,proto3" json:"grantees,omitempty"`
+}
+
+func (m *MsgRevokeAppFeeAllowances) Reset()         { *m = MsgRevokeAppFeeAllowances{} }
+func (m *MsgRevokeAppFeeAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppFeeAllowances) ProtoMessage()    {}
+func (*MsgRevokeAppFeeAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{54}
+}
+func (m *MsgRevokeAppFeeAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppFeeAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppFeeAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAppFeeAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppFeeAllowances.Merge(m, src)
+}
+func (m *MsgRevokeAppFeeAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppFeeAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppFeeAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAppFeeAllowances proto.InternalMessageInfo
+
+func (m *MsgRevokeAppFeeAllowances) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokeAppFeeAllowances) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgRevokeAppFeeAllowances) GetGrantees() []string {
+	if m != nil {
+		return m.Grantees
+	}
+	return nil
+}
+
+type MsgRevokeAppFeeAllowancesResponse struct {
+}
+
+func (m *MsgRevokeAppFeeAllowancesResponse) Reset()         { *m = MsgRevokeAppFeeAllowancesResponse{} }
+func (m *MsgRevokeAppFeeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppFeeAllowancesResponse) ProtoMessage()    {}
+func (*MsgRevokeAppFeeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{55}
+}
+func (m *MsgRevokeAppFeeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppFeeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppFeeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAppFeeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppFeeAllowancesResponse.Merge(m, src)
+}
+func (m *MsgRevokeAppFeeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppFeeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppFeeAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAppFeeAllowancesResponse proto.InternalMessageInfo
+
+// MsgAuthorizeApp authorizes an app for scopes, replacing any previous authorization
+type MsgAuthorizeApp struct {
+	Creator    string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Uid        string     `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Appid      string     `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+	Scopes     []string   `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgAuthorizeApp) Reset()         { *m = MsgAuthorizeApp{} }
+func (m *MsgAuthorizeApp) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeApp) ProtoMessage()    {}
+func (*MsgAuthorizeApp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{56}
+}
+func (m *MsgAuthorizeApp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeApp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthorizeApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeApp.Merge(m, src)
+}
+func (m *MsgAuthorizeApp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeApp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthorizeApp proto.InternalMessageInfo
+
+func (m *MsgAuthorizeApp) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAuthorizeApp) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *MsgAuthorizeApp) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgAuthorizeApp) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *MsgAuthorizeApp) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+type MsgAuthorizeAppResponse struct {
+}
+
+func (m *MsgAuthorizeAppResponse) Reset()         { *m = MsgAuthorizeAppResponse{} }
+func (m *MsgAuthorizeAppResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeAppResponse) ProtoMessage()    {}
+func (*MsgAuthorizeAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{57}
+}
+func (m *MsgAuthorizeAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthorizeAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeAppResponse.Merge(m, src)
+}
+func (m *MsgAuthorizeAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthorizeAppResponse proto.InternalMessageInfo
+
+type MsgRevokeAppAuthorization struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Uid     string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Appid   string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+}
+
+func (m *MsgRevokeAppAuthorization) Reset()         { *m = MsgRevokeAppAuthorization{} }
+func (m *MsgRevokeAppAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppAuthorization) ProtoMessage()    {}
+func (*MsgRevokeAppAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{58}
+}
+func (m *MsgRevokeAppAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAppAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppAuthorization.Merge(m, src)
+}
+func (m *MsgRevokeAppAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAppAuthorization proto.InternalMessageInfo
+
+func (m *MsgRevokeAppAuthorization) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokeAppAuthorization) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *MsgRevokeAppAuthorization) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+type MsgRevokeAppAuthorizationResponse struct {
+}
+
+func (m *MsgRevokeAppAuthorizationResponse) Reset()         { *m = MsgRevokeAppAuthorizationResponse{} }
+func (m *MsgRevokeAppAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppAuthorizationResponse) ProtoMessage()    {}
+func (*MsgRevokeAppAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{59}
+}
+func (m *MsgRevokeAppAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAppAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgRevokeAppAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAppAuthorizationResponse proto.InternalMessageInfo
+
+// MsgExecAppAuthorized is signed by the app owner or an app admin, every
+// message must be signed by a user who authorized the app for the scope of
+// the message
+type MsgExecAppAuthorized struct {
+	Creator string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string        `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Msgs    []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgExecAppAuthorized) Reset()         { *m = MsgExecAppAuthorized{} }
+func (m *MsgExecAppAuthorized) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAppAuthorized) ProtoMessage()    {}
+func (*MsgExecAppAuthorized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{60}
+}
+func (m *MsgExecAppAuthorized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAppAuthorized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAppAuthorized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAppAuthorized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAppAuthorized.Merge(m, src)
+}
+func (m *MsgExecAppAuthorized) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAppAuthorized) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAppAuthorized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAppAuthorized proto.InternalMessageInfo
+
+func (m *MsgExecAppAuthorized) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgExecAppAuthorized) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgExecAppAuthorized) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+type MsgExecAppAuthorizedResponse struct {
+}
+
+func (m *MsgExecAppAuthorizedResponse) Reset()         { *m = MsgExecAppAuthorizedResponse{} }
+func (m *MsgExecAppAuthorizedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAppAuthorizedResponse) ProtoMessage()    {}
+func (*MsgExecAppAuthorizedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{61}
+}
+func (m *MsgExecAppAuthorizedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAppAuthorizedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAppAuthorizedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAppAuthorizedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAppAuthorizedResponse.Merge(m, src)
+}
+func (m *MsgExecAppAuthorizedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAppAuthorizedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAppAuthorizedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAppAuthorizedResponse proto.InternalMessageInfo
+
+type MsgAddAppAdmin struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Admin   string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *MsgAddAppAdmin) Reset()         { *m = MsgAddAppAdmin{} }
+func (m *MsgAddAppAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAppAdmin) ProtoMessage()    {}
+func (*MsgAddAppAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{62}
+}
+func (m *MsgAddAppAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAppAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAppAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAppAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAppAdmin.Merge(m, src)
+}
+func (m *MsgAddAppAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAppAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAppAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAppAdmin proto.InternalMessageInfo
+
+func (m *MsgAddAppAdmin) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddAppAdmin) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgAddAppAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+type MsgAddAppAdminResponse struct {
+}
+
+func (m *MsgAddAppAdminResponse) Reset()         { *m = MsgAddAppAdminResponse{} }
+func (m *MsgAddAppAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAppAdminResponse) ProtoMessage()    {}
+func (*MsgAddAppAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{63}
+}
+func (m *MsgAddAppAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAppAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAppAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAppAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAppAdminResponse.Merge(m, src)
+}
+func (m *MsgAddAppAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAppAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAppAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAppAdminResponse proto.InternalMessageInfo
+
+type MsgRemoveAppAdmin struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Admin   string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *MsgRemoveAppAdmin) Reset()         { *m = MsgRemoveAppAdmin{} }
+func (m *MsgRemoveAppAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAppAdmin) ProtoMessage()    {}
+func (*MsgRemoveAppAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{64}
+}
+func (m *MsgRemoveAppAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAppAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAppAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAppAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAppAdmin.Merge(m, src)
+}
+func (m *MsgRemoveAppAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAppAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAppAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAppAdmin proto.InternalMessageInfo
+
+func (m *MsgRemoveAppAdmin) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRemoveAppAdmin) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgRemoveAppAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+type MsgRemoveAppAdminResponse struct {
+}
+
+func (m *MsgRemoveAppAdminResponse) Reset()         { *m = MsgRemoveAppAdminResponse{} }
+func (m *MsgRemoveAppAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAppAdminResponse) ProtoMessage()    {}
+func (*MsgRemoveAppAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{65}
+}
+func (m *MsgRemoveAppAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAppAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAppAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAppAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAppAdminResponse.Merge(m, src)
+}
+func (m *MsgRemoveAppAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAppAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAppAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAppAdminResponse proto.InternalMessageInfo
+
+// MsgTransferAppOwnership proposes a new owner, who has to accept it,
+// an empty new owner cancels a pending transfer
+type MsgTransferAppOwnership struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid    string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferAppOwnership) Reset()         { *m = MsgTransferAppOwnership{} }
+func (m *MsgTransferAppOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAppOwnership) ProtoMessage()    {}
+func (*MsgTransferAppOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{66}
+}
+func (m *MsgTransferAppOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAppOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAppOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAppOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAppOwnership.Merge(m, src)
+}
+func (m *MsgTransferAppOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAppOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAppOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAppOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferAppOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferAppOwnership) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgTransferAppOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferAppOwnershipResponse struct {
+}
+
+func (m *MsgTransferAppOwnershipResponse) Reset()         { *m = MsgTransferAppOwnershipResponse{} }
+func (m *MsgTransferAppOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAppOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferAppOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{67}
+}
+func (m *MsgTransferAppOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAppOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAppOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAppOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAppOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferAppOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAppOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAppOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAppOwnershipResponse proto.InternalMessageInfo
+
+type MsgAcceptAppOwnership struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+}
+
+func (m *MsgAcceptAppOwnership) Reset()         { *m = MsgAcceptAppOwnership{} }
+func (m *MsgAcceptAppOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAppOwnership) ProtoMessage()    {}
+func (*MsgAcceptAppOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{68}
+}
+func (m *MsgAcceptAppOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAppOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAppOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAppOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAppOwnership.Merge(m, src)
+}
+func (m *MsgAcceptAppOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAppOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAppOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAppOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptAppOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptAppOwnership) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+type MsgAcceptAppOwnershipResponse struct {
+}
+
+func (m *MsgAcceptAppOwnershipResponse) Reset()         { *m = MsgAcceptAppOwnershipResponse{} }
+func (m *MsgAcceptAppOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAppOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptAppOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{69}
+}
+func (m *MsgAcceptAppOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAppOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAppOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAppOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAppOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptAppOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAppOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAppOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAppOwnershipResponse proto.InternalMessageInfo
+
+// MsgMintAppDenom mints more of a denom issued by an app
+type MsgMintAppDenom struct {
+	Creator   string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid     string                                 `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id        string                                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Recipient string                                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgMintAppDenom) Reset()         { *m = MsgMintAppDenom{} }
+func (m *MsgMintAppDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgMintAppDenom) ProtoMessage()    {}
+func (*MsgMintAppDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{70}
+}
+func (m *MsgMintAppDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintAppDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintAppDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintAppDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintAppDenom.Merge(m, src)
+}
+func (m *MsgMintAppDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintAppDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintAppDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintAppDenom proto.InternalMessageInfo
+
+func (m *MsgMintAppDenom) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgMintAppDenom) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgMintAppDenom) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MsgMintAppDenom) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type MsgMintAppDenomResponse struct {
+}
+
+func (m *MsgMintAppDenomResponse) Reset()         { *m = MsgMintAppDenomResponse{} }
+func (m *MsgMintAppDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintAppDenomResponse) ProtoMessage()    {}
+func (*MsgMintAppDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{71}
+}
+func (m *MsgMintAppDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintAppDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintAppDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintAppDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintAppDenomResponse.Merge(m, src)
+}
+func (m *MsgMintAppDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintAppDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintAppDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintAppDenomResponse proto.InternalMessageInfo
+
+// MsgBurnAppDenom burns a denom issued by an app from the balance of the creator
+type MsgBurnAppDenom struct {
+	Creator string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Appid   string                                 `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id      string                                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgBurnAppDenom) Reset()         { *m = MsgBurnAppDenom{} }
+func (m *MsgBurnAppDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnAppDenom) ProtoMessage()    {}
+func (*MsgBurnAppDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{72}
+}
+func (m *MsgBurnAppDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnAppDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnAppDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnAppDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnAppDenom.Merge(m, src)
+}
+func (m *MsgBurnAppDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnAppDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnAppDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnAppDenom proto.InternalMessageInfo
+
+func (m *MsgBurnAppDenom) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBurnAppDenom) GetAppid() string {
+	if m != nil {
+		return m.Appid
+	}
+	return ""
+}
+
+func (m *MsgBurnAppDenom) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type MsgBurnAppDenomResponse struct {
+}
+
+func (m *MsgBurnAppDenomResponse) Reset()         { *m = MsgBurnAppDenomResponse{} }
+func (m *MsgBurnAppDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnAppDenomResponse) ProtoMessage()    {}
+func (*MsgBurnAppDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4b1477772a91a3, []int{73}
+}
+func (m *MsgBurnAppDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnAppDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnAppDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnAppDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnAppDenomResponse.Merge(m, src)
+}
+func (m *MsgBurnAppDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnAppDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnAppDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnAppDenomResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateUserInfo)(nil), "misesid.misestm.v1beta1.MsgUpdateUserInfo")
+	proto.RegisterType((*MsgUpdateUserInfoResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateUserInfoResponse")
+	proto.RegisterType((*MsgUpdateUserRelation)(nil), "misesid.misestm.v1beta1.MsgUpdateUserRelation")
+	proto.RegisterType((*MsgUpdateUserRelationResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateUserRelationResponse")
+	proto.RegisterType((*MsgUpdateAppInfo)(nil), "misesid.misestm.v1beta1.MsgUpdateAppInfo")
+	proto.RegisterType((*MsgUpdateAppInfoResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateAppInfoResponse")
+	proto.RegisterType((*MsgCreateDidRegistry)(nil), "misesid.misestm.v1beta1.MsgCreateDidRegistry")
+	proto.RegisterType((*MsgCreateDidRegistryResponse)(nil), "misesid.misestm.v1beta1.MsgCreateDidRegistryResponse")
+	proto.RegisterType((*MsgCreateUserList)(nil), "misesid.misestm.v1beta1.MsgCreateUserList")
+	proto.RegisterType((*MsgCreateUserListResponse)(nil), "misesid.misestm.v1beta1.MsgCreateUserListResponse")
+	proto.RegisterType((*MsgRenameUserList)(nil), "misesid.misestm.v1beta1.MsgRenameUserList")
+	proto.RegisterType((*MsgRenameUserListResponse)(nil), "misesid.misestm.v1beta1.MsgRenameUserListResponse")
+	proto.RegisterType((*MsgAddUserListMembers)(nil), "misesid.misestm.v1beta1.MsgAddUserListMembers")
+	proto.RegisterType((*MsgAddUserListMembersResponse)(nil), "misesid.misestm.v1beta1.MsgAddUserListMembersResponse")
+	proto.RegisterType((*MsgRemoveUserListMembers)(nil), "misesid.misestm.v1beta1.MsgRemoveUserListMembers")
+	proto.RegisterType((*MsgRemoveUserListMembersResponse)(nil), "misesid.misestm.v1beta1.MsgRemoveUserListMembersResponse")
+	proto.RegisterType((*MsgDeleteUserList)(nil), "misesid.misestm.v1beta1.MsgDeleteUserList")
+	proto.RegisterType((*MsgDeleteUserListResponse)(nil), "misesid.misestm.v1beta1.MsgDeleteUserListResponse")
+	proto.RegisterType((*MsgCreateCommunity)(nil), "misesid.misestm.v1beta1.MsgCreateCommunity")
+	proto.RegisterType((*MsgCreateCommunityResponse)(nil), "misesid.misestm.v1beta1.MsgCreateCommunityResponse")
+	proto.RegisterType((*MsgJoinCommunity)(nil), "misesid.misestm.v1beta1.MsgJoinCommunity")
+	proto.RegisterType((*MsgJoinCommunityResponse)(nil), "misesid.misestm.v1beta1.MsgJoinCommunityResponse")
+	proto.RegisterType((*MsgApproveCommunityMember)(nil), "misesid.misestm.v1beta1.MsgApproveCommunityMember")
+	proto.RegisterType((*MsgApproveCommunityMemberResponse)(nil), "misesid.misestm.v1beta1.MsgApproveCommunityMemberResponse")
+	proto.RegisterType((*MsgKickCommunityMember)(nil), "misesid.misestm.v1beta1.MsgKickCommunityMember")
+	proto.RegisterType((*MsgKickCommunityMemberResponse)(nil), "misesid.misestm.v1beta1.MsgKickCommunityMemberResponse")
+	proto.RegisterType((*MsgChangeCommunityRole)(nil), "misesid.misestm.v1beta1.MsgChangeCommunityRole")
+	proto.RegisterType((*MsgChangeCommunityRoleResponse)(nil), "misesid.misestm.v1beta1.MsgChangeCommunityRoleResponse")
+	proto.RegisterType((*MsgNewDenom)(nil), "misesid.misestm.v1beta1.MsgNewDenom")
+	proto.RegisterType((*MsgNewDenomResponse)(nil), "misesid.misestm.v1beta1.MsgNewDenomResponse")
+	proto.RegisterType((*MsgNewNFTClass)(nil), "misesid.misestm.v1beta1.MsgNewNFTClass")
+	proto.RegisterType((*MsgNewNFTClassResponse)(nil), "misesid.misestm.v1beta1.MsgNewNFTClassResponse")
+	proto.RegisterType((*MsgUpdateNFTClass)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTClass")
+	proto.RegisterType((*MsgUpdateNFTClassResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTClassResponse")
+	proto.RegisterType((*MsgMintNFT)(nil), "misesid.misestm.v1beta1.MsgMintNFT")
+	proto.RegisterType((*MsgMintNFTResponse)(nil), "misesid.misestm.v1beta1.MsgMintNFTResponse")
+	proto.RegisterType((*MsgUpdateNFT)(nil), "misesid.misestm.v1beta1.MsgUpdateNFT")
+	proto.RegisterType((*MsgUpdateNFTResponse)(nil), "misesid.misestm.v1beta1.MsgUpdateNFTResponse")
+	proto.RegisterType((*MsgBurnNFT)(nil), "misesid.misestm.v1beta1.MsgBurnNFT")
+	proto.RegisterType((*MsgBurnNFTResponse)(nil), "misesid.misestm.v1beta1.MsgBurnNFTResponse")
+	proto.RegisterType((*MsgTransferNFT)(nil), "misesid.misestm.v1beta1.MsgTransferNFT")
+	proto.RegisterType((*MsgTransferNFTResponse)(nil), "misesid.misestm.v1beta1.MsgTransferNFTResponse")
+	proto.RegisterType((*MsgRevokeNFT)(nil), "misesid.misestm.v1beta1.MsgRevokeNFT")
+	proto.RegisterType((*MsgRevokeNFTResponse)(nil), "misesid.misestm.v1beta1.MsgRevokeNFTResponse")
+	proto.RegisterType((*MsgTransferDidNFT)(nil), "misesid.misestm.v1beta1.MsgTransferDidNFT")
+	proto.RegisterType((*MsgTransferDidNFTResponse)(nil), "misesid.misestm.v1beta1.MsgTransferDidNFTResponse")
+	proto.RegisterType((*MsgSetAppFeeGrantTemplate)(nil), "misesid.misestm.v1beta1.MsgSetAppFeeGrantTemplate")
+	proto.RegisterType((*MsgSetAppFeeGrantTemplateResponse)(nil), "misesid.misestm.v1beta1.MsgSetAppFeeGrantTemplateResponse")
+	proto.RegisterType((*MsgDeleteAppFeeGrantTemplate)(nil), "misesid.misestm.v1beta1.MsgDeleteAppFeeGrantTemplate")
+	proto.RegisterType((*MsgDeleteAppFeeGrantTemplateResponse)(nil), "misesid.misestm.v1beta1.MsgDeleteAppFeeGrantTemplateResponse")
+	proto.RegisterType((*MsgGrantAppFeeAllowances)(nil), "misesid.misestm.v1beta1.MsgGrantAppFeeAllowances")
+	proto.RegisterType((*MsgGrantAppFeeAllowancesResponse)(nil), "misesid.misestm.v1beta1.MsgGrantAppFeeAllowancesResponse")
+	proto.RegisterType((*MsgRenewAppFeeAllowances)(nil), "misesid.misestm.v1beta1.MsgRenewAppFeeAllowances")
+	proto.RegisterType((*MsgRenewAppFeeAllowancesResponse)(nil), "misesid.misestm.v1beta1.MsgRenewAppFeeAllowancesResponse")
+	proto.RegisterType((*MsgRevokeAppFeeAllowances)(nil), "misesid.misestm.v1beta1.MsgRevokeAppFeeAllowances")
+	proto.RegisterType((*MsgRevokeAppFeeAllowancesResponse)(nil), "misesid.misestm.v1beta1.MsgRevokeAppFeeAllowancesResponse")
+	proto.RegisterType((*MsgAuthorizeApp)(nil), "misesid.misestm.v1beta1.MsgAuthorizeApp")
+	proto.RegisterType((*MsgAuthorizeAppResponse)(nil), "misesid.misestm.v1beta1.MsgAuthorizeAppResponse")
+	proto.RegisterType((*MsgRevokeAppAuthorization)(nil), "misesid.misestm.v1beta1.MsgRevokeAppAuthorization")
+	proto.RegisterType((*MsgRevokeAppAuthorizationResponse)(nil), "misesid.misestm.v1beta1.MsgRevokeAppAuthorizationResponse")
+	proto.RegisterType((*MsgExecAppAuthorized)(nil), "misesid.misestm.v1beta1.MsgExecAppAuthorized")
+	proto.RegisterType((*MsgExecAppAuthorizedResponse)(nil), "misesid.misestm.v1beta1.MsgExecAppAuthorizedResponse")
+	proto.RegisterType((*MsgAddAppAdmin)(nil), "misesid.misestm.v1beta1.MsgAddAppAdmin")
+	proto.RegisterType((*MsgAddAppAdminResponse)(nil), "misesid.misestm.v1beta1.MsgAddAppAdminResponse")
+	proto.RegisterType((*MsgRemoveAppAdmin)(nil), "misesid.misestm.v1beta1.MsgRemoveAppAdmin")
+	proto.RegisterType((*MsgRemoveAppAdminResponse)(nil), "misesid.misestm.v1beta1.MsgRemoveAppAdminResponse")
+	proto.RegisterType((*MsgTransferAppOwnership)(nil), "misesid.misestm.v1beta1.MsgTransferAppOwnership")
+	proto.RegisterType((*MsgTransferAppOwnershipResponse)(nil), "misesid.misestm.v1beta1.MsgTransferAppOwnershipResponse")
+	proto.RegisterType((*MsgAcceptAppOwnership)(nil), "misesid.misestm.v1beta1.MsgAcceptAppOwnership")
+	proto.RegisterType((*MsgAcceptAppOwnershipResponse)(nil), "misesid.misestm.v1beta1.MsgAcceptAppOwnershipResponse")
+	proto.RegisterType((*MsgMintAppDenom)(nil), "misesid.misestm.v1beta1.MsgMintAppDenom")
+	proto.RegisterType((*MsgMintAppDenomResponse)(nil), "misesid.misestm.v1beta1.MsgMintAppDenomResponse")
+	proto.RegisterType((*MsgBurnAppDenom)(nil), "misesid.misestm.v1beta1.MsgBurnAppDenom")
+	proto.RegisterType((*MsgBurnAppDenomResponse)(nil), "misesid.misestm.v1beta1.MsgBurnAppDenomResponse")
+}
+
+func init() { proto.RegisterFile("misestm/v1beta1/tx.proto", fileDescriptor_5f4b1477772a91a3) }
+
+var fileDescriptor_5f4b1477772a91a3 = []byte{
+	// 2712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x7b, 0xc6, 0x9e, 0xf1, 0x73, 0x3e, 0x7b, 0xbd, 0xc9, 0xb8, 0x37, 0xb1, 0x9d, 0xd9,
+	0x90, 0xcc, 0xc6, 0xc9, 0x4c, 0x32, 0xab, 0x64, 0x89, 0x17, 0x24, 0x3c, 0x31, 0x5e, 0xc2, 0xc6,
+	0x21, 0xf4, 0x3a, 0x8b, 0x00, 0x81, 0xd5, 0x33, 0x5d, 0x1e, 0xd7, 0xba, 0xbf, 0xd4, 0xdd, 0x63,
+	0x67, 0x10, 0x68, 0xa5, 0x48, 0x48, 0x48, 0x5c, 0x16, 0x71, 0x81, 0x1b, 0x07, 0x4e, 0x08, 0x6e,
+	0x48, 0x1c, 0xb8, 0x01, 0x87, 0x88, 0xd3, 0x1e, 0x90, 0x40, 0x1c, 0xb2, 0xab, 0xe4, 0xc2, 0x0d,
+	0xfe, 0x04, 0x54, 0xd5, 0x55, 0x35, 0xd5, 0x33, 0x3d, 0x3d, 0xdd, 0x1b, 0x6f, 0x76, 0xb5, 0x27,
+	0x4f, 0x55, 0xfd, 0x5e, 0xbd, 0xdf, 0xfb, 0xa8, 0xea, 0xaa, 0x57, 0x86, 0x8a, 0x8d, 0x03, 0x14,
+	0x84, 0x76, 0x63, 0xff, 0x7a, 0x1b, 0x85, 0xc6, 0xf5, 0x46, 0xf8, 0xb0, 0xee, 0xf9, 0x6e, 0xe8,
+	0xaa, 0x67, 0xe8, 0x08, 0x36, 0xeb, 0x0c, 0x51, 0x67, 0x08, 0x6d, 0xbe, 0xeb, 0x76, 0x5d, 0x8a,
+	0x69, 0x90, 0x5f, 0x11, 0x5c, 0x5b, 0xe8, 0xba, 0x6e, 0xd7, 0x42, 0x0d, 0xda, 0x6a, 0xf7, 0x76,
+	0x1a, 0x86, 0xd3, 0x67, 0x43, 0x4b, 0xc3, 0x43, 0x21, 0xb6, 0x51, 0x10, 0x1a, 0xb6, 0xc7, 0x00,
+	0x8b, 0x1d, 0x37, 0xb0, 0xdd, 0xa0, 0xd1, 0x36, 0x9c, 0x3d, 0x41, 0x84, 0x34, 0x46, 0xc6, 0x03,
+	0x24, 0xc6, 0x3b, 0x2e, 0x76, 0xf8, 0xf8, 0xb0, 0x11, 0x0f, 0x02, 0xe4, 0xdf, 0x71, 0x76, 0x38,
+	0xb7, 0x6a, 0xd2, 0xb8, 0x8e, 0x2c, 0x23, 0xc4, 0x2e, 0x9f, 0xe3, 0xdc, 0x30, 0x66, 0xcd, 0xf3,
+	0xa4, 0x29, 0xce, 0x0f, 0x0f, 0xaf, 0x63, 0x53, 0x47, 0x5d, 0x1c, 0x84, 0x7e, 0x3f, 0x8d, 0xc5,
+	0x5d, 0x1c, 0x84, 0xdc, 0x0d, 0xc3, 0xe3, 0xb7, 0x5d, 0xdb, 0xee, 0x39, 0x38, 0xe4, 0x13, 0xbc,
+	0x96, 0x40, 0x61, 0x03, 0xa1, 0xb7, 0x7c, 0xc3, 0x09, 0xb7, 0x90, 0xed, 0x59, 0x46, 0x88, 0x18,
+	0xf4, 0x62, 0x02, 0x74, 0xad, 0x17, 0xee, 0xba, 0x3e, 0xfe, 0x91, 0x6c, 0xd5, 0x42, 0xe4, 0xb9,
+	0xed, 0x28, 0x5c, 0x51, 0x83, 0xd3, 0x1d, 0x8e, 0x8a, 0xd9, 0xf3, 0x25, 0xd1, 0xea, 0x53, 0x05,
+	0x4e, 0x6d, 0x06, 0xdd, 0x07, 0x9e, 0x69, 0x84, 0x88, 0x3b, 0x54, 0xad, 0x40, 0xa9, 0xe3, 0x23,
+	0x23, 0x74, 0xfd, 0x8a, 0xb2, 0xac, 0xd4, 0x66, 0x75, 0xde, 0x54, 0x4f, 0x42, 0xa1, 0x87, 0xcd,
+	0xca, 0x14, 0xed, 0x25, 0x3f, 0xd5, 0x16, 0x94, 0xbd, 0x5e, 0x7b, 0x1b, 0x3b, 0x3b, 0x6e, 0xa5,
+	0xb0, 0xac, 0xd4, 0xe6, 0x9a, 0x97, 0xea, 0x63, 0x92, 0xaa, 0x7e, 0xbf, 0xd7, 0xb6, 0x70, 0x87,
+	0xab, 0xd1, 0x4b, 0x5e, 0xaf, 0x4d, 0xf5, 0xdd, 0x86, 0xb2, 0xe7, 0xe3, 0x68, 0x8e, 0x22, 0x9d,
+	0xa3, 0x36, 0x7e, 0x0e, 0x1f, 0xef, 0x4b, 0x5c, 0xf5, 0x92, 0xe7, 0x63, 0x4e, 0x7a, 0x1f, 0xf9,
+	0x01, 0x76, 0x9d, 0xca, 0xf4, 0xb2, 0x52, 0x2b, 0xea, 0xbc, 0x59, 0x7d, 0x05, 0x16, 0x46, 0x6c,
+	0xd4, 0x51, 0xe0, 0xb9, 0x4e, 0x80, 0xaa, 0x3f, 0x2f, 0xc0, 0xcb, 0xb1, 0x51, 0x9e, 0x32, 0x29,
+	0x5e, 0xa8, 0x40, 0xa9, 0x87, 0xcd, 0x0d, 0xdf, 0xb5, 0x99, 0x27, 0x78, 0x53, 0x9d, 0x87, 0xe9,
+	0x1e, 0x36, 0xb7, 0x22, 0x57, 0xcc, 0xea, 0x51, 0x43, 0x5d, 0x86, 0x39, 0x1c, 0x6c, 0xb8, 0x96,
+	0xe5, 0x1e, 0x60, 0xa7, 0x4b, 0x4d, 0x2c, 0xeb, 0x72, 0x97, 0xba, 0x08, 0x80, 0x83, 0x96, 0xe5,
+	0x76, 0xf6, 0x08, 0x60, 0x9a, 0x02, 0xa4, 0x1e, 0xb5, 0x0a, 0x47, 0x71, 0xa0, 0xa3, 0x1d, 0xe4,
+	0xfb, 0xc8, 0x6c, 0xf5, 0x2b, 0x33, 0x14, 0x11, 0xeb, 0x93, 0x1d, 0x50, 0x8a, 0x39, 0x80, 0x8c,
+	0xf8, 0xc8, 0xda, 0xea, 0x7b, 0xa8, 0x52, 0x8e, 0x46, 0x58, 0x53, 0xad, 0xc1, 0x09, 0xf4, 0xd0,
+	0xc3, 0x3e, 0x76, 0xba, 0x3a, 0x43, 0xcc, 0x52, 0xc4, 0x70, 0x37, 0x61, 0x40, 0xbb, 0xd0, 0x37,
+	0x10, 0xee, 0xee, 0x86, 0x15, 0x58, 0x56, 0x6a, 0x05, 0x3d, 0xd6, 0xa7, 0x7e, 0x0d, 0x20, 0x6a,
+	0x6f, 0x61, 0x1b, 0x55, 0xe6, 0x68, 0x24, 0xb5, 0x7a, 0x94, 0x82, 0x75, 0x9e, 0x82, 0xf5, 0x2d,
+	0xbe, 0x31, 0xb4, 0x8a, 0x1f, 0x7c, 0xb4, 0xa4, 0xe8, 0x92, 0x4c, 0x75, 0x09, 0xce, 0x25, 0x06,
+	0x43, 0x84, 0xeb, 0x89, 0x02, 0x27, 0x05, 0x82, 0xad, 0xde, 0x94, 0x48, 0xcd, 0xc3, 0xb4, 0xe1,
+	0x79, 0x22, 0x63, 0xa3, 0x86, 0xaa, 0x42, 0xd1, 0x31, 0x6c, 0xc4, 0x82, 0x44, 0x7f, 0x93, 0x39,
+	0x4c, 0xd7, 0x36, 0xb0, 0x13, 0x54, 0x8a, 0xcb, 0x05, 0x32, 0x07, 0x6b, 0xaa, 0x67, 0x61, 0xd6,
+	0x44, 0xfb, 0xc8, 0x72, 0x3d, 0xe4, 0xd3, 0xd0, 0xcc, 0xea, 0x83, 0x0e, 0x75, 0x01, 0xca, 0xbb,
+	0xae, 0x8d, 0xb6, 0x7b, 0xbe, 0x45, 0xa3, 0x32, 0xab, 0x97, 0x48, 0xfb, 0x81, 0x6f, 0x91, 0x21,
+	0xdc, 0x71, 0x1d, 0x3a, 0x54, 0x8a, 0x86, 0x48, 0x9b, 0x0c, 0x49, 0xb1, 0x2a, 0xc7, 0x93, 0x55,
+	0x83, 0xca, 0xb0, 0x7d, 0xc2, 0xf8, 0xff, 0x29, 0x30, 0xbf, 0x19, 0x74, 0x6f, 0x13, 0xe3, 0x90,
+	0xb4, 0x37, 0xa5, 0x2f, 0x58, 0x73, 0xb0, 0x60, 0x4d, 0x6c, 0x12, 0xac, 0xb7, 0x87, 0xfa, 0xeb,
+	0xd8, 0x64, 0xf6, 0xf3, 0xa6, 0xaa, 0x41, 0x99, 0xfc, 0xa4, 0x59, 0x50, 0xa4, 0x43, 0xa2, 0xad,
+	0x5e, 0x80, 0x63, 0xe4, 0xf7, 0x66, 0xcf, 0x0a, 0x31, 0xd9, 0xa1, 0x99, 0x23, 0xe2, 0x9d, 0xb2,
+	0x59, 0x33, 0x23, 0x29, 0x48, 0x8d, 0x70, 0x7d, 0xee, 0x0a, 0xd6, 0x24, 0xee, 0x0d, 0x70, 0xd7,
+	0x31, 0xc2, 0x9e, 0x1f, 0xa5, 0xe7, 0x51, 0x7d, 0xd0, 0x51, 0x5d, 0x84, 0xb3, 0x49, 0x16, 0x0b,
+	0x97, 0x7c, 0x1c, 0x6d, 0x60, 0x11, 0x80, 0xef, 0xc5, 0xe9, 0x09, 0xe1, 0x1e, 0x38, 0xc8, 0xe7,
+	0x09, 0x41, 0x1b, 0x89, 0x09, 0xb1, 0x0c, 0x73, 0x26, 0x0a, 0x3a, 0x3e, 0xf6, 0x48, 0x02, 0x32,
+	0x87, 0xc8, 0x5d, 0xea, 0xdb, 0x00, 0xfb, 0x38, 0xc0, 0x6d, 0x6c, 0xe1, 0xb0, 0x4f, 0x1d, 0x72,
+	0xbc, 0xb9, 0x32, 0x76, 0xe3, 0xe2, 0xe4, 0xde, 0x15, 0x22, 0xba, 0x24, 0x4e, 0x28, 0xdb, 0xc8,
+	0x6e, 0x23, 0x3f, 0xa8, 0xcc, 0x44, 0xf9, 0xc7, 0x9a, 0xd5, 0x15, 0x58, 0x18, 0xb1, 0x90, 0xdb,
+	0xaf, 0x1e, 0x87, 0x29, 0x6c, 0x52, 0x23, 0x8b, 0xfa, 0x14, 0x36, 0xab, 0x7b, 0xd4, 0x1d, 0x3a,
+	0x22, 0x26, 0x64, 0x70, 0x47, 0x24, 0x3e, 0xc5, 0xc5, 0xc7, 0xad, 0x0c, 0x1e, 0xd4, 0x62, 0xd2,
+	0xc6, 0x1a, 0x57, 0x26, 0x22, 0xd3, 0xa3, 0xfb, 0xea, 0x9a, 0x69, 0xf2, 0x91, 0xcd, 0xc8, 0x9e,
+	0x1c, 0x6c, 0x24, 0x9f, 0x14, 0x62, 0x3e, 0x49, 0xe1, 0x14, 0xed, 0x20, 0xa3, 0x6a, 0x05, 0xaf,
+	0x87, 0x74, 0x81, 0xe9, 0xc8, 0x76, 0xf7, 0xd1, 0x8b, 0xa5, 0x56, 0x85, 0xe5, 0x71, 0x9a, 0x05,
+	0xbb, 0xef, 0xd0, 0xf8, 0xad, 0x23, 0x0b, 0x85, 0x9f, 0x24, 0x7e, 0x92, 0xf2, 0x42, 0x52, 0xac,
+	0xe2, 0x13, 0x0b, 0xad, 0x8f, 0x15, 0x50, 0x45, 0x8e, 0x89, 0x13, 0xcb, 0x0b, 0x5a, 0x46, 0x77,
+	0x01, 0xde, 0x73, 0xb1, 0x73, 0xdf, 0xb5, 0x70, 0x87, 0x2f, 0xa3, 0x2b, 0x63, 0x97, 0x91, 0x60,
+	0xf7, 0x4d, 0x21, 0xa3, 0x4b, 0xf2, 0xd5, 0x2b, 0xa0, 0x8d, 0x5a, 0x32, 0x76, 0xb9, 0xec, 0xd0,
+	0xaf, 0x09, 0x99, 0x2a, 0x8b, 0xd5, 0xcb, 0x30, 0xd7, 0xe1, 0xb0, 0x3b, 0xdc, 0xed, 0x72, 0x97,
+	0x7a, 0x1a, 0x66, 0xa2, 0x3c, 0x60, 0x3e, 0x60, 0x2d, 0xb6, 0xab, 0xc7, 0xf4, 0x0c, 0x4e, 0x20,
+	0x0a, 0x0d, 0xcd, 0x9a, 0xe7, 0xf9, 0xee, 0xfe, 0x80, 0x73, 0x94, 0x19, 0xcf, 0xc5, 0x46, 0x83,
+	0x32, 0xf9, 0x46, 0x51, 0xe1, 0x88, 0x8f, 0x68, 0x4b, 0x4c, 0x8b, 0x31, 0xa6, 0xaf, 0xc2, 0xf9,
+	0xb1, 0x64, 0x04, 0xe5, 0x9f, 0x29, 0x70, 0x7a, 0x33, 0xe8, 0xbe, 0x8d, 0x3b, 0x7b, 0x9f, 0x35,
+	0xdf, 0x65, 0x58, 0x4c, 0x66, 0x22, 0xc8, 0xfe, 0x2d, 0x22, 0x7b, 0x7b, 0xd7, 0x70, 0xba, 0x52,
+	0x4a, 0xb8, 0x16, 0x7a, 0xd1, 0x64, 0xd5, 0x55, 0x28, 0xfa, 0xae, 0x85, 0x58, 0x92, 0x5f, 0x9c,
+	0x9c, 0xe4, 0x84, 0xa5, 0x4e, 0x65, 0x98, 0xa1, 0x09, 0x56, 0x08, 0x43, 0xff, 0xab, 0xc0, 0xdc,
+	0x66, 0xd0, 0xbd, 0x87, 0x0e, 0xd6, 0x91, 0xe3, 0xda, 0x52, 0xb2, 0xcf, 0xd2, 0xcd, 0x61, 0x03,
+	0x66, 0x0c, 0xdb, 0xed, 0x39, 0x61, 0xb4, 0x6a, 0x5b, 0xf5, 0xc7, 0x4f, 0x96, 0x8e, 0xfc, 0xfb,
+	0xc9, 0xd2, 0xc5, 0x2e, 0x0e, 0x77, 0x7b, 0xed, 0x7a, 0xc7, 0xb5, 0xd9, 0xed, 0x81, 0xfd, 0xb9,
+	0x1a, 0x98, 0x7b, 0x8d, 0xb0, 0xef, 0xa1, 0xa0, 0x7e, 0xc7, 0x09, 0x75, 0x26, 0xad, 0x7e, 0x05,
+	0xc0, 0x24, 0x0a, 0xb6, 0x6d, 0x14, 0x1a, 0xec, 0xd0, 0x7f, 0xae, 0x1e, 0x89, 0xd4, 0xe9, 0x8d,
+	0x8e, 0xdb, 0xb1, 0x89, 0x42, 0xc3, 0x34, 0x42, 0x83, 0x1c, 0x98, 0x1c, 0xd7, 0x26, 0x4d, 0xe2,
+	0x9b, 0x00, 0x39, 0xe6, 0xc0, 0x37, 0x51, 0x8b, 0x9c, 0x03, 0x7c, 0xd4, 0xc1, 0x1e, 0x46, 0x4e,
+	0xc8, 0x8f, 0x59, 0xa2, 0x63, 0x70, 0x90, 0x9b, 0x91, 0x0e, 0x72, 0xd5, 0x15, 0x78, 0x49, 0x32,
+	0x58, 0xac, 0xf2, 0x79, 0x98, 0xa6, 0xfa, 0x98, 0xed, 0x51, 0xa3, 0xfa, 0xe7, 0x29, 0x38, 0x1e,
+	0xa1, 0xef, 0x6d, 0x6c, 0xdd, 0xb6, 0x8c, 0x20, 0x18, 0xf1, 0x10, 0xdf, 0xc0, 0xa6, 0xa4, 0x0d,
+	0x8c, 0x5c, 0x79, 0x7c, 0xcc, 0x42, 0x4c, 0x7e, 0x52, 0x0b, 0x3a, 0xbb, 0xc8, 0x36, 0x84, 0x05,
+	0xb4, 0x45, 0xfb, 0xfb, 0x76, 0xdb, 0xb5, 0x18, 0x7d, 0xd6, 0x52, 0x6b, 0x50, 0x24, 0x4e, 0xa0,
+	0xd4, 0xe7, 0x9a, 0xf3, 0x23, 0x07, 0xe2, 0x35, 0xa7, 0xaf, 0x53, 0x84, 0xe4, 0x9b, 0x52, 0xcc,
+	0x37, 0x2b, 0x70, 0xca, 0x77, 0xfb, 0x86, 0x15, 0xf6, 0xb7, 0x07, 0x3e, 0x2a, 0x53, 0xc8, 0x49,
+	0x36, 0xa0, 0x0b, 0x57, 0x5d, 0x83, 0x79, 0x0e, 0x6e, 0x1b, 0x01, 0x0e, 0xb6, 0x3d, 0x17, 0x3b,
+	0x61, 0x40, 0x0f, 0xf6, 0xc7, 0x74, 0x95, 0x8d, 0xb5, 0xc8, 0xd0, 0x7d, 0x3a, 0x42, 0x8f, 0x60,
+	0x6e, 0xcf, 0x6a, 0xbb, 0x3d, 0xc7, 0xa4, 0x07, 0xfb, 0xb2, 0x3e, 0xe8, 0x58, 0x2d, 0xfe, 0xe7,
+	0x37, 0x4b, 0x4a, 0xb5, 0x02, 0xa7, 0xe3, 0xce, 0x13, 0x69, 0xf7, 0x7b, 0xf9, 0x0e, 0x39, 0xd6,
+	0xb5, 0x0b, 0x50, 0xee, 0x90, 0x81, 0x6d, 0x71, 0x1a, 0x2d, 0xd1, 0xf6, 0x9d, 0xe4, 0x43, 0x07,
+	0xf3, 0x7a, 0x71, 0xe0, 0x75, 0xee, 0xc5, 0xe9, 0x1c, 0x5e, 0x9c, 0x91, 0xbd, 0xc8, 0x0c, 0x91,
+	0x6f, 0x83, 0x23, 0xb6, 0xfc, 0x55, 0x01, 0xd8, 0x0c, 0xba, 0x9b, 0xd8, 0x09, 0xef, 0x6d, 0x6c,
+	0x7d, 0x0e, 0x8d, 0x88, 0x2f, 0x93, 0xd2, 0xd0, 0x32, 0x61, 0x26, 0xce, 0x83, 0x3a, 0x30, 0x42,
+	0xd8, 0xf6, 0x4b, 0x05, 0x8e, 0xca, 0x96, 0xe7, 0xb1, 0x6e, 0x74, 0x11, 0x70, 0x4b, 0x8a, 0x39,
+	0x2c, 0x99, 0x4e, 0x08, 0xc7, 0x69, 0x7a, 0xa5, 0x11, 0xa4, 0x04, 0xdb, 0x07, 0x34, 0x10, 0xad,
+	0x9e, 0xef, 0xe4, 0xa4, 0x3a, 0x50, 0x57, 0x48, 0x50, 0x17, 0xb9, 0x86, 0x4d, 0x2b, 0x94, 0xfd,
+	0x43, 0xa1, 0x5b, 0xc3, 0x96, 0x6f, 0x38, 0xc1, 0x0e, 0xf2, 0x0f, 0x47, 0x63, 0x3c, 0x54, 0xc5,
+	0xe1, 0x1d, 0xcd, 0x80, 0x69, 0xcf, 0xc7, 0x1d, 0xf2, 0x31, 0x28, 0xd4, 0xe6, 0x9a, 0x0b, 0x83,
+	0x0d, 0x34, 0x40, 0xd2, 0x87, 0x00, 0x3b, 0xad, 0x6b, 0x64, 0x9f, 0xfe, 0xdd, 0x47, 0x4b, 0xb5,
+	0x0c, 0xfb, 0x34, 0x11, 0x08, 0xf4, 0x68, 0xe6, 0xea, 0xfb, 0x70, 0x3a, 0x6e, 0x95, 0xd8, 0x21,
+	0x11, 0x94, 0xd8, 0x3e, 0x50, 0x51, 0x0e, 0x5f, 0x3d, 0x9f, 0xbb, 0xfa, 0x6d, 0x9a, 0x71, 0x3a,
+	0xda, 0x77, 0xf7, 0xd0, 0xe1, 0x38, 0x95, 0xe5, 0x8b, 0x98, 0x52, 0x84, 0xf0, 0x9f, 0xd1, 0x2e,
+	0xc4, 0x8d, 0x5d, 0xc7, 0xe6, 0x17, 0x25, 0x8a, 0x8f, 0xa2, 0xf3, 0x61, 0xdc, 0xb2, 0x17, 0x1d,
+	0xc9, 0xbf, 0x14, 0x28, 0x89, 0x77, 0x50, 0x98, 0x50, 0xaf, 0x3c, 0x94, 0x02, 0xcc, 0x9b, 0x30,
+	0xe3, 0x21, 0x1f, 0xbb, 0x26, 0xdb, 0x52, 0x16, 0x46, 0xb6, 0x94, 0x75, 0x56, 0xbb, 0x6c, 0x95,
+	0x89, 0x1d, 0xbf, 0x22, 0xb5, 0x23, 0x26, 0xa2, 0x5a, 0x30, 0x17, 0x78, 0xc8, 0x31, 0xb7, 0x2d,
+	0x6c, 0xe3, 0xf0, 0xd3, 0x08, 0x06, 0xd0, 0xf9, 0xef, 0x92, 0xe9, 0xd5, 0x3e, 0xa8, 0x91, 0xde,
+	0x6d, 0x59, 0xe9, 0xcc, 0xe1, 0x2b, 0x3d, 0x19, 0xa9, 0x79, 0x67, 0xa0, 0xfa, 0x4d, 0x98, 0xa1,
+	0xe5, 0xb2, 0x3e, 0xdd, 0xfb, 0xb3, 0x7a, 0x29, 0x12, 0x61, 0x67, 0xfb, 0xe4, 0x18, 0x8a, 0x85,
+	0xd4, 0x86, 0xb3, 0xe2, 0xa2, 0xf8, 0x29, 0xc5, 0xba, 0x7a, 0x11, 0x2e, 0xa4, 0xe9, 0x10, 0x5c,
+	0x1e, 0x29, 0xf4, 0xde, 0x44, 0x07, 0x23, 0xdc, 0x1a, 0xa9, 0x98, 0x1a, 0x4e, 0x07, 0x05, 0xb9,
+	0x89, 0x68, 0x50, 0x0e, 0x99, 0x02, 0x7e, 0x60, 0xe7, 0x6d, 0x32, 0xd6, 0x25, 0x4a, 0x10, 0xe2,
+	0xe5, 0x3f, 0xd1, 0x66, 0xd7, 0xf6, 0x44, 0x0e, 0xc3, 0x44, 0x75, 0xe4, 0xa0, 0x83, 0xcf, 0x98,
+	0x68, 0x22, 0x07, 0x41, 0xb4, 0x0b, 0x0b, 0x62, 0xfb, 0x3c, 0x0c, 0xa2, 0x82, 0x4c, 0x61, 0x88,
+	0x4c, 0x94, 0x6b, 0xc9, 0x8a, 0x04, 0x9b, 0x3f, 0x28, 0x70, 0x82, 0xdc, 0x36, 0xd9, 0xa3, 0x06,
+	0x01, 0xe6, 0x7a, 0x7c, 0x10, 0xb4, 0x0a, 0x32, 0x2d, 0x7a, 0x3e, 0x77, 0x3d, 0xe1, 0x21, 0xd6,
+	0x12, 0xe5, 0x69, 0xba, 0x3c, 0x2a, 0xd3, 0xb9, 0xca, 0xd3, 0x54, 0xa6, 0xba, 0x00, 0x67, 0x86,
+	0xe8, 0x0a, 0x53, 0x7e, 0x10, 0x77, 0x6c, 0xec, 0x9d, 0xe6, 0xf9, 0x6d, 0x1a, 0x76, 0x67, 0x6c,
+	0x7a, 0xc1, 0xe1, 0x27, 0xf4, 0xdb, 0xf8, 0xf5, 0x87, 0xa8, 0x23, 0x41, 0x90, 0x99, 0x3b, 0xae,
+	0x37, 0xa0, 0x68, 0x07, 0xdd, 0x28, 0xa6, 0x63, 0xce, 0x76, 0xad, 0xb9, 0xbf, 0xff, 0xf1, 0x6a,
+	0x29, 0x30, 0xf7, 0xea, 0x84, 0x16, 0x85, 0xb3, 0x5a, 0xed, 0x88, 0x7a, 0x41, 0xef, 0x5d, 0x7a,
+	0xc8, 0x5a, 0x33, 0x4d, 0x32, 0x6c, 0xda, 0xd8, 0xc9, 0x4d, 0x8c, 0xf4, 0x12, 0x41, 0xe1, 0x1b,
+	0xd2, 0x60, 0x57, 0x13, 0x69, 0x5e, 0xa1, 0xf1, 0xbb, 0x70, 0x4a, 0x54, 0xdc, 0x0e, 0x59, 0x29,
+	0xaf, 0x7d, 0xca, 0x53, 0x0b, 0xbd, 0x3b, 0x70, 0x46, 0xfa, 0x62, 0xaf, 0x79, 0xde, 0xb7, 0x48,
+	0x7d, 0x2c, 0xd8, 0xc5, 0x5e, 0x6e, 0xed, 0xaf, 0xc0, 0xac, 0x83, 0x0e, 0xb6, 0xa3, 0x6a, 0x1b,
+	0xdb, 0x0d, 0x1c, 0x74, 0x40, 0x27, 0xac, 0x9e, 0x87, 0xa5, 0x31, 0x7a, 0x04, 0x95, 0xb7, 0xa2,
+	0x32, 0x6c, 0xa7, 0x83, 0xbc, 0xf0, 0x79, 0x88, 0xf0, 0xc2, 0xea, 0xc8, 0x44, 0x42, 0xd3, 0x9f,
+	0xa2, 0xc5, 0x4c, 0xae, 0x1d, 0x6b, 0x9e, 0x17, 0x95, 0x20, 0xf2, 0x5a, 0x1b, 0x9d, 0xd7, 0x0a,
+	0x09, 0x25, 0x8b, 0xe2, 0x73, 0x95, 0x2c, 0x52, 0x8b, 0x0b, 0x6c, 0x59, 0xcb, 0xc4, 0x85, 0x51,
+	0xbf, 0x8e, 0x8c, 0x22, 0x17, 0x86, 0xcf, 0x9b, 0x51, 0x8c, 0xb6, 0x4c, 0x8d, 0xd3, 0x6e, 0xfe,
+	0xb6, 0x0a, 0x85, 0xcd, 0xa0, 0xab, 0xfe, 0x10, 0xca, 0xa2, 0x1c, 0x74, 0x61, 0x6c, 0xb9, 0x49,
+	0xaa, 0xa1, 0x68, 0x57, 0xb2, 0xa0, 0xc4, 0xe9, 0xb3, 0x0b, 0x73, 0x72, 0x3d, 0xe5, 0xd2, 0x04,
+	0x61, 0x0e, 0xd4, 0x1a, 0x19, 0x81, 0x42, 0x91, 0x07, 0xc7, 0x87, 0x0a, 0x0c, 0x97, 0xd3, 0xa6,
+	0x88, 0x63, 0xb5, 0x66, 0x76, 0xac, 0xd0, 0xf8, 0x7d, 0x28, 0xf1, 0x32, 0xc0, 0xab, 0x69, 0xe2,
+	0x0c, 0xa4, 0xad, 0x64, 0x00, 0x89, 0xc9, 0x0d, 0x98, 0x1d, 0xdc, 0xc3, 0xbf, 0x94, 0x89, 0x9d,
+	0x76, 0x35, 0x13, 0x4c, 0xe6, 0xcf, 0x6f, 0xcf, 0xa9, 0xfc, 0x19, 0x48, 0x5b, 0xc9, 0x00, 0x92,
+	0xe3, 0x2e, 0x5f, 0x96, 0x53, 0xe3, 0x2e, 0x01, 0xb5, 0x46, 0x46, 0xa0, 0xec, 0xa8, 0xc1, 0xf5,
+	0x31, 0xd5, 0x51, 0x02, 0xa6, 0x5d, 0xcd, 0x04, 0x93, 0x53, 0x6b, 0xe8, 0xd6, 0x78, 0x39, 0x0b,
+	0xcb, 0x08, 0xab, 0x35, 0xb3, 0x63, 0x47, 0x93, 0x59, 0xfc, 0xc7, 0x45, 0x86, 0x64, 0xe6, 0x58,
+	0xad, 0x99, 0x1d, 0x2b, 0x34, 0xfe, 0x18, 0xd4, 0x84, 0xff, 0x70, 0xa8, 0x67, 0x9b, 0x89, 0xe3,
+	0xb5, 0x9b, 0xf9, 0xf0, 0x42, 0xbb, 0x0d, 0xc7, 0xe2, 0x0f, 0xf6, 0xaf, 0x4d, 0x9e, 0x88, 0x41,
+	0xb5, 0xeb, 0x99, 0xa1, 0x42, 0x5d, 0x1f, 0x4e, 0x8d, 0x3e, 0x91, 0xa7, 0x26, 0xc5, 0x08, 0x5c,
+	0xbb, 0x91, 0x0b, 0x2e, 0x47, 0x76, 0xe8, 0x29, 0xfa, 0xf2, 0xe4, 0x89, 0x38, 0x56, 0x6b, 0x66,
+	0xc7, 0xca, 0x1a, 0x87, 0x5e, 0x7b, 0x2f, 0xa7, 0xa7, 0xbf, 0x8c, 0xd5, 0x9a, 0xd9, 0xb1, 0x72,
+	0x2e, 0x25, 0xbc, 0xea, 0xa6, 0xe6, 0xd2, 0x28, 0x5e, 0xbb, 0x99, 0x0f, 0x2f, 0xb4, 0xff, 0x54,
+	0x81, 0x97, 0x93, 0x1f, 0x6f, 0xaf, 0xa7, 0xdb, 0x92, 0x20, 0xa2, 0xdd, 0xca, 0x2d, 0x22, 0xfb,
+	0x7d, 0xe8, 0x95, 0x36, 0xd5, 0xef, 0x71, 0xac, 0xd6, 0xcc, 0x8e, 0x15, 0x1a, 0x03, 0x38, 0x31,
+	0xfc, 0x40, 0xbb, 0x32, 0x39, 0x61, 0x04, 0x58, 0x7b, 0x3d, 0x07, 0x58, 0x5e, 0xba, 0xf1, 0xd7,
+	0xd1, 0xd4, 0xa5, 0x1b, 0x83, 0x6a, 0xd7, 0x33, 0x43, 0x85, 0x3a, 0xf2, 0xb0, 0x38, 0xe6, 0x21,
+	0x34, 0xd5, 0x65, 0xc9, 0x32, 0xda, 0x6a, 0x7e, 0x19, 0x41, 0xe5, 0x7d, 0x78, 0x29, 0xe9, 0x7d,
+	0x33, 0xf5, 0x0b, 0x96, 0x20, 0xa0, 0xbd, 0x91, 0x53, 0x40, 0x26, 0x90, 0xf4, 0x66, 0x99, 0x4a,
+	0x20, 0x41, 0x40, 0x7b, 0x23, 0xa7, 0x40, 0x2c, 0x18, 0x63, 0x0a, 0x7e, 0xa9, 0xc1, 0x48, 0x96,
+	0xd1, 0x56, 0xf3, 0xcb, 0x08, 0x2a, 0xbf, 0x50, 0x60, 0x61, 0x7c, 0x49, 0xea, 0xc6, 0xe4, 0xd5,
+	0x94, 0x44, 0xe8, 0xab, 0x9f, 0x48, 0x2c, 0xb6, 0x13, 0x25, 0x57, 0xa6, 0x52, 0x13, 0x3f, 0x51,
+	0x44, 0xbb, 0x95, 0x5b, 0x64, 0x68, 0x47, 0x4c, 0x2a, 0x3c, 0x4d, 0xd8, 0x11, 0x13, 0x44, 0xb4,
+	0x5b, 0xb9, 0x45, 0x62, 0xe9, 0x32, 0xa6, 0xb0, 0xd4, 0x9c, 0x7c, 0x22, 0x1b, 0x61, 0xb2, 0x9a,
+	0x5f, 0x46, 0x50, 0x79, 0x0f, 0x8e, 0xc6, 0x6a, 0x4a, 0xb5, 0xd4, 0x7d, 0x40, 0x42, 0x6a, 0xd7,
+	0xb2, 0x22, 0x93, 0xcd, 0x8e, 0x97, 0x7d, 0xb2, 0x99, 0x1d, 0x93, 0xd1, 0x56, 0xf3, 0xcb, 0xc8,
+	0x07, 0x9f, 0xd1, 0xe2, 0x4f, 0xea, 0xc1, 0x67, 0x04, 0xae, 0xdd, 0xc8, 0x05, 0x97, 0x2f, 0x04,
+	0x72, 0x61, 0xe7, 0xd2, 0x84, 0xaf, 0x3b, 0x07, 0x6a, 0x8d, 0x8c, 0xc0, 0xf8, 0x79, 0x27, 0x56,
+	0xcf, 0xb9, 0x3c, 0xf9, 0x23, 0x2e, 0xd4, 0x35, 0xb3, 0x63, 0x85, 0xc6, 0x47, 0x0a, 0xcc, 0x27,
+	0x96, 0x72, 0xae, 0x65, 0x39, 0xfa, 0xcb, 0x12, 0xda, 0x97, 0xf3, 0x4a, 0xc4, 0x0e, 0x5d, 0xa3,
+	0x35, 0x9c, 0xf4, 0x43, 0xd7, 0x08, 0x5e, 0xbb, 0x99, 0x0f, 0x2f, 0xaf, 0xa7, 0x58, 0x59, 0xa7,
+	0x36, 0xe9, 0xae, 0xcb, 0x91, 0xda, 0xb5, 0xac, 0x48, 0x59, 0x57, 0xac, 0xda, 0x52, 0x9b, 0x74,
+	0x2f, 0xcd, 0xa6, 0x2b, 0xa9, 0x4c, 0xd2, 0xda, 0x78, 0xfc, 0x74, 0x51, 0xf9, 0xf0, 0xe9, 0xa2,
+	0xf2, 0xf1, 0xd3, 0x45, 0xe5, 0x83, 0x67, 0x8b, 0x47, 0x3e, 0x7c, 0xb6, 0x78, 0xe4, 0x5f, 0xcf,
+	0x16, 0x8f, 0x7c, 0xef, 0x8a, 0x54, 0x8b, 0xa1, 0xb3, 0x5d, 0xc5, 0x26, 0xfb, 0x11, 0xda, 0x8d,
+	0x87, 0x0d, 0xfe, 0xaf, 0xf9, 0xb4, 0x2a, 0xd3, 0x9e, 0xa1, 0xa5, 0xd1, 0xd7, 0xff, 0x3f, 0x00,
+	0x43, 0xac, 0xc8, 0xc7, 0x69, 0x31, 0x00, 0x00,
+}
+
+func (this *MsgNewNFTClass) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgNewNFTClass)
+	if !ok {
+		that2, ok := that.(MsgNewNFTClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Uri != that1.Uri {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.RoyaltyRecipient != that1.RoyaltyRecipient {
+		return false
+	}
+	if this.RoyaltyBasisPoints != that1.RoyaltyBasisPoints {
+		return false
+	}
+	if this.Soulbound != that1.Soulbound {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateNFTClass) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateNFTClass)
+	if !ok {
+		that2, ok := that.(MsgUpdateNFTClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ClassId != that1.ClassId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Uri != that1.Uri {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgMintNFT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgMintNFT)
+	if !ok {
+		that2, ok := that.(MsgMintNFT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ClassId != that1.ClassId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Uri != that1.Uri {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateNFT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateNFT)
+	if !ok {
+		that2, ok := that.(MsgUpdateNFT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ClassId != that1.ClassId {
+		return false
+	}
+	if this.Uri != that1.Uri {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgBurnNFT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBurnNFT)
+	if !ok {
+		that2, ok := that.(MsgBurnNFT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ClassId != that1.ClassId {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// NewDenom defines a method for create a new denom.
+	NewDenom(ctx context.Context, in *MsgNewDenom, opts ...grpc.CallOption) (*MsgNewDenomResponse, error)
+	NewNFTClass(ctx context.Context, in *MsgNewNFTClass, opts ...grpc.CallOption) (*MsgNewNFTClassResponse, error)
+	UpdateNFTClass(ctx context.Context, in *MsgUpdateNFTClass, opts ...grpc.CallOption) (*MsgUpdateNFTClassResponse, error)
+	// MintNFT defines a method for mint a new nft
+	MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error)
+	// UpdateNFT defines a method for editing a nft.
+	UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error)
+	// BurnNFT defines a method for burning a nft.
+	BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error)
+	// TransferNFT defines a method for transferring or selling a nft, paying the class royalty.
+	TransferNFT(ctx context.Context, in *MsgTransferNFT, opts ...grpc.CallOption) (*MsgTransferNFTResponse, error)
+	// RevokeNFT defines a method for the class owner to burn a nft of a soulbound class.
+	RevokeNFT(ctx context.Context, in *MsgRevokeNFT, opts ...grpc.CallOption) (*MsgRevokeNFTResponse, error)
+	// TransferDidNFT defines a method for transferring or selling a nft between mises ids, paying the class royalty.
+	TransferDidNFT(ctx context.Context, in *MsgTransferDidNFT, opts ...grpc.CallOption) (*MsgTransferDidNFTResponse, error)
+	UpdateUserInfo(ctx context.Context, in *MsgUpdateUserInfo, opts ...grpc.CallOption) (*MsgUpdateUserInfoResponse, error)
+	UpdateUserRelation(ctx context.Context, in *MsgUpdateUserRelation, opts ...grpc.CallOption) (*MsgUpdateUserRelationResponse, error)
+	UpdateAppInfo(ctx context.Context, in *MsgUpdateAppInfo, opts ...grpc.CallOption) (*MsgUpdateAppInfoResponse, error)
+	CreateDidRegistry(ctx context.Context, in *MsgCreateDidRegistry, opts ...grpc.CallOption) (*MsgCreateDidRegistryResponse, error)
+	CreateUserList(ctx context.Context, in *MsgCreateUserList, opts ...grpc.CallOption) (*MsgCreateUserListResponse, error)
+	RenameUserList(ctx context.Context, in *MsgRenameUserList, opts ...grpc.CallOption) (*MsgRenameUserListResponse, error)
+	AddUserListMembers(ctx context.Context, in *MsgAddUserListMembers, opts ...grpc.CallOption) (*MsgAddUserListMembersResponse, error)
+	RemoveUserListMembers(ctx context.Context, in *MsgRemoveUserListMembers, opts ...grpc.CallOption) (*MsgRemoveUserListMembersResponse, error)
+	DeleteUserList(ctx context.Context, in *MsgDeleteUserList, opts ...grpc.CallOption) (*MsgDeleteUserListResponse, error)
+	CreateCommunity(ctx context.Context, in *MsgCreateCommunity, opts ...grpc.CallOption) (*MsgCreateCommunityResponse, error)
+	JoinCommunity(ctx context.Context, in *MsgJoinCommunity, opts ...grpc.CallOption) (*MsgJoinCommunityResponse, error)
+	ApproveCommunityMember(ctx context.Context, in *MsgApproveCommunityMember, opts ...grpc.CallOption) (*MsgApproveCommunityMemberResponse, error)
+	KickCommunityMember(ctx context.Context, in *MsgKickCommunityMember, opts ...grpc.CallOption) (*MsgKickCommunityMemberResponse, error)
+	ChangeCommunityRole(ctx context.Context, in *MsgChangeCommunityRole, opts ...grpc.CallOption) (*MsgChangeCommunityRoleResponse, error)
+	SetAppFeeGrantTemplate(ctx context.Context, in *MsgSetAppFeeGrantTemplate, opts ...grpc.CallOption) (*MsgSetAppFeeGrantTemplateResponse, error)
+	DeleteAppFeeGrantTemplate(ctx context.Context, in *MsgDeleteAppFeeGrantTemplate, opts ...grpc.CallOption) (*MsgDeleteAppFeeGrantTemplateResponse, error)
+	GrantAppFeeAllowances(ctx context.Context, in *MsgGrantAppFeeAllowances, opts ...grpc.CallOption) (*MsgGrantAppFeeAllowancesResponse, error)
+	RenewAppFeeAllowances(ctx context.Context, in *MsgRenewAppFeeAllowances, opts ...grpc.CallOption) (*MsgRenewAppFeeAllowancesResponse, error)
+	RevokeAppFeeAllowances(ctx context.Context, in *MsgRevokeAppFeeAllowances, opts ...grpc.CallOption) (*MsgRevokeAppFeeAllowancesResponse, error)
+	AuthorizeApp(ctx context.Context, in *MsgAuthorizeApp, opts ...grpc.CallOption) (*MsgAuthorizeAppResponse, error)
+	RevokeAppAuthorization(ctx context.Context, in *MsgRevokeAppAuthorization, opts ...grpc.CallOption) (*MsgRevokeAppAuthorizationResponse, error)
+	// ExecAppAuthorized executes messages of users on behalf of an app they authorized
+	ExecAppAuthorized(ctx context.Context, in *MsgExecAppAuthorized, opts ...grpc.CallOption) (*MsgExecAppAuthorizedResponse, error)
+	AddAppAdmin(ctx context.Context, in *MsgAddAppAdmin, opts ...grpc.CallOption) (*MsgAddAppAdminResponse, error)
+	RemoveAppAdmin(ctx context.Context, in *MsgRemoveAppAdmin, opts ...grpc.CallOption) (*MsgRemoveAppAdminResponse, error)
+	TransferAppOwnership(ctx context.Context, in *MsgTransferAppOwnership, opts ...grpc.CallOption) (*MsgTransferAppOwnershipResponse, error)
+	AcceptAppOwnership(ctx context.Context, in *MsgAcceptAppOwnership, opts ...grpc.CallOption) (*MsgAcceptAppOwnershipResponse, error)
+	MintAppDenom(ctx context.Context, in *MsgMintAppDenom, opts ...grpc.CallOption) (*MsgMintAppDenomResponse, error)
+	BurnAppDenom(ctx context.Context, in *MsgBurnAppDenom, opts ...grpc.CallOption) (*MsgBurnAppDenomResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) NewDenom(ctx context.Context, in *MsgNewDenom, opts ...grpc.CallOption) (*MsgNewDenomResponse, error) {
+	out := new(MsgNewDenomResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/NewDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) NewNFTClass(ctx context.Context, in *MsgNewNFTClass, opts ...grpc.CallOption) (*MsgNewNFTClassResponse, error) {
+	out := new(MsgNewNFTClassResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/NewNFTClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNFTClass(ctx context.Context, in *MsgUpdateNFTClass, opts ...grpc.CallOption) (*MsgUpdateNFTClassResponse, error) {
+	out := new(MsgUpdateNFTClassResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateNFTClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error) {
+	out := new(MsgMintNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/MintNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error) {
+	out := new(MsgUpdateNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error) {
+	out := new(MsgBurnNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/BurnNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferNFT(ctx context.Context, in *MsgTransferNFT, opts ...grpc.CallOption) (*MsgTransferNFTResponse, error) {
+	out := new(MsgTransferNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/TransferNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeNFT(ctx context.Context, in *MsgRevokeNFT, opts ...grpc.CallOption) (*MsgRevokeNFTResponse, error) {
+	out := new(MsgRevokeNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RevokeNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferDidNFT(ctx context.Context, in *MsgTransferDidNFT, opts ...grpc.CallOption) (*MsgTransferDidNFTResponse, error) {
+	out := new(MsgTransferDidNFTResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/TransferDidNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateUserInfo(ctx context.Context, in *MsgUpdateUserInfo, opts ...grpc.CallOption) (*MsgUpdateUserInfoResponse, error) {
+	out := new(MsgUpdateUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateUserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateUserRelation(ctx context.Context, in *MsgUpdateUserRelation, opts ...grpc.CallOption) (*MsgUpdateUserRelationResponse, error) {
+	out := new(MsgUpdateUserRelationResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateUserRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAppInfo(ctx context.Context, in *MsgUpdateAppInfo, opts ...grpc.CallOption) (*MsgUpdateAppInfoResponse, error) {
+	out := new(MsgUpdateAppInfoResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/UpdateAppInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDidRegistry(ctx context.Context, in *MsgCreateDidRegistry, opts ...grpc.CallOption) (*MsgCreateDidRegistryResponse, error) {
+	out := new(MsgCreateDidRegistryResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/CreateDidRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateUserList(ctx context.Context, in *MsgCreateUserList, opts ...grpc.CallOption) (*MsgCreateUserListResponse, error) {
+	out := new(MsgCreateUserListResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/CreateUserList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenameUserList(ctx context.Context, in *MsgRenameUserList, opts ...grpc.CallOption) (*MsgRenameUserListResponse, error) {
+	out := new(MsgRenameUserListResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RenameUserList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddUserListMembers(ctx context.Context, in *MsgAddUserListMembers, opts ...grpc.CallOption) (*MsgAddUserListMembersResponse, error) {
+	out := new(MsgAddUserListMembersResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/AddUserListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveUserListMembers(ctx context.Context, in *MsgRemoveUserListMembers, opts ...grpc.CallOption) (*MsgRemoveUserListMembersResponse, error) {
+	out := new(MsgRemoveUserListMembersResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RemoveUserListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteUserList(ctx context.Context, in *MsgDeleteUserList, opts ...grpc.CallOption) (*MsgDeleteUserListResponse, error) {
+	out := new(MsgDeleteUserListResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/DeleteUserList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateCommunity(ctx context.Context, in *MsgCreateCommunity, opts ...grpc.CallOption) (*MsgCreateCommunityResponse, error) {
+	out := new(MsgCreateCommunityResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/CreateCommunity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinCommunity(ctx context.Context, in *MsgJoinCommunity, opts ...grpc.CallOption) (*MsgJoinCommunityResponse, error) {
+	out := new(MsgJoinCommunityResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/JoinCommunity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveCommunityMember(ctx context.Context, in *MsgApproveCommunityMember, opts ...grpc.CallOption) (*MsgApproveCommunityMemberResponse, error) {
+	out := new(MsgApproveCommunityMemberResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/ApproveCommunityMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) KickCommunityMember(ctx context.Context, in *MsgKickCommunityMember, opts ...grpc.CallOption) (*MsgKickCommunityMemberResponse, error) {
+	out := new(MsgKickCommunityMemberResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/KickCommunityMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeCommunityRole(ctx context.Context, in *MsgChangeCommunityRole, opts ...grpc.CallOption) (*MsgChangeCommunityRoleResponse, error) {
+	out := new(MsgChangeCommunityRoleResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/ChangeCommunityRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAppFeeGrantTemplate(ctx context.Context, in *MsgSetAppFeeGrantTemplate, opts ...grpc.CallOption) (*MsgSetAppFeeGrantTemplateResponse, error) {
+	out := new(MsgSetAppFeeGrantTemplateResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/SetAppFeeGrantTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAppFeeGrantTemplate(ctx context.Context, in *MsgDeleteAppFeeGrantTemplate, opts ...grpc.CallOption) (*MsgDeleteAppFeeGrantTemplateResponse, error) {
+	out := new(MsgDeleteAppFeeGrantTemplateResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/DeleteAppFeeGrantTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantAppFeeAllowances(ctx context.Context, in *MsgGrantAppFeeAllowances, opts ...grpc.CallOption) (*MsgGrantAppFeeAllowancesResponse, error) {
+	out := new(MsgGrantAppFeeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/GrantAppFeeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewAppFeeAllowances(ctx context.Context, in *MsgRenewAppFeeAllowances, opts ...grpc.CallOption) (*MsgRenewAppFeeAllowancesResponse, error) {
+	out := new(MsgRenewAppFeeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RenewAppFeeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAppFeeAllowances(ctx context.Context, in *MsgRevokeAppFeeAllowances, opts ...grpc.CallOption) (*MsgRevokeAppFeeAllowancesResponse, error) {
+	out := new(MsgRevokeAppFeeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RevokeAppFeeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AuthorizeApp(ctx context.Context, in *MsgAuthorizeApp, opts ...grpc.CallOption) (*MsgAuthorizeAppResponse, error) {
+	out := new(MsgAuthorizeAppResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/AuthorizeApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAppAuthorization(ctx context.Context, in *MsgRevokeAppAuthorization, opts ...grpc.CallOption) (*MsgRevokeAppAuthorizationResponse, error) {
+	out := new(MsgRevokeAppAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RevokeAppAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecAppAuthorized(ctx context.Context, in *MsgExecAppAuthorized, opts ...grpc.CallOption) (*MsgExecAppAuthorizedResponse, error) {
+	out := new(MsgExecAppAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/ExecAppAuthorized", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAppAdmin(ctx context.Context, in *MsgAddAppAdmin, opts ...grpc.CallOption) (*MsgAddAppAdminResponse, error) {
+	out := new(MsgAddAppAdminResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/AddAppAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAppAdmin(ctx context.Context, in *MsgRemoveAppAdmin, opts ...grpc.CallOption) (*MsgRemoveAppAdminResponse, error) {
+	out := new(MsgRemoveAppAdminResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/RemoveAppAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAppOwnership(ctx context.Context, in *MsgTransferAppOwnership, opts ...grpc.CallOption) (*MsgTransferAppOwnershipResponse, error) {
+	out := new(MsgTransferAppOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/TransferAppOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAppOwnership(ctx context.Context, in *MsgAcceptAppOwnership, opts ...grpc.CallOption) (*MsgAcceptAppOwnershipResponse, error) {
+	out := new(MsgAcceptAppOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/AcceptAppOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintAppDenom(ctx context.Context, in *MsgMintAppDenom, opts ...grpc.CallOption) (*MsgMintAppDenomResponse, error) {
+	out := new(MsgMintAppDenomResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/MintAppDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnAppDenom(ctx context.Context, in *MsgBurnAppDenom, opts ...grpc.CallOption) (*MsgBurnAppDenomResponse, error) {
+	out := new(MsgBurnAppDenomResponse)
+	err := c.cc.Invoke(ctx, "/misesid.misestm.v1beta1.Msg/BurnAppDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// NewDenom defines a method for create a new denom.
+	NewDenom(context.Context, *MsgNewDenom) (*MsgNewDenomResponse, error)
+	NewNFTClass(context.Context, *MsgNewNFTClass) (*MsgNewNFTClassResponse, error)
+	UpdateNFTClass(context.Context, *MsgUpdateNFTClass) (*MsgUpdateNFTClassResponse, error)
+	// MintNFT defines a method for mint a new nft
+	MintNFT(context.Context, *MsgMintNFT) (*MsgMintNFTResponse, error)
+	// UpdateNFT defines a method for editing a nft.
+	UpdateNFT(context.Context, *MsgUpdateNFT) (*MsgUpdateNFTResponse, error)
+	// BurnNFT defines a method for burning a nft.
+	BurnNFT(context.Context, *MsgBurnNFT) (*MsgBurnNFTResponse, error)
+	// TransferNFT defines a method for transferring or selling a nft, paying the class royalty.
+	TransferNFT(context.Context, *MsgTransferNFT) (*MsgTransferNFTResponse, error)
+	// RevokeNFT defines a method for the class owner to burn a nft of a soulbound class.
+	RevokeNFT(context.Context, *MsgRevokeNFT) (*MsgRevokeNFTResponse, error)
+	// TransferDidNFT defines a method for transferring or selling a nft between mises ids, paying the class royalty.
+	TransferDidNFT(context.Context, *MsgTransferDidNFT) (*MsgTransferDidNFTResponse, error)
+	UpdateUserInfo(context.Context, *MsgUpdateUserInfo) (*MsgUpdateUserInfoResponse, error)
+	UpdateUserRelation(context.Context, *MsgUpdateUserRelation) (*MsgUpdateUserRelationResponse, error)
+	UpdateAppInfo(context.Context, *MsgUpdateAppInfo) (*MsgUpdateAppInfoResponse, error)
+	CreateDidRegistry(context.Context, *MsgCreateDidRegistry) (*MsgCreateDidRegistryResponse, error)
+	CreateUserList(context.Context, *MsgCreateUserList) (*MsgCreateUserListResponse, error)
+	RenameUserList(context.Context, *MsgRenameUserList) (*MsgRenameUserListResponse, error)
+	AddUserListMembers(context.Context, *MsgAddUserListMembers) (*MsgAddUserListMembersResponse, error)
+	RemoveUserListMembers(context.Context, *MsgRemoveUserListMembers) (*MsgRemoveUserListMembersResponse, error)
+	DeleteUserList(context.Context, *MsgDeleteUserList) (*MsgDeleteUserListResponse, error)
+	CreateCommunity(context.Context, *MsgCreateCommunity) (*MsgCreateCommunityResponse, error)
+	JoinCommunity(context.Context, *MsgJoinCommunity) (*MsgJoinCommunityResponse, error)
+	ApproveCommunityMember(context.Context, *MsgApproveCommunityMember) (*MsgApproveCommunityMemberResponse, error)
+	KickCommunityMember(context.Context, *MsgKickCommunityMember) (*MsgKickCommunityMemberResponse, error)
+	ChangeCommunityRole(context.Context, *MsgChangeCommunityRole) (*MsgChangeCommunityRoleResponse, error)
+	SetAppFeeGrantTemplate(context.Context, *MsgSetAppFeeGrantTemplate) (*MsgSetAppFeeGrantTemplateResponse, error)
+	DeleteAppFeeGrantTemplate(context.Context, *MsgDeleteAppFeeGrantTemplate) (*MsgDeleteAppFeeGrantTemplateResponse, error)
+	GrantAppFeeAllowances(context.Context, *MsgGrantAppFeeAllowances) (*MsgGrantAppFeeAllowancesResponse, error)
+	RenewAppFeeAllowances(context.Context, *MsgRenewAppFeeAllowances) (*MsgRenewAppFeeAllowancesResponse, error)
+	RevokeAppFeeAllowances(context.Context, *MsgRevokeAppFeeAllowances) (*MsgRevokeAppFeeAllowancesResponse, error)
+	AuthorizeApp(context.Context, *MsgAuthorizeApp) (*MsgAuthorizeAppResponse, error)
+	RevokeAppAuthorization(context.Context, *MsgRevokeAppAuthorization) (*MsgRevokeAppAuthorizationResponse, error)
+	// ExecAppAuthorized executes messages of users on behalf of an app they authorized
+	ExecAppAuthorized(context.Context, *MsgExecAppAuthorized) (*MsgExecAppAuthorizedResponse, error)
+	AddAppAdmin(context.Context, *MsgAddAppAdmin) (*MsgAddAppAdminResponse, error)
+	RemoveAppAdmin(context.Context, *MsgRemoveAppAdmin) (*MsgRemoveAppAdminResponse, error)
+	TransferAppOwnership(context.Context, *MsgTransferAppOwnership) (*MsgTransferAppOwnershipResponse, error)
+	AcceptAppOwnership(context.Context, *MsgAcceptAppOwnership) (*MsgAcceptAppOwnershipResponse, error)
+	MintAppDenom(context.Context, *MsgMintAppDenom) (*MsgMintAppDenomResponse, error)
+	BurnAppDenom(context.Context, *MsgBurnAppDenom) (*MsgBurnAppDenomResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) NewDenom(ctx context.Context, req *MsgNewDenom) (*MsgNewDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewDenom not implemented")
+}
+func (*UnimplementedMsgServer) NewNFTClass(ctx context.Context, req *MsgNewNFTClass) (*MsgNewNFTClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewNFTClass not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNFTClass(ctx context.Context, req *MsgUpdateNFTClass) (*MsgUpdateNFTClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNFTClass not implemented")
+}
+func (*UnimplementedMsgServer) MintNFT(ctx context.Context, req *MsgMintNFT) (*MsgMintNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintNFT not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNFT(ctx context.Context, req *MsgUpdateNFT) (*MsgUpdateNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNFT not implemented")
+}
+func (*UnimplementedMsgServer) BurnNFT(ctx context.Context, req *MsgBurnNFT) (*MsgBurnNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnNFT not implemented")
+}
+func (*UnimplementedMsgServer) TransferNFT(ctx context.Context, req *MsgTransferNFT) (*MsgTransferNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNFT not implemented")
+}
+func (*UnimplementedMsgServer) RevokeNFT(ctx context.Context, req *MsgRevokeNFT) (*MsgRevokeNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNFT not implemented")
+}
+func (*UnimplementedMsgServer) TransferDidNFT(ctx context.Context, req *MsgTransferDidNFT) (*MsgTransferDidNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDidNFT not implemented")
+}
+func (*UnimplementedMsgServer) UpdateUserInfo(ctx context.Context, req *MsgUpdateUserInfo) (*MsgUpdateUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateUserRelation(ctx context.Context, req *MsgUpdateUserRelation) (*MsgUpdateUserRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserRelation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAppInfo(ctx context.Context, req *MsgUpdateAppInfo) (*MsgUpdateAppInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppInfo not implemented")
+}
+func (*UnimplementedMsgServer) CreateDidRegistry(ctx context.Context, req *MsgCreateDidRegistry) (*MsgCreateDidRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDidRegistry not implemented")
+}
+func (*UnimplementedMsgServer) CreateUserList(ctx context.Context, req *MsgCreateUserList) (*MsgCreateUserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserList not implemented")
+}
+func (*UnimplementedMsgServer) RenameUserList(ctx context.Context, req *MsgRenameUserList) (*MsgRenameUserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameUserList not implemented")
+}
+func (*UnimplementedMsgServer) AddUserListMembers(ctx context.Context, req *MsgAddUserListMembers) (*MsgAddUserListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserListMembers not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUserListMembers(ctx context.Context, req *MsgRemoveUserListMembers) (*MsgRemoveUserListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserListMembers not implemented")
+}
+func (*UnimplementedMsgServer) DeleteUserList(ctx context.Context, req *MsgDeleteUserList) (*MsgDeleteUserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserList not implemented")
+}
+func (*UnimplementedMsgServer) CreateCommunity(ctx context.Context, req *MsgCreateCommunity) (*MsgCreateCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunity not implemented")
+}
+func (*UnimplementedMsgServer) JoinCommunity(ctx context.Context, req *MsgJoinCommunity) (*MsgJoinCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCommunity not implemented")
+}
+func (*UnimplementedMsgServer) ApproveCommunityMember(ctx context.Context, req *MsgApproveCommunityMember) (*MsgApproveCommunityMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCommunityMember not implemented")
+}
+func (*UnimplementedMsgServer) KickCommunityMember(ctx context.Context, req *MsgKickCommunityMember) (*MsgKickCommunityMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickCommunityMember not implemented")
+}
+func (*UnimplementedMsgServer) ChangeCommunityRole(ctx context.Context, req *MsgChangeCommunityRole) (*MsgChangeCommunityRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCommunityRole not implemented")
+}
+func (*UnimplementedMsgServer) SetAppFeeGrantTemplate(ctx context.Context, req *MsgSetAppFeeGrantTemplate) (*MsgSetAppFeeGrantTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppFeeGrantTemplate not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAppFeeGrantTemplate(ctx context.Context, req *MsgDeleteAppFeeGrantTemplate) (*MsgDeleteAppFeeGrantTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppFeeGrantTemplate not implemented")
+}
+func (*UnimplementedMsgServer) GrantAppFeeAllowances(ctx context.Context, req *MsgGrantAppFeeAllowances) (*MsgGrantAppFeeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAppFeeAllowances not implemented")
+}
+func (*UnimplementedMsgServer) RenewAppFeeAllowances(ctx context.Context, req *MsgRenewAppFeeAllowances) (*MsgRenewAppFeeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAppFeeAllowances not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAppFeeAllowances(ctx context.Context, req *MsgRevokeAppFeeAllowances) (*MsgRevokeAppFeeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppFeeAllowances not implemented")
+}
+func (*UnimplementedMsgServer) AuthorizeApp(ctx context.Context, req *MsgAuthorizeApp) (*MsgAuthorizeAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeApp not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAppAuthorization(ctx context.Context, req *MsgRevokeAppAuthorization) (*MsgRevokeAppAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) ExecAppAuthorized(ctx context.Context, req *MsgExecAppAuthorized) (*MsgExecAppAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecAppAuthorized not implemented")
+}
+func (*UnimplementedMsgServer) AddAppAdmin(ctx context.Context, req *MsgAddAppAdmin) (*MsgAddAppAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAppAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAppAdmin(ctx context.Context, req *MsgRemoveAppAdmin) (*MsgRemoveAppAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppAdmin not implemented")
+}
+func (*UnimplementedMsgServer) TransferAppOwnership(ctx context.Context, req *MsgTransferAppOwnership) (*MsgTransferAppOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAppOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAppOwnership(ctx context.Context, req *MsgAcceptAppOwnership) (*MsgAcceptAppOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAppOwnership not implemented")
+}
+func (*UnimplementedMsgServer) MintAppDenom(ctx context.Context, req *MsgMintAppDenom) (*MsgMintAppDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAppDenom not implemented")
+}
+func (*UnimplementedMsgServer) BurnAppDenom(ctx context.Context, req *MsgBurnAppDenom) (*MsgBurnAppDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAppDenom not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_NewDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NewDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/NewDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewDenom(ctx, req.(*MsgNewDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_NewNFTClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewNFTClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NewNFTClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/NewNFTClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewNFTClass(ctx, req.(*MsgNewNFTClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNFTClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNFTClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNFTClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateNFTClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNFTClass(ctx, req.(*MsgUpdateNFTClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/MintNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintNFT(ctx, req.(*MsgMintNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNFT(ctx, req.(*MsgUpdateNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/BurnNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnNFT(ctx, req.(*MsgBurnNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/TransferNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNFT(ctx, req.(*MsgTransferNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RevokeNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeNFT(ctx, req.(*MsgRevokeNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferDidNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDidNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDidNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/TransferDidNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDidNFT(ctx, req.(*MsgTransferDidNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateUserInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateUserInfo(ctx, req.(*MsgUpdateUserInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateUserRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateUserRelation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateUserRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateUserRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateUserRelation(ctx, req.(*MsgUpdateUserRelation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAppInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAppInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAppInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/UpdateAppInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAppInfo(ctx, req.(*MsgUpdateAppInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDidRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDidRegistry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDidRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/CreateDidRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDidRegistry(ctx, req.(*MsgCreateDidRegistry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateUserList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateUserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/CreateUserList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateUserList(ctx, req.(*MsgCreateUserList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenameUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenameUserList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenameUserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RenameUserList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenameUserList(ctx, req.(*MsgRenameUserList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddUserListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddUserListMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddUserListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/AddUserListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddUserListMembers(ctx, req.(*MsgAddUserListMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveUserListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUserListMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUserListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RemoveUserListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUserListMembers(ctx, req.(*MsgRemoveUserListMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteUserList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteUserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/DeleteUserList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteUserList(ctx, req.(*MsgDeleteUserList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCommunity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/CreateCommunity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCommunity(ctx, req.(*MsgCreateCommunity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinCommunity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/JoinCommunity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinCommunity(ctx, req.(*MsgJoinCommunity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveCommunityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveCommunityMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveCommunityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/ApproveCommunityMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveCommunityMember(ctx, req.(*MsgApproveCommunityMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_KickCommunityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgKickCommunityMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).KickCommunityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/KickCommunityMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).KickCommunityMember(ctx, req.(*MsgKickCommunityMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeCommunityRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeCommunityRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeCommunityRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/ChangeCommunityRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeCommunityRole(ctx, req.(*MsgChangeCommunityRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAppFeeGrantTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppFeeGrantTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppFeeGrantTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/SetAppFeeGrantTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppFeeGrantTemplate(ctx, req.(*MsgSetAppFeeGrantTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAppFeeGrantTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAppFeeGrantTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAppFeeGrantTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/DeleteAppFeeGrantTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAppFeeGrantTemplate(ctx, req.(*MsgDeleteAppFeeGrantTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAppFeeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAppFeeAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAppFeeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/GrantAppFeeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAppFeeAllowances(ctx, req.(*MsgGrantAppFeeAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewAppFeeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewAppFeeAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewAppFeeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RenewAppFeeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewAppFeeAllowances(ctx, req.(*MsgRenewAppFeeAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAppFeeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAppFeeAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAppFeeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RevokeAppFeeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAppFeeAllowances(ctx, req.(*MsgRevokeAppFeeAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AuthorizeApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizeApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorizeApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/AuthorizeApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorizeApp(ctx, req.(*MsgAuthorizeApp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAppAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAppAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAppAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RevokeAppAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAppAuthorization(ctx, req.(*MsgRevokeAppAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecAppAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecAppAuthorized)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecAppAuthorized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/ExecAppAuthorized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecAppAuthorized(ctx, req.(*MsgExecAppAuthorized))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAppAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAppAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAppAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/AddAppAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAppAdmin(ctx, req.(*MsgAddAppAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAppAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAppAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAppAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/RemoveAppAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAppAdmin(ctx, req.(*MsgRemoveAppAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAppOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAppOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAppOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/TransferAppOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAppOwnership(ctx, req.(*MsgTransferAppOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAppOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAppOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAppOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/AcceptAppOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAppOwnership(ctx, req.(*MsgAcceptAppOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintAppDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintAppDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintAppDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/MintAppDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintAppDenom(ctx, req.(*MsgMintAppDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnAppDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnAppDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnAppDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/misesid.misestm.v1beta1.Msg/BurnAppDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnAppDenom(ctx, req.(*MsgBurnAppDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "misesid.misestm.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewDenom",
+			Handler:    _Msg_NewDenom_Handler,
+		},
+		{
+			MethodName: "NewNFTClass",
+			Handler:    _Msg_NewNFTClass_Handler,
+		},
+		{
+			MethodName: "UpdateNFTClass",
+			Handler:    _Msg_UpdateNFTClass_Handler,
+		},
+		{
+			MethodName: "MintNFT",
+			Handler:    _Msg_MintNFT_Handler,
+		},
+		{
+			MethodName: "UpdateNFT",
+			Handler:    _Msg_UpdateNFT_Handler,
+		},
+		{
+			MethodName: "BurnNFT",
+			Handler:    _Msg_BurnNFT_Handler,
+		},
+		{
+			MethodName: "TransferNFT",
+			Handler:    _Msg_TransferNFT_Handler,
+		},
+		{
+			MethodName: "RevokeNFT",
+			Handler:    _Msg_RevokeNFT_Handler,
+		},
+		{
+			MethodName: "TransferDidNFT",
+			Handler:    _Msg_TransferDidNFT_Handler,
+		},
+		{
+			MethodName: "UpdateUserInfo",
+			Handler:    _Msg_UpdateUserInfo_Handler,
+		},
+		{
+			MethodName: "UpdateUserRelation",
+			Handler:    _Msg_UpdateUserRelation_Handler,
+		},
+		{
+			MethodName: "UpdateAppInfo",
+			Handler:    _Msg_UpdateAppInfo_Handler,
+		},
+		{
+			MethodName: "CreateDidRegistry",
+			Handler:    _Msg_CreateDidRegistry_Handler,
+		},
+		{
+			MethodName: "CreateUserList",
+			Handler:    _Msg_CreateUserList_Handler,
+		},
+		{
+			MethodName: "RenameUserList",
+			Handler:    _Msg_RenameUserList_Handler,
+		},
+		{
+			MethodName: "AddUserListMembers",
+			Handler:    _Msg_AddUserListMembers_Handler,
+		},
+		{
+			MethodName: "RemoveUserListMembers",
+			Handler:    _Msg_RemoveUserListMembers_Handler,
+		},
+		{
+			MethodName: "DeleteUserList",
+			Handler:    _Msg_DeleteUserList_Handler,
+		},
+		{
+			MethodName: "CreateCommunity",
+			Handler:    _Msg_CreateCommunity_Handler,
+		},
+		{
+			MethodName: "JoinCommunity",
+			Handler:    _Msg_JoinCommunity_Handler,
+		},
+		{
+			MethodName: "ApproveCommunityMember",
+			Handler:    _Msg_ApproveCommunityMember_Handler,
+		},
+		{
+			MethodName: "KickCommunityMember",
+			Handler:    _Msg_KickCommunityMember_Handler,
+		},
+		{
+			MethodName: "ChangeCommunityRole",
+			Handler:    _Msg_ChangeCommunityRole_Handler,
+		},
+		{
+			MethodName: "SetAppFeeGrantTemplate",
+			Handler:    _Msg_SetAppFeeGrantTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteAppFeeGrantTemplate",
+			Handler:    _Msg_DeleteAppFeeGrantTemplate_Handler,
+		},
+		{
+			MethodName: "GrantAppFeeAllowances",
+			Handler:    _Msg_GrantAppFeeAllowances_Handler,
+		},
+		{
+			MethodName: "RenewAppFeeAllowances",
+			Handler:    _Msg_RenewAppFeeAllowances_Handler,
+		},
+		{
+			MethodName: "RevokeAppFeeAllowances",
+			Handler:    _Msg_RevokeAppFeeAllowances_Handler,
+		},
+		{
+			MethodName: "AuthorizeApp",
+			Handler:    _Msg_AuthorizeApp_Handler,
+		},
+		{
+			MethodName: "RevokeAppAuthorization",
+			Handler:    _Msg_RevokeAppAuthorization_Handler,
+		},
+		{
+			MethodName: "ExecAppAuthorized",
+			Handler:    _Msg_ExecAppAuthorized_Handler,
+		},
+		{
+			MethodName: "AddAppAdmin",
+			Handler:    _Msg_AddAppAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveAppAdmin",
+			Handler:    _Msg_RemoveAppAdmin_Handler,
+		},
+		{
+			MethodName: "TransferAppOwnership",
+			Handler:    _Msg_TransferAppOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptAppOwnership",
+			Handler:    _Msg_AcceptAppOwnership_Handler,
+		},
+		{
+			MethodName: "MintAppDenom",
+			Handler:    _Msg_MintAppDenom_Handler,
+		},
+		{
+			MethodName: "BurnAppDenom",
+			Handler:    _Msg_BurnAppDenom_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "misestm/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateUserInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUserInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUserInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriInfo != nil {
+		{
+			size, err := m.PriInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PubInfo != nil {
+		{
+			size, err := m.PubInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateUserInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateUserRelation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUserRelation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUserRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExpiringRelType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiringRelType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RelType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsReferredBy {
+		i--
+		if m.IsReferredBy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsBlocking {
+		i--
+		if m.IsBlocking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsFollowing {
+		i--
+		if m.IsFollowing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UidTo) > 0 {
+		i -= len(m.UidTo)
+		copy(dAtA[i:], m.UidTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UidTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UidFrom) > 0 {
+		i -= len(m.UidFrom)
+		copy(dAtA[i:], m.UidFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UidFrom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateUserRelationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUserRelationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUserRelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAppInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAppInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAppInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.IconUrl) > 0 {
+		i -= len(m.IconUrl)
+		copy(dAtA[i:], m.IconUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IconUrl)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HomeUrl) > 0 {
+		i -= len(m.HomeUrl)
+		copy(dAtA[i:], m.HomeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HomeUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Domains[iNdEx])
+			copy(dAtA[i:], m.Domains[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Domains[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAppInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDidRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDidRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDidRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PkeyMultibase) > 0 {
+		i -= len(m.PkeyMultibase)
+		copy(dAtA[i:], m.PkeyMultibase)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyMultibase)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PkeyType) > 0 {
+		i -= len(m.PkeyType)
+		copy(dAtA[i:], m.PkeyType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PkeyDid) > 0 {
+		i -= len(m.PkeyDid)
+		copy(dAtA[i:], m.PkeyDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PkeyDid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDidRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDidRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDidRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateUserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateUserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateUserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateUserListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateUserListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateUserListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenameUserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenameUserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenameUserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenameUserListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenameUserListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenameUserListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddUserListMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserListMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserListMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddUserListMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserListMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserListMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUserListMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserListMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserListMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUserListMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserListMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserListMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteUserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteUserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteUserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteUserListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteUserListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteUserListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCommunity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCommunity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCommunity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinPolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JoinPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCommunityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCommunityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCommunityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinCommunity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinCommunity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinCommunity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinCommunityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinCommunityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinCommunityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCommunityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCommunityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCommunityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCommunityMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCommunityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCommunityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgKickCommunityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgKickCommunityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgKickCommunityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgKickCommunityMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgKickCommunityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgKickCommunityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCommunityRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCommunityRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCommunityRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommunityId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommunityId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCommunityRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCommunityRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCommunityRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DenomMeta != nil {
+		{
+			size, err := m.DenomMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewNFTClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewNFTClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Soulbound {
+		i--
+		if m.Soulbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewNFTClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewNFTClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewNFTClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNFTClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNFTClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNFTClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNFTClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNFTClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for iNdEx := len(m.Royalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDidNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDidNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDidNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDidNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDidNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDidNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for iNdEx := len(m.Royalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppFeeGrantTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppFeeGrantTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppFeeGrantTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppFeeGrantTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppFeeGrantTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppFeeGrantTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAppFeeGrantTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAppFeeGrantTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAppFeeGrantTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAppFeeGrantTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAppFeeGrantTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAppFeeGrantTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAppFeeAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAppFeeAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAppFeeAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grantees[iNdEx])
+			copy(dAtA[i:], m.Grantees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Grantees[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAppFeeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAppFeeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAppFeeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewAppFeeAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewAppFeeAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewAppFeeAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grantees[iNdEx])
+			copy(dAtA[i:], m.Grantees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Grantees[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewAppFeeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewAppFeeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewAppFeeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppFeeAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppFeeAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppFeeAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grantees[iNdEx])
+			copy(dAtA[i:], m.Grantees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Grantees[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppFeeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppFeeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppFeeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAppAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAppAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAppAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAppAuthorizedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAppAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAppAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAppAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAppAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAppAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAppAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAppAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAppAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAppOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAppOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAppOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAppOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAppOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAppOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAppOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAppOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAppOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAppOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAppOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAppOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintAppDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintAppDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintAppDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintAppDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintAppDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintAppDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnAppDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnAppDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnAppDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Appid) > 0 {
+		i -= len(m.Appid)
+		copy(dAtA[i:], m.Appid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnAppDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnAppDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnAppDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateUserInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubInfo != nil {
+		l = m.PubInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PriInfo != nil {
+		l = m.PriInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgUpdateUserInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateUserRelation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UidFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UidTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsFollowing {
+		n += 2
+	}
+	if m.IsBlocking {
+		n += 2
+	}
+	if m.IsReferredBy {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	if m.RelType != 0 {
+		n += 1 + sovTx(uint64(m.RelType))
+	}
+	if m.ExpiringRelType != 0 {
+		n += 1 + sovTx(uint64(m.ExpiringRelType))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateUserRelationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAppInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HomeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IconUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgUpdateAppInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDidRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PkeyDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PkeyType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PkeyMultibase)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDidRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateUserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateUserListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRenameUserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRenameUserListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddUserListMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgAddUserListMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUserListMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRemoveUserListMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteUserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgDeleteUserListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCommunity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JoinPolicy != 0 {
+		n += 1 + sovTx(uint64(m.JoinPolicy))
+	}
+	return n
+}
+
+func (m *MsgCreateCommunityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgJoinCommunity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommunityId != 0 {
+		n += 1 + sovTx(uint64(m.CommunityId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinCommunityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveCommunityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommunityId != 0 {
+		n += 1 + sovTx(uint64(m.CommunityId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveCommunityMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgKickCommunityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommunityId != 0 {
+		n += 1 + sovTx(uint64(m.CommunityId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgKickCommunityMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeCommunityRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommunityId != 0 {
+		n += 1 + sovTx(uint64(m.CommunityId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgChangeCommunityRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DenomMeta != nil {
+		l = m.DenomMeta.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNewDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNewNFTClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovTx(uint64(m.RoyaltyBasisPoints))
+	}
+	if m.Soulbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgNewNFTClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNFTClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNFTClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for _, e := range m.Royalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferDidNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferDidNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for _, e := range m.Royalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAppFeeGrantTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAppFeeGrantTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAppFeeGrantTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAppFeeGrantTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantAppFeeAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grantees) > 0 {
+		for _, s := range m.Grantees {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantAppFeeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewAppFeeAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grantees) > 0 {
+		for _, s := range m.Grantees {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRenewAppFeeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAppFeeAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grantees) > 0 {
+		for _, s := range m.Grantees {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeAppFeeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthorizeApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAppAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAppAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecAppAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecAppAuthorizedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAppAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAppAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAppAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAppAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferAppOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAppOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAppOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAppOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintAppDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintAppDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnAppDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Appid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnAppDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUserInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUserInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubInfo == nil {
+				m.PubInfo = &PublicUserInfo{}
+			}
+			if err := m.PubInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriInfo == nil {
+				m.PriInfo = &PrivateUserInfo{}
+			}
+			if err := m.PriInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateUserInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUserInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUserRelation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUserRelation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFollowing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFollowing = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBlocking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBlocking = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReferredBy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReferredBy = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelType", wireType)
+			}
+			m.RelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringRelType", wireType)
+			}
+			m.ExpiringRelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiringRelType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateUserRelationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUserRelationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAppInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAppInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAppInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAppInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDidRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDidRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkeyDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkeyDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkeyMultibase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkeyMultibase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDidRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDidRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateUserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateUserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateUserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= UserListVisibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateUserListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateUserListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateUserListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenameUserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenameUserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenameUserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenameUserListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenameUserListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenameUserListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddUserListMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddUserListMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddUserListMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddUserListMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddUserListMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddUserListMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUserListMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUserListMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUserListMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUserListMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUserListMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUserListMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteUserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteUserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteUserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteUserListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteUserListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteUserListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCommunity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCommunity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCommunity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPolicy", wireType)
+			}
+			m.JoinPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPolicy |= CommunityJoinPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCommunityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCommunityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCommunityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinCommunity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinCommunity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinCommunity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinCommunityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinCommunityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinCommunityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveCommunityMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveCommunityMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveCommunityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveCommunityMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveCommunityMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveCommunityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgKickCommunityMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgKickCommunityMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgKickCommunityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgKickCommunityMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgKickCommunityMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgKickCommunityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeCommunityRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeCommunityRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeCommunityRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityId", wireType)
+			}
+			m.CommunityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= CommunityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeCommunityRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeCommunityRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeCommunityRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomMeta == nil {
+				m.DenomMeta = &types.Metadata{}
+			}
+			if err := m.DenomMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewNFTClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
+			}
+			m.RoyaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soulbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Soulbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewNFTClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewNFTClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewNFTClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNFTClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNFTClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNFTClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNFTClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types2.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalty = append(m.Royalty, types2.Coin{})
+			if err := m.Royalty[len(m.Royalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgRevokeNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDidNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDidNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDidNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types2.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgBurnNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferDidNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDidNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDidNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalty = append(m.Royalty, types2.Coin{})
+			if err := m.Royalty[len(m.Royalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgBurnNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAppFeeGrantTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3293,10 +16585,10 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAppFeeGrantTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAppFeeGrantTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3333,7 +16625,7 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3361,11 +16653,43 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3392,16 +16716,47 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubInfo == nil {
-				m.PubInfo = &PublicUserInfo{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.PubInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types2.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3428,18 +16783,16 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriInfo == nil {
-				m.PriInfo = &PrivateUserInfo{}
-			}
-			if err := m.PriInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types2.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3449,11 +16802,25 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3475,7 +16842,7 @@ func (m *MsgUpdateUserInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAppFeeGrantTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3498,10 +16865,10 @@ func (m *MsgUpdateUserInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAppFeeGrantTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAppFeeGrantTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3525,7 +16892,7 @@ func (m *MsgUpdateUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAppFeeGrantTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3548,10 +16915,10 @@ func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserRelation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAppFeeGrantTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserRelation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAppFeeGrantTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3588,7 +16955,7 @@ func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3616,11 +16983,11 @@ func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidFrom = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3648,87 +17015,8 @@ func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidTo = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFollowing", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFollowing = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBlocking", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsBlocking = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReferredBy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsReferredBy = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3750,7 +17038,7 @@ func (m *MsgUpdateUserRelation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserRelationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAppFeeGrantTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3773,10 +17061,10 @@ func (m *MsgUpdateUserRelationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserRelationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAppFeeGrantTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserRelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAppFeeGrantTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3800,7 +17088,7 @@ func (m *MsgUpdateUserRelationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAppFeeAllowances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3823,10 +17111,10 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAppInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAppFeeAllowances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAppInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAppFeeAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3895,7 +17183,7 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3923,11 +17211,11 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3955,11 +17243,111 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domains = append(m.Domains, string(dAtA[iNdEx:postIndex]))
+			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAppFeeAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAppFeeAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAppFeeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewAppFeeAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewAppFeeAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewAppFeeAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3987,11 +17375,11 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Developer = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4019,11 +17407,11 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HomeUrl = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4051,13 +17439,13 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconUrl = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4067,11 +17455,24 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4093,7 +17494,7 @@ func (m *MsgUpdateAppInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAppInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewAppFeeAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4116,10 +17517,10 @@ func (m *MsgUpdateAppInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAppInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewAppFeeAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewAppFeeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4143,7 +17544,7 @@ func (m *MsgUpdateAppInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAppFeeAllowances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4166,10 +17567,10 @@ func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidRegistry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAppFeeAllowances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAppFeeAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4206,7 +17607,7 @@ func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4234,75 +17635,11 @@ func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PkeyDid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PkeyDid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PkeyType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PkeyType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PkeyMultibase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4330,27 +17667,8 @@ func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PkeyMultibase = string(dAtA[iNdEx:postIndex])
+			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4372,7 +17690,7 @@ func (m *MsgCreateDidRegistry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDidRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAppFeeAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4395,10 +17713,10 @@ func (m *MsgCreateDidRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAppFeeAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAppFeeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4422,7 +17740,7 @@ func (m *MsgCreateDidRegistryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4445,15 +17763,15 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4481,11 +17799,11 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4513,15 +17831,13 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4531,31 +17847,27 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DenomMeta == nil {
-				m.DenomMeta = &types.Metadata{}
-			}
-			if err := m.DenomMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4583,13 +17895,13 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4599,23 +17911,27 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4638,7 +17954,7 @@ func (m *MsgNewDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4661,10 +17977,10 @@ func (m *MsgNewDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4688,7 +18004,7 @@ func (m *MsgNewDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAppAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4711,15 +18027,15 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewNFTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAppAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAppAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4747,11 +18063,43 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4776,46 +18124,114 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAppAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAppAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAppAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecAppAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecAppAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecAppAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4843,11 +18259,11 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4875,11 +18291,11 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4906,45 +18322,11 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types1.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4966,7 +18348,7 @@ func (m *MsgNewNFTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewNFTClassResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAppAuthorizedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4989,10 +18371,10 @@ func (m *MsgNewNFTClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewNFTClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAppAuthorizedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewNFTClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAppAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5016,7 +18398,7 @@ func (m *MsgNewNFTClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAppAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5039,15 +18421,15 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNFTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAppAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAppAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5075,11 +18457,11 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5107,11 +18489,11 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5139,11 +18521,111 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAppAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAppAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAppAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAppAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAppAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAppAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5171,13 +18653,13 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5187,31 +18669,27 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types1.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5239,7 +18717,7 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5262,7 +18740,7 @@ func (m *MsgUpdateNFTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNFTClassResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAppAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5285,10 +18763,10 @@ func (m *MsgUpdateNFTClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNFTClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAppAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNFTClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAppAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5312,7 +18790,7 @@ func (m *MsgUpdateNFTClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAppOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5335,15 +18813,15 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAppOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAppOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5371,11 +18849,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5403,11 +18881,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5435,79 +18913,111 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAppOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAppOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAppOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types1.Any{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAppOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAppOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAppOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5535,11 +19045,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5567,7 +19077,7 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5590,7 +19100,7 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAppOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5613,10 +19123,10 @@ func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAppOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAppOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5640,7 +19150,7 @@ func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgMintAppDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5663,15 +19173,15 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintAppDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintAppDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5699,11 +19209,11 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5731,11 +19241,11 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5763,13 +19273,13 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5779,31 +19289,29 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types1.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5831,7 +19339,7 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5854,7 +19362,7 @@ func (m *MsgUpdateNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintAppDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5877,10 +19385,10 @@ func (m *MsgUpdateNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintAppDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintAppDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5904,7 +19412,7 @@ func (m *MsgUpdateNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnAppDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5927,15 +19435,15 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnAppDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnAppDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5963,11 +19471,11 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5995,11 +19503,11 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Appid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6027,7 +19535,41 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6050,7 +19592,7 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnAppDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6073,10 +19615,10 @@ func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnAppDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnAppDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,37 @@
+package nfttransfer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mises-id/mises-tm/x/nfttransfer/keeper"
+	"github.com/mises-id/mises-tm/x/nfttransfer/types"
+)
+
+// InitGenesis initializes the nfttransfer module's state from a provided genesis
+// state and binds its port.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetPort(ctx, genState.PortId)
+
+	for _, trace := range genState.ClassTraces {
+		k.SetClassTrace(ctx, trace)
+	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, genState.PortId) {
+		// transfer module binds to the transfer port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, genState.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis returns the nfttransfer module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		PortId:      k.GetPort(ctx),
+		ClassTraces: k.GetAllClassTraces(ctx),
+	}
+}
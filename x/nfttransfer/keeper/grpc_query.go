@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mises-id/mises-tm/x/nfttransfer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method
+func (k Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid class trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("class trace for hash %s not found", req.Hash))
+	}
+
+	return &types.QueryClassTraceResponse{
+		ClassTrace: &classTrace,
+	}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (k Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.ClassTrace
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		traces = append(traces, result)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassTracesResponse{
+		ClassTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/mises-id/mises-tm/x/nfttransfer/types"
+)
+
+type (
+	Keeper struct {
+		cdc           codec.BinaryCodec
+		storeKey      sdk.StoreKey
+		channelKeeper types.ChannelKeeper
+		portKeeper    types.PortKeeper
+		scopedKeeper  capabilitykeeper.ScopedKeeper
+		nk            types.NFTKeeper
+		ck            types.NFTClassKeeper
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+	nk types.NFTKeeper,
+	ck types.NFTClassKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		nk:            nk,
+		ck:            ck,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// IsBound checks if the module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the module to claim a capability that IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetClassTrace retrieves the full identifiers trace and base class id from the store.
+func (k Keeper) GetClassTrace(ctx sdk.Context, classTraceHash []byte) (types.ClassTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	bz := store.Get(classTraceHash)
+	if bz == nil {
+		return types.ClassTrace{}, false
+	}
+
+	var classTrace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &classTrace)
+	return classTrace, true
+}
+
+// HasClassTrace checks if the class trace info for the provided hash exists in the store.
+func (k Keeper) HasClassTrace(ctx sdk.Context, classTraceHash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	return store.Has(classTraceHash)
+}
+
+// SetClassTrace sets a new {trace hash -> class trace} pair to the store.
+func (k Keeper) SetClassTrace(ctx sdk.Context, classTrace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	bz := k.cdc.MustMarshal(&classTrace)
+	store.Set(classTrace.Hash(), bz)
+}
+
+// GetAllClassTraces returns the trace information for all the classes.
+func (k Keeper) GetAllClassTraces(ctx sdk.Context) types.Traces {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	traces := types.Traces{}
+	for ; iterator.Valid(); iterator.Next() {
+		var classTrace types.ClassTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &classTrace)
+		traces = append(traces, classTrace)
+	}
+
+	return traces.Sort()
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/mises-id/mises-tm/x/nfttransfer/types"
+)
+
+// SendTransfer handles the sending logic of non fungible tokens, following the fungible token
+// transfer of ICS-20. There are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The nfts are transferred to an escrow address
+// (i.e locked) on the sender chain and the receiving chain is expected to mint vouchers of them
+// in a class prefixed with its receiving port and channel.
+//
+// 2. Sender chain is acting as the sink zone. The vouchers are burned on the sender chain and the
+// receiving chain, which had previously sent the original class, is expected to unescrow the nfts
+// and send them to the receiving address.
+//
+// Classes of soulbound nfts never leave the chain.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	if k.ck.IsSoulboundNFTClass(ctx, classID) {
+		return sdkerrors.Wrapf(types.ErrNonTransferableClass, "class %s is soulbound", classID)
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	class, found := k.nk.GetClass(ctx, classID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "class %s not found", classID)
+	}
+
+	fullClassPath := classID
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		var err error
+		fullClassPath, err = k.ClassPathFromHash(ctx, classID)
+		if err != nil {
+			return err
+		}
+	}
+
+	senderIsSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath)
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	tokenURIs := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		token, found := k.nk.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %s of class %s not found", tokenID, classID)
+		}
+		if !sender.Equals(k.nk.GetOwner(ctx, classID, tokenID)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, tokenID)
+		}
+		tokenURIs = append(tokenURIs, token.Uri)
+
+		if senderIsSource {
+			// escrow the source nft
+			if err := k.nk.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return err
+			}
+		} else {
+			// burn the voucher, the receiving chain unescrows the original nft
+			if err := k.nk.Burn(ctx, classID, tokenID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// NOTE: SendTransfer simply sends the class id as it exists on its own
+	// chain inside the packet data. The receiving chain will perform class
+	// prefixing as necessary.
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.Uri, tokenIDs, tokenURIs, sender.String(), receiver,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, packet)
+}
+
+// OnRecvPacket processes a cross chain non fungible token transfer. If the sender chain is the
+// source of the class then vouchers will be minted to the receiving address, the voucher class
+// is created on the first transfer. Otherwise the nfts this chain originally transferred are
+// unescrowed and sent to the receiving address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this class as seen in the "sender chain is the source" condition.
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassID) {
+		// sender chain is not the source, unescrow nfts
+
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassID[len(voucherPrefix):]
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIDs {
+			if !escrowAddress.Equals(k.nk.GetOwner(ctx, classID, tokenID)) {
+				// NOTE: this is only expected given a bug or a malicious counterparty module
+				// sending back nfts that were never escrowed.
+				return sdkerrors.Wrapf(types.ErrInvalidPacket, "nft %s of class %s is not escrowed", tokenID, classID)
+			}
+			if err := k.nk.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return sdkerrors.Wrap(err, "unable to unescrow nft")
+			}
+		}
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the class id, we must prefix the class id here
+	sourcePrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedClassID := sourcePrefix + data.ClassID
+
+	classTrace := types.ParseClassTrace(prefixedClassID)
+
+	traceHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, traceHash) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	if err := k.ensureVoucherClass(ctx, voucherClassID, data.ClassURI); err != nil {
+		return err
+	}
+
+	for i, tokenID := range data.TokenIDs {
+		if err := k.nk.Mint(ctx, nft.NFT{
+			ClassId: voucherClassID,
+			Id:      tokenID,
+			Uri:     data.TokenURIs[i],
+		}, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// failed, the sender is refunded their nfts.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens unescrows the nfts back to the sender if the sending chain was the source.
+// Otherwise the vouchers were burnt in the original send so they are minted again to the sender.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	classID := types.ParseClassTrace(data.ClassID).IBCClassID()
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassID) {
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, tokenID := range data.TokenIDs {
+			if err := k.nk.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return sdkerrors.Wrapf(err, "unable to unescrow nft %s from %s", tokenID, escrowAddress)
+			}
+		}
+		return nil
+	}
+
+	for i, tokenID := range data.TokenIDs {
+		if err := k.nk.Mint(ctx, nft.NFT{
+			ClassId: classID,
+			Id:      tokenID,
+			Uri:     data.TokenURIs[i],
+		}, sender); err != nil {
+			panic(fmt.Sprintf("unable to mint back the voucher %s of class %s: %v", tokenID, classID, err))
+		}
+	}
+	return nil
+}
+
+// ensureVoucherClass creates the class of the vouchers the first time nfts of a class are received,
+// the class is owned by the module so that only transfers mint and burn its nfts.
+func (k Keeper) ensureVoucherClass(ctx sdk.Context, classID string, classURI string) error {
+	if k.nk.HasClass(ctx, classID) {
+		return nil
+	}
+	if err := k.nk.SaveClass(ctx, nft.Class{
+		Id:  classID,
+		Uri: classURI,
+	}); err != nil {
+		return err
+	}
+	k.nk.SetClassOwner(ctx, classID, types.GetClassOwnerAddress())
+	return nil
+}
+
+// ClassPathFromHash returns the full class path prefix from an ibc class id with a hash
+// component.
+func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (string, error) {
+	// trim the class prefix, by default "ibc/"
+	hexHash := classID[len(types.ClassPrefix+"/"):]
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidClassIDForTransfer, err.Error())
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	return classTrace.GetFullClassPath(), nil
+}
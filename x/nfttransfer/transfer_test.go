@@ -0,0 +1,138 @@
+package nfttransfer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+
+	"github.com/mises-id/mises-tm/app"
+	misestmtypes "github.com/mises-id/mises-tm/x/misestm/types"
+	"github.com/mises-id/mises-tm/x/nfttransfer/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := app.MakeEncodingConfig()
+	a := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, encoding,
+		simapp.EmptyAppOptions{})
+	return a, app.NewDefaultGenesisState(encoding.Codec)
+}
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+func getApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// mints a nft on chainA, sends it to chainB and sends the voucher back to chainA.
+func (suite *TransferTestSuite) TestHandleMsgTransfer() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	_, err := suite.chainA.SendMsgs(
+		misestmtypes.NewMsgNewNFTClass("mises-class", "class", "ipfs://class", "", "MC", nil, senderA.String(), "", 0, false),
+		misestmtypes.NewMsgMintNFT("nft1", "mises-class", "nft", "ipfs://nft1", nil, senderA.String(), senderA.String()),
+	)
+	suite.Require().NoError(err)
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "mises-class", []string{"nft1"}, senderA.String(), receiverB.String(), timeoutHeight, 0)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	escrowA := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(escrowA, getApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), "mises-class", "nft1"))
+
+	packetData := types.NewNonFungibleTokenPacketData("mises-class", "ipfs://class", []string{"nft1"}, []string{"ipfs://nft1"}, senderA.String(), receiverB.String())
+	packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.RelayPacket(packet, ack.Acknowledgement()))
+
+	// check that the voucher exists on chain B
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + "mises-class")
+	voucherClassID := voucherTrace.IBCClassID()
+	nkB := getApp(suite.chainB).NFTKeeper
+	suite.Require().Equal(receiverB, nkB.GetOwner(suite.chainB.GetContext(), voucherClassID, "nft1"))
+	voucher, found := nkB.GetNFT(suite.chainB.GetContext(), voucherClassID, "nft1")
+	suite.Require().True(found)
+	suite.Require().Equal("ipfs://nft1", voucher.Uri)
+	class, found := nkB.GetClass(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal("ipfs://class", class.Uri)
+	trace, found := getApp(suite.chainB).NFTTransferKeeper.GetClassTrace(suite.chainB.GetContext(), voucherTrace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(voucherTrace, trace)
+
+	// send the voucher back from chainB to chainA
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID, []string{"nft1"}, receiverB.String(), senderA.String(), timeoutHeight, 0)
+	_, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().False(nkB.HasNFT(suite.chainB.GetContext(), voucherClassID, "nft1"))
+
+	packetData = types.NewNonFungibleTokenPacketData(voucherTrace.GetFullClassPath(), "ipfs://class", []string{"nft1"}, []string{"ipfs://nft1"}, receiverB.String(), senderA.String())
+	packet = channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.RelayPacket(packet, ack.Acknowledgement()))
+
+	// check that the nft is unescrowed on chain A
+	suite.Require().Equal(senderA, getApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), "mises-class", "nft1"))
+}
+
+// nfts of soulbound classes can not leave the chain.
+func (suite *TransferTestSuite) TestTransferSoulbound() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	_, err := suite.chainA.SendMsgs(
+		misestmtypes.NewMsgNewNFTClass("mises-badge", "badge", "ipfs://badge", "", "MB", nil, senderA.String(), "", 0, true),
+		misestmtypes.NewMsgMintNFT("badge1", "mises-badge", "badge", "ipfs://badge1", nil, senderA.String(), senderA.String()),
+	)
+	suite.Require().NoError(err)
+
+	err = getApp(suite.chainA).NFTTransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "mises-badge", []string{"badge1"},
+		senderA, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+	)
+	suite.Require().ErrorIs(err, types.ErrNonTransferableClass)
+	suite.Require().Equal(senderA, getApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), "mises-badge", "badge1"))
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
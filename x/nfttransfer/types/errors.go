@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/nfttransfer module sentinel errors
+var (
+	ErrInvalidPacketTimeout      = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidClassIDForTransfer = sdkerrors.Register(ModuleName, 3, "invalid class id for cross-chain transfer")
+	ErrInvalidVersion            = sdkerrors.Register(ModuleName, 4, "invalid ICS721 version")
+	ErrInvalidTokenIDs           = sdkerrors.Register(ModuleName, 5, "invalid token ids")
+	ErrTraceNotFound             = sdkerrors.Register(ModuleName, 6, "class trace not found")
+	ErrMaxTransferChannels       = sdkerrors.Register(ModuleName, 7, "max nft transfer channels")
+	ErrInvalidPacket             = sdkerrors.Register(ModuleName, 8, "invalid non fungible token packet")
+	ErrNonTransferableClass      = sdkerrors.Register(ModuleName, 9, "nfts of the class can not leave the chain")
+)
@@ -0,0 +1,18 @@
+package types
+
+// nfttransfer events
+const (
+	EventTypeTimeout    = "timeout"
+	EventTypePacket     = "non_fungible_token_packet"
+	EventTypeTransfer   = "ibc_nft_transfer"
+	EventTypeClassTrace = "class_trace"
+
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAck            = "acknowledgement"
+	AttributeKeyAckError       = "error"
+	AttributeKeyTraceHash      = "trace_hash"
+)
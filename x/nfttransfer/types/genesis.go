@@ -0,0 +1,22 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default nfttransfer genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PortId:      PortID,
+		ClassTraces: Traces{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	return gs.ClassTraces.Validate()
+}
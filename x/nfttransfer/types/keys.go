@@ -0,0 +1,57 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nfttransfer"
+
+	// Version defines the ICS-721 version the module supports
+	Version = "ics721-1"
+
+	// PortID is the default port id that the module binds to
+	PortID = "nft-transfer"
+
+	// StoreKey defines the primary module store key, the module name can't be used as
+	// store keys must not be prefixes of each other and "nft" is the nft module store key
+	StoreKey = "ics721"
+
+	// RouterKey is the message route for the module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// ClassPrefix is the prefix of the ids of the classes received from other chains
+	ClassPrefix = "ibc"
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// ClassTraceKey defines the key to store the class trace info in store
+	ClassTraceKey = []byte{0x02}
+)
+
+// GetEscrowAddress returns the address holding the nfts sent through a channel, following ADR 028
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash separates the port and channel identifiers to prevent collisions between channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// GetClassOwnerAddress returns the owner of the voucher classes, only the module mints their nfts
+func GetClassOwnerAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
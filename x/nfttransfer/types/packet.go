@@ -0,0 +1,83 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NonFungibleTokenPacketData is the ICS-721 packet data, it is relayed as JSON with the field
+// names of the specification
+type NonFungibleTokenPacketData struct {
+	// full class path, prefixed with the port and channel identifiers it went through
+	ClassID  string   `json:"classId"`
+	ClassURI string   `json:"classUri,omitempty"`
+	TokenIDs []string `json:"tokenIds"`
+	// one uri per token id
+	TokenURIs []string `json:"tokenUris"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+}
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(classID string, classURI string, tokenIDs []string, tokenURIs []string, sender string, receiver string) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassID:   classID,
+		ClassURI:  classURI,
+		TokenIDs:  tokenIDs,
+		TokenURIs: tokenURIs,
+		Sender:    sender,
+		Receiver:  receiver,
+	}
+}
+
+// ValidateBasic is used for validating the nft transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.ClassID) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassIDForTransfer, "class id cannot be blank")
+	}
+	if err := ValidateTokenIDs(data.TokenIDs); err != nil {
+		return err
+	}
+	if len(data.TokenURIs) != len(data.TokenIDs) {
+		return sdkerrors.Wrap(ErrInvalidPacket, "token uris must match the token ids")
+	}
+	if strings.TrimSpace(data.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateTokenIDs checks a transfer carries distinct non blank token ids
+func ValidateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenIDs, "no token ids")
+	}
+	seen := make(map[string]bool, len(tokenIDs))
+	for _, id := range tokenIDs {
+		if strings.TrimSpace(id) == "" {
+			return sdkerrors.Wrap(ErrInvalidTokenIDs, "token id cannot be blank")
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalidTokenIDs, "duplicated token id %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+// ParseClassTrace parses a class id prefixed with the port and channel identifiers it went through,
+// '{portIDN}/{channelIDN}/.../{portID0}/{channelID0}/baseClassID'. Class ids may contain slashes,
+// only the leading pairs of valid port and channel identifiers make up the path.
+func ParseClassTrace(rawClassID string) ClassTrace {
+	parts := strings.Split(rawClassID, "/")
+	i := 0
+	for ; i+2 < len(parts); i += 2 {
+		if host.PortIdentifierValidator(parts[i]) != nil || !channeltypes.IsValidChannelID(parts[i+1]) {
+			break
+		}
+	}
+	return ClassTrace{
+		Path:        strings.Join(parts[:i], "/"),
+		BaseClassId: strings.Join(parts[i:], "/"),
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassID)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID returns the id of the class on this chain, 'ibc/{hash(tracePath + baseClassID)}'
+// for classes from other chains and the base class id otherwise.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class id according to the ICS-721 specification:
+// tracePath + "/" + baseClassID
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return fmt.Errorf("base class id cannot be blank")
+	}
+	// empty trace is accepted when the class lives on the original chain
+	if ct.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", trace.Hash())
+		}
+
+		if err := trace.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullClassPath() < t[j].GetFullClassPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of class traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmtypes.ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// GetClassPrefix returns the prefix of the classes received through a channel
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// SenderChainIsSource returns false if the class originally came from the receiving chain and true
+// otherwise. The class id is the full class path as relayed in packets.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// This is the prefix that would have been prefixed to the class
+	// on sender chain IF and only if the nft originally came from the
+	// receiving chain.
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came from the receiving chain and false
+// otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// The prefix passed in should contain the SourcePort and SourceChannel.
+	// If  the receiver chain originally sent the nft to the sender chain
+	// the class will have the sender's SourcePort and SourceChannel as the
+	// prefix.
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}